@@ -0,0 +1,97 @@
+package mdl
+
+import (
+	"bytes"
+	"io"
+	"io/ioutil"
+	"os"
+)
+
+// Attachment represents a file that was sent along with an instruction.
+//
+// For example, when a web browser submits an HTML form that has an <input type="file"> in it,
+// the file(s) that were chosen are received as ‘mdl.Attachment’.
+//
+// Example
+//
+// Here is an example of reading the attachments of an ‘mdl.Instruction’:
+//
+//	var instruction mdl.Instruction
+//	
+//	// ...
+//	
+//	err := instruction.Scan(request)
+//	
+//	// ...
+//	
+//	defer instruction.RemoveAttachments()
+//	
+//	for _, attachment := range instruction.Attachments {
+//	
+//		fmt.Printf("key = %#v\n", attachment.Key())
+//		fmt.Printf("file name = %q\n", attachment.FileName())
+//		fmt.Printf("media type = %q\n", attachment.MediaType())
+//		fmt.Printf("size = %d\n", attachment.Size())
+//	
+//		reader, err := attachment.Open()
+//		if nil != err {
+//			return err
+//		}
+//	
+//		// ...
+//	
+//		reader.Close()
+//	}
+type Attachment struct {
+	key       Key
+	fileName  string
+	mediaType string
+	size      int64
+
+	content  []byte
+	tempFile string
+}
+
+// FileName returns the file name the HTTP client gave for the attachment.
+func (receiver Attachment) FileName() string {
+	return receiver.fileName
+}
+
+// Key returns the key (i.e., form field name) the attachment was sent under.
+func (receiver Attachment) Key() Key {
+	return receiver.key
+}
+
+// MediaType returns the media type the HTTP client gave for the attachment.
+//
+// If the HTTP client did not give one, then this returns "application/octet-stream".
+func (receiver Attachment) MediaType() string {
+	return receiver.mediaType
+}
+
+// Open returns a reader for the content of the attachment.
+//
+// The attachment content is streamed from where it is held (in memory for small attachments,
+// and in a temporary file for large attachments), rather than being read all at once.
+//
+// The caller should call .Close() on the returned reader when done with it.
+func (receiver Attachment) Open() (io.ReadCloser, error) {
+	if "" != receiver.tempFile {
+		return os.Open(receiver.tempFile)
+	}
+
+	return ioutil.NopCloser(bytes.NewReader(receiver.content)), nil
+}
+
+// Size returns the size of the attachment content, in bytes.
+func (receiver Attachment) Size() int64 {
+	return receiver.size
+}
+
+func (receiver Attachment) remove() error {
+	if "" == receiver.tempFile {
+		return nil
+	}
+
+	return os.Remove(receiver.tempFile)
+}
@@ -0,0 +1,66 @@
+package mdl
+
+import (
+	"fmt"
+	"net/http"
+)
+
+// BadVerb is the error returned from mdl.Instruction.Scan() when the instruction ‘Verb’ is missing, or is not allowed.
+//
+// For example:
+//
+//	var instruction mdl.Instruction
+//	
+//	// ...
+//	
+//	err := instruction.Scan(request)
+//	
+//	if nil != err {
+//		switch casted := err.(type) {
+//		case mdl.BadVerb:
+//			http.Error(responseWriter, casted.Error(), casted.StatusCode())
+//			return
+//		default:
+//			//@TODO
+//		}
+//	}
+type BadVerb interface {
+	error
+	BadVerb()
+
+	// StatusCode returns the HTTP status code that goes with this error (i.e., 405 “Method Not Allowed”).
+	StatusCode() int
+
+	// Unwrap returns the error that caused this error, if there is one.
+	Unwrap() error
+}
+
+type internalBadVerb struct {
+	err error
+}
+
+func badVerb(err error) BadVerb {
+	return internalBadVerb{
+		err: err,
+	}
+}
+
+func (receiver internalBadVerb) Error() string {
+	if nil == receiver.err {
+		return "mdl: bad verb"
+	}
+
+	return fmt.Sprintf("mdl: bad verb: %s", receiver.err)
+}
+
+func (receiver internalBadVerb) StatusCode() int {
+	return http.StatusMethodNotAllowed
+}
+
+func (receiver internalBadVerb) Unwrap() error {
+	return receiver.err
+}
+
+func (internalBadVerb) BadVerb() {
+	// Nothing here.
+}
@@ -0,0 +1,23 @@
+package mdl
+
+import (
+	"testing"
+)
+
+func TestInternalBadVerbAsError(t *testing.T) {
+	var err error = internalBadVerb{} // THIS IS THE LINE THAT ACTUALLY MATTERS.
+
+	if nil == err {
+		t.Errorf("This should never happen.")
+		return
+	}
+}
+
+func TestInternalBadVerbAsBadVerb(t *testing.T) {
+	var complainer BadVerb = internalBadVerb{} // THIS IS THE LINE THAT ACTUALLY MATTERS.
+
+	if nil == complainer {
+		t.Errorf("This should never happen.")
+		return
+	}
+}
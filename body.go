@@ -0,0 +1,38 @@
+package mdl
+
+import (
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"unsafe"
+)
+
+func readBody(request *http.Request, limit int64) (string, error) {
+	if nil == request {
+		return "", errNilHttpRequest
+	}
+
+	if nil == request.Body {
+		return "", nil
+	}
+
+	var reader io.Reader = io.LimitReader(request.Body, limit)
+
+	bytes, err := ioutil.ReadAll(reader)
+	if nil != err {
+		return "", malformedBody(err)
+	}
+
+	{
+		var buffer [1]byte
+		n, _ := request.Body.Read(buffer[:])
+		if n > 0 {
+			return "", payloadTooLarge(fmt.Errorf("limit is %d bytes", limit))
+		}
+	}
+
+	body := *(*string)(unsafe.Pointer(&bytes))
+
+	return body, nil
+}
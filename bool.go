@@ -0,0 +1,262 @@
+package mdl
+
+import (
+	"database/sql/driver"
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// Bool is a bool option type.
+//
+// It can have 2 kinds of value:
+//
+// • no bool (i.e., ‘nothing’),
+//
+// • some bool (i.e., ‘something’).
+//
+// Like with ‘mdl.String’, the value in this type is that it can differentiate between being assigned false,
+// and a variable that hasn't been loaded.
+type Bool struct {
+	datum  bool
+	loaded bool
+}
+
+// NoBool returns a mdl.Bool which has no value (i.e., ‘nothing’).
+//
+// Note that this is not the same thing as false!
+// That is mdl.SomeBool(false).
+//
+// Example
+//
+//	var datum mdl.Bool
+//	
+//	// ...
+//	
+//	if mdl.NoBool() == datum {
+//		//@TODO
+//	}
+func NoBool() Bool {
+	return Bool{}
+}
+
+// SomeBool returns a mdl.Bool which has some value (i.e., ‘something’).
+//
+// Example
+//
+//	var datum mdl.Bool
+//	
+//	// ...
+//	
+//	datum = mdl.SomeBool(true)
+func SomeBool(datum bool) Bool {
+	return Bool{
+		loaded: true,
+		datum:  datum,
+	}
+}
+
+func (receiver Bool) Else(datum bool) Bool {
+	if NoBool() != receiver {
+		return receiver
+	}
+
+	return SomeBool(datum)
+}
+
+func (receiver Bool) ElseUnwrap(datum bool) bool {
+	if NoBool() == receiver {
+		return datum
+	}
+
+	return receiver.datum
+}
+
+// Format makes ‘mdl.Bool’ fit the ‘fmt.Formatter’ interface.
+//
+// The %t, and %v verbs format the value the same way they would format a bool.
+// The %q verb formats its text form (see .MarshalText()) as a quoted string, and the %#v verb formats it with .GoString().
+func (receiver Bool) Format(f fmt.State, c rune) {
+	switch {
+	case 'v' == c && f.Flag('#'):
+		fmt.Fprint(f, receiver.GoString())
+	case NoBool() == receiver && 'q' == c:
+		fmt.Fprint(f, "«no-bool»")
+	case NoBool() == receiver:
+		fmt.Fprintf(f, "%%!%s(%s)", string(c), receiver.GoString())
+	case 'q' == c:
+		fmt.Fprintf(f, "%q", strconv.FormatBool(receiver.datum))
+	case strings.ContainsRune("tv", c):
+		fmt.Fprintf(f, optionFormatDirective(f, c), receiver.datum)
+	default:
+		fmt.Fprintf(f, "%%!%s(%s)", string(c), receiver.GoString())
+	}
+}
+
+// GoString makes mdl.Bool fit the fmt.GoStringer interface.
+//
+// It gets used with the %#v verb with the printing family of functions
+// in the Go built-in "fmt" package.
+func (receiver Bool) GoString() string {
+	if NoBool() == receiver {
+		return "mdl.NoBool()"
+	}
+
+	return fmt.Sprintf("mdl.SomeBool(%t)", receiver.datum)
+}
+
+// MarshalJSON makes mdl.Bool fit the encoding/json.Marshaler interface.
+//
+// mdl.NoBool() is marshaled as JSON null, and mdl.SomeBool() is marshaled as JSON true or false.
+func (receiver Bool) MarshalJSON() ([]byte, error) {
+	if NoBool() == receiver {
+		return []byte("null"), nil
+	}
+
+	return json.Marshal(receiver.datum)
+}
+
+// MarshalText makes mdl.Bool fit the encoding.TextMarshaler interface.
+//
+// mdl.SomeBool() is marshaled as “true” or “false”.
+// Since text has no way of saying ‘nothing’, mdl.NoBool() cannot be marshaled as text, and returns an error.
+func (receiver Bool) MarshalText() ([]byte, error) {
+	if NoBool() == receiver {
+		return nil, errNotLoaded
+	}
+
+	return []byte(strconv.FormatBool(receiver.datum)), nil
+}
+
+func (receiver Bool) Map(fn func(bool)bool) Bool {
+	if NoBool() == receiver {
+		return receiver
+	}
+
+	return SomeBool(fn(receiver.datum))
+}
+
+// Scan makes mdl.Bool fit the database/sql.Scanner interface.
+//
+// A nil source (i.e., SQL NULL) scans as mdl.NoBool().
+// The source can be a bool, an int64 (0 or 1, which is what some databases use for booleans), a string, a []byte, or an ‘mdl.Bool’.
+func (receiver *Bool) Scan(src interface{}) error {
+	if nil == receiver {
+		return errNilReceiver
+	}
+
+	switch casted := src.(type) {
+	case nil:
+		*receiver = NoBool()
+		return nil
+	case Bool:
+		*receiver = casted
+		return nil
+	case string:
+		return receiver.scanText(casted)
+	case []byte:
+		if nil == casted {
+			*receiver = NoBool()
+			return nil
+		}
+		return receiver.scanText(string(casted))
+	case bool:
+		*receiver = SomeBool(casted)
+		return nil
+	case int64:
+		switch casted {
+		case 0:
+			*receiver = SomeBool(false)
+		case 1:
+			*receiver = SomeBool(true)
+		default:
+			return fmt.Errorf("mdl: %d is not 0 or 1, so cannot be a bool", casted)
+		}
+		return nil
+	default:
+		return unsupportedSource(src)
+	}
+}
+
+// String returns the text form of the value (see .MarshalText()).
+func (receiver Bool) String() (string, error) {
+	if NoBool() == receiver {
+		return "", errNotLoaded
+	}
+
+	return strconv.FormatBool(receiver.datum), nil
+}
+
+func (receiver Bool) Then(fn func(bool)Bool) Bool {
+	if NoBool() == receiver {
+		return receiver
+	}
+
+	return fn(receiver.datum)
+}
+
+// UnmarshalJSON makes mdl.Bool fit the encoding/json.Unmarshaler interface.
+//
+// It is the opposite of .MarshalJSON(); i.e., JSON null is unmarshaled as mdl.NoBool().
+func (receiver *Bool) UnmarshalJSON(data []byte) error {
+	if nil == receiver {
+		return errNilReceiver
+	}
+
+	if "null" == string(data) {
+		*receiver = NoBool()
+		return nil
+	}
+
+	var datum bool
+	if err := json.Unmarshal(data, &datum); nil != err {
+		return err
+	}
+
+	*receiver = SomeBool(datum)
+	return nil
+}
+
+// UnmarshalText makes mdl.Bool fit the encoding.TextUnmarshaler interface.
+//
+// It is the opposite of .MarshalText(). (A nil text is unmarshaled as mdl.NoBool().)
+func (receiver *Bool) UnmarshalText(text []byte) error {
+	if nil == receiver {
+		return errNilReceiver
+	}
+
+	if nil == text {
+		*receiver = NoBool()
+		return nil
+	}
+
+	return receiver.scanText(string(text))
+}
+
+func (receiver Bool) Unwrap() (bool, bool) {
+	return receiver.datum, receiver.loaded
+}
+
+// Value makes mdl.Bool fit the database/sql/driver.Valuer interface.
+func (receiver Bool) Value() (driver.Value, error) {
+	if NoBool() == receiver {
+		return nil, errNotLoaded
+	}
+
+	return receiver.datum, nil
+}
+
+func (receiver Bool) isNothing() bool {
+	return NoBool() == receiver
+}
+
+func (receiver *Bool) scanText(text string) error {
+	datum, err := strconv.ParseBool(text)
+	if nil != err {
+		return err
+	}
+
+	*receiver = SomeBool(datum)
+	return nil
+}
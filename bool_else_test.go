@@ -0,0 +1,55 @@
+package mdl_test
+
+import (
+	"github.com/reiver/go-mdl"
+
+	"testing"
+)
+
+func TestBoolElse(t *testing.T) {
+
+	tests := []struct{
+		Value    mdl.Bool
+		Else     bool
+		Expected mdl.Bool
+	}{
+		{
+			Value:    mdl.NoBool(),
+			Else:                  true,
+			Expected: mdl.SomeBool(true),
+		},
+		{
+			Value:    mdl.NoBool(),
+			Else:                  false,
+			Expected: mdl.SomeBool(false),
+		},
+
+
+
+		// Something that is false is not replaced; which is the point of mdl.Bool.
+		{
+			Value:    mdl.SomeBool(false),
+			Else:                  true,
+			Expected: mdl.SomeBool(false),
+		},
+		{
+			Value:    mdl.SomeBool(true),
+			Else:                  false,
+			Expected: mdl.SomeBool(true),
+		},
+	}
+
+	for testNumber, test := range tests {
+
+		actual := test.Value.Else(test.Else)
+
+		if expected := test.Expected; expected != actual {
+			t.Errorf("For test #%d ...", testNumber)
+			t.Errorf("\tVALUE:    %#v", test.Value)
+			t.Errorf("\tELSE:                  %t", test.Else)
+			t.Errorf("\tEXPECTED: %#v", expected)
+			t.Errorf("\tACTUAL:   %#v", actual)
+			continue
+		}
+	}
+}
@@ -0,0 +1,54 @@
+package mdl_test
+
+import (
+	"github.com/reiver/go-mdl"
+
+	"testing"
+)
+
+func TestBoolElseUnwrap(t *testing.T) {
+
+	tests := []struct{
+		Value    mdl.Bool
+		Else     bool
+		Expected bool
+	}{
+		{
+			Value:    mdl.NoBool(),
+			Else:                  true,
+			Expected:              true,
+		},
+		{
+			Value:    mdl.NoBool(),
+			Else:                  false,
+			Expected:              false,
+		},
+
+
+
+		{
+			Value:    mdl.SomeBool(false),
+			Else:                  true,
+			Expected:              false,
+		},
+		{
+			Value:    mdl.SomeBool(true),
+			Else:                  false,
+			Expected:              true,
+		},
+	}
+
+	for testNumber, test := range tests {
+
+		actual := test.Value.ElseUnwrap(test.Else)
+
+		if expected := test.Expected; expected != actual {
+			t.Errorf("For test #%d ...", testNumber)
+			t.Errorf("\tVALUE: %#v", test.Value)
+			t.Errorf("\tELSE:               %t", test.Else)
+			t.Errorf("\tEXPECTED:           %t", expected)
+			t.Errorf("\tACTUAL:             %t", actual)
+			continue
+		}
+	}
+}
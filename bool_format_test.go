@@ -0,0 +1,74 @@
+package mdl_test
+
+import (
+	"github.com/reiver/go-mdl"
+
+	"fmt"
+
+	"testing"
+)
+
+func TestBoolFormat(t *testing.T) {
+
+	tests := []struct{
+		Format   string
+		Bool     mdl.Bool
+		Expected string
+	}{
+		{
+			Format: "%q",
+			Bool:   mdl.NoBool(),
+			Expected:      `«no-bool»`,
+		},
+		{
+			Format: "%q",
+			Bool:   mdl.SomeBool(true),
+			Expected:      `"true"`,
+		},
+
+
+
+		{
+			Format: "%t",
+			Bool:   mdl.SomeBool(false),
+			Expected:      `false`,
+		},
+		{
+			Format: "%v",
+			Bool:   mdl.SomeBool(true),
+			Expected:      `true`,
+		},
+		{
+			Format: "%6t|",
+			Bool:   mdl.SomeBool(true),
+			Expected:      `  true|`,
+		},
+
+
+
+		{
+			Format: "%t",
+			Bool:   mdl.NoBool(),
+			Expected:      `%!t(mdl.NoBool())`,
+		},
+		{
+			Format: "%d",
+			Bool:   mdl.SomeBool(true),
+			Expected:      `%!d(mdl.SomeBool(true))`,
+		},
+	}
+
+	for testNumber, test := range tests {
+
+		actual := fmt.Sprintf(test.Format, test.Bool)
+		if expected := test.Expected; expected != actual {
+			t.Errorf("For test #%d, for print verb, did not actually get what was expected.", testNumber)
+			t.Logf("\tEXPECTED: %q", expected)
+			t.Logf("\tACTUAL:   %q", actual)
+			t.Logf("\tFormat: %q", test.Format)
+			t.Logf("\tBool: %#v", test.Bool)
+			continue
+		}
+
+	}
+}
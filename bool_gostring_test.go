@@ -0,0 +1,40 @@
+package mdl_test
+
+import (
+	"github.com/reiver/go-mdl"
+
+	"testing"
+)
+
+func TestBoolGoString(t *testing.T) {
+
+	tests := []struct{
+		Value    mdl.Bool
+		Expected string
+	}{
+		{
+			Value:    mdl.NoBool(),
+			Expected: "mdl.NoBool()",
+		},
+		{
+			Value:    mdl.SomeBool(false),
+			Expected: "mdl.SomeBool(false)",
+		},
+		{
+			Value:    mdl.SomeBool(true),
+			Expected: "mdl.SomeBool(true)",
+		},
+	}
+
+	for testNumber, test := range tests {
+
+		actual := test.Value.GoString()
+
+		if expected := test.Expected; expected != actual {
+			t.Errorf("For test #%d ...", testNumber)
+			t.Errorf("\tEXPECTED: %#v", expected)
+			t.Errorf("\tACTUAL:   %#v", actual)
+			continue
+		}
+	}
+}
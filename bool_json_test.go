@@ -0,0 +1,90 @@
+package mdl_test
+
+import (
+	"github.com/reiver/go-mdl"
+
+	"encoding/json"
+	"testing"
+)
+
+func TestBoolJSON(t *testing.T) {
+
+	tests := []struct{
+		Datum    mdl.Bool
+		Expected string
+	}{
+		{
+			Datum:    mdl.NoBool(),
+			Expected: `null`,
+		},
+		{
+			Datum:    mdl.SomeBool(false),
+			Expected: `false`,
+		},
+		{
+			Datum:    mdl.SomeBool(true),
+			Expected: `true`,
+		},
+	}
+
+	for testNumber, test := range tests {
+
+		data, err := json.Marshal(test.Datum)
+		if nil != err {
+			t.Errorf("For test #%d, did not expect an error, but actually got one: (%T) %q", testNumber, err, err)
+			continue
+		}
+
+		if expected, actual := test.Expected, string(data); expected != actual {
+			t.Errorf("For test #%d, expected %s, but actually got %s.", testNumber, expected, actual)
+			continue
+		}
+
+		var actual mdl.Bool = mdl.SomeBool(false)
+		if mdl.SomeBool(false) == test.Datum {
+			actual = mdl.SomeBool(true)
+		}
+		if err := json.Unmarshal(data, &actual); nil != err {
+			t.Errorf("For test #%d, did not expect an error, but actually got one: (%T) %q", testNumber, err, err)
+			continue
+		}
+
+		if expected := test.Datum; expected != actual {
+			t.Errorf("For test #%d, expected %#v, but actually got %#v.", testNumber, expected, actual)
+			continue
+		}
+	}
+}
+
+func TestBoolJSONStruct(t *testing.T) {
+
+	type Settings struct {
+		Admin     mdl.Bool `json:"admin"`
+		Verified  mdl.Bool `json:"verified"`
+		Suspended mdl.Bool `json:"suspended"`
+	}
+
+	var actual Settings
+	if err := json.Unmarshal([]byte(`{"admin":false,"verified":true,"suspended":null}`), &actual); nil != err {
+		t.Errorf("Did not expect an error, but actually got one: (%T) %q", err, err)
+		return
+	}
+
+	expected := Settings{
+		Admin:     mdl.SomeBool(false),
+		Verified:  mdl.SomeBool(true),
+		Suspended: mdl.NoBool(),
+	}
+
+	if expected != actual {
+		t.Errorf("Expected %#v, but actually got %#v.", expected, actual)
+		return
+	}
+
+	for testNumber, data := range []string{ `{"admin":"true"}`, `{"admin":1}` } {
+		if err := json.Unmarshal([]byte(data), &actual); nil == err {
+			t.Errorf("For test #%d, expected an error for %s, but did not actually get one.", testNumber, data)
+			continue
+		}
+	}
+}
@@ -0,0 +1,49 @@
+package mdl_test
+
+import (
+	"github.com/reiver/go-mdl"
+
+	"testing"
+)
+
+func TestBoolMap(t *testing.T) {
+
+	not := func(b bool) bool {
+		return !b
+	}
+
+	tests := []struct{
+		Value    mdl.Bool
+		Func     func(bool)bool
+		Expected mdl.Bool
+	}{
+		{
+			Value:    mdl.NoBool(),
+			Func:     not,
+			Expected: mdl.NoBool(),
+		},
+		{
+			Value:    mdl.SomeBool(false),
+			Func:     not,
+			Expected: mdl.SomeBool(true),
+		},
+		{
+			Value:    mdl.SomeBool(true),
+			Func:     not,
+			Expected: mdl.SomeBool(false),
+		},
+	}
+
+	for testNumber, test := range tests {
+
+		actual := test.Value.Map(test.Func)
+
+		if expected := test.Expected; expected != actual {
+			t.Errorf("For test #%d ...", testNumber)
+			t.Errorf("\tVALUE:    %#v", test.Value)
+			t.Errorf("\tEXPECTED: %#v", expected)
+			t.Errorf("\tACTUAL:   %#v", actual)
+			continue
+		}
+	}
+}
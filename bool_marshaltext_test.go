@@ -0,0 +1,42 @@
+package mdl_test
+
+import (
+	"github.com/reiver/go-mdl"
+
+	"testing"
+)
+
+func TestBoolMarshalText(t *testing.T) {
+
+	tests := []struct{
+		Value    mdl.Bool
+		Expected string
+	}{
+		{
+			Value:    mdl.SomeBool(false),
+			Expected: "false",
+		},
+		{
+			Value:    mdl.SomeBool(true),
+			Expected: "true",
+		},
+	}
+
+	for testNumber, test := range tests {
+
+		text, err := test.Value.MarshalText()
+		if nil != err {
+			t.Errorf("For test #%d, did not expect an error, but actually got one: (%T) %q", testNumber, err, err)
+			continue
+		}
+
+		if expected, actual := test.Expected, string(text); expected != actual {
+			t.Errorf("For test #%d, expected %q, but actually got %q.", testNumber, expected, actual)
+			continue
+		}
+	}
+
+	if _, err := mdl.NoBool().MarshalText(); nil == err {
+		t.Errorf("Expected an error for mdl.NoBool(), but did not actually get one.")
+	}
+}
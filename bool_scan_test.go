@@ -0,0 +1,122 @@
+package mdl_test
+
+import (
+	"github.com/reiver/go-mdl"
+
+	"testing"
+)
+
+func TestBoolScan(t *testing.T) {
+
+	tests := []struct{
+		Datum    interface{}
+		Expected mdl.Bool
+	}{
+		{
+			Datum:    nil,
+			Expected: mdl.NoBool(),
+		},
+		{
+			Datum:    []byte(nil),
+			Expected: mdl.NoBool(),
+		},
+
+
+
+		{
+			Datum:    mdl.NoBool(),
+			Expected: mdl.NoBool(),
+		},
+		{
+			Datum:    mdl.SomeBool(false),
+			Expected: mdl.SomeBool(false),
+		},
+
+
+
+		{
+			Datum:                 "true",
+			Expected: mdl.SomeBool(true),
+		},
+		{
+			Datum:          []byte("false"),
+			Expected: mdl.SomeBool(false),
+		},
+		{
+			Datum:                 "t",
+			Expected: mdl.SomeBool(true),
+		},
+		{
+			Datum:                 "0",
+			Expected: mdl.SomeBool(false),
+		},
+
+
+
+		{
+			Datum:                  true,
+			Expected: mdl.SomeBool(true),
+		},
+
+
+
+		// Some databases (such as MySQL, and SQLite) store booleans as 0 or 1.
+		{
+			Datum:             int64(0),
+			Expected: mdl.SomeBool(false),
+		},
+		{
+			Datum:             int64(1),
+			Expected: mdl.SomeBool(true),
+		},
+	}
+
+	for testNumber, test := range tests {
+
+		var actual mdl.Bool = mdl.SomeBool(true)
+		if expected := test.Expected; mdl.SomeBool(true) == expected {
+			actual = mdl.SomeBool(false)
+		}
+
+		err := actual.Scan(test.Datum)
+
+		if expected := test.Expected; nil != err {
+			t.Errorf("For test #%d, did not expect an error, but actually got one: (%T) %q", testNumber, err, err)
+			t.Errorf("\tDATUM: (%T) %#v", test.Datum, test.Datum)
+			t.Errorf("\tEXPECTED: %#v", expected)
+			t.Errorf("\tACTUAL:   %#v", actual)
+			continue
+		}
+
+		if expected := test.Expected; expected != actual {
+			t.Errorf("For test #%d ...", testNumber)
+			t.Errorf("\tDATUM: (%T) %#v", test.Datum, test.Datum)
+			t.Errorf("\tEXPECTED: %#v", expected)
+			t.Errorf("\tACTUAL:   %#v", actual)
+			continue
+		}
+	}
+}
+
+func TestBoolScanError(t *testing.T) {
+
+	tests := []interface{}{
+		int64(2),
+		int64(-1),
+		"yes",
+		"",
+		1.0,
+	}
+
+	for testNumber, datum := range tests {
+
+		var actual mdl.Bool
+
+		if err := actual.Scan(datum); nil == err {
+			t.Errorf("For test #%d, expected an error, but did not actually get one.", testNumber)
+			t.Errorf("\tDATUM: (%T) %#v", datum, datum)
+			t.Errorf("\tACTUAL: %#v", actual)
+			continue
+		}
+	}
+}
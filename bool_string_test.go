@@ -0,0 +1,62 @@
+package mdl_test
+
+import (
+	"github.com/reiver/go-mdl"
+
+	"testing"
+)
+
+func TestBoolString(t *testing.T) {
+
+	tests := []struct{
+		Value          mdl.Bool
+		ExpectedLoaded bool
+		Expected       string
+	}{
+		{
+			Value: mdl.NoBool(),
+			ExpectedLoaded: false,
+			Expected:                 "",
+		},
+		{
+			Value: mdl.SomeBool(false),
+			ExpectedLoaded: true,
+			Expected:                 "false",
+		},
+		{
+			Value: mdl.SomeBool(true),
+			ExpectedLoaded: true,
+			Expected:                 "true",
+		},
+	}
+
+	for testNumber, test := range tests {
+
+		actual, err := test.Value.String()
+
+		if !test.ExpectedLoaded && nil == err {
+			t.Errorf("For test #%d, expected an error, but did not actually get one: %#v", testNumber, err)
+			t.Errorf("\tEXPECTED loaded: %t", test.ExpectedLoaded)
+			t.Errorf("\tEXPECTED: %#v", test.Expected)
+			t.Errorf("\tACTUAL:   %#v", actual)
+			continue
+		}
+
+		if test.ExpectedLoaded && nil != err {
+			t.Errorf("For test #%d, did not expect an error, but actually got one: (%T) %q", testNumber, err, err)
+			t.Errorf("\tEXPECTED loaded: %t", test.ExpectedLoaded)
+			t.Errorf("\tEXPECTED: %#v", test.Expected)
+			t.Errorf("\tACTUAL:   %#v", actual)
+			continue
+		}
+
+		if expected := test.Expected; expected != actual {
+			t.Errorf("For test #%d ...", testNumber)
+			t.Errorf("\tEXPECTED loaded: %t", test.ExpectedLoaded)
+			t.Errorf("\tACTUAL   err:   (%T) %q", err, err)
+			t.Errorf("\tEXPECTED: %#v", expected)
+			t.Errorf("\tACTUAL:   %#v", actual)
+			continue
+		}
+	}
+}
@@ -0,0 +1,53 @@
+package mdl_test
+
+import (
+	"github.com/reiver/go-mdl"
+
+	"testing"
+)
+
+func TestBoolThen(t *testing.T) {
+
+	// Only true counts; false is treated as if it was never set.
+	onlyTrue := func(b bool) mdl.Bool {
+		if !b {
+			return mdl.NoBool()
+		}
+		return mdl.SomeBool(b)
+	}
+
+	tests := []struct{
+		Value    mdl.Bool
+		Func     func(bool)mdl.Bool
+		Expected mdl.Bool
+	}{
+		{
+			Value:    mdl.NoBool(),
+			Func:     onlyTrue,
+			Expected: mdl.NoBool(),
+		},
+		{
+			Value:    mdl.SomeBool(false),
+			Func:     onlyTrue,
+			Expected: mdl.NoBool(),
+		},
+		{
+			Value:    mdl.SomeBool(true),
+			Func:     onlyTrue,
+			Expected: mdl.SomeBool(true),
+		},
+	}
+
+	for testNumber, test := range tests {
+
+		actual := test.Value.Then(test.Func)
+
+		if expected := test.Expected; expected != actual {
+			t.Errorf("For test #%d ...", testNumber)
+			t.Errorf("\tVALUE:    %#v", test.Value)
+			t.Errorf("\tEXPECTED: %#v", expected)
+			t.Errorf("\tACTUAL:   %#v", actual)
+			continue
+		}
+	}
+}
@@ -0,0 +1,75 @@
+package mdl_test
+
+import (
+	"github.com/reiver/go-mdl"
+
+	"testing"
+)
+
+func TestBoolUnmarshalText(t *testing.T) {
+
+	tests := []struct{
+		Datum    []byte
+		Expected mdl.Bool
+	}{
+		{
+			Datum:      []byte(nil),
+			Expected: mdl.NoBool(),
+		},
+
+
+
+		{
+			Datum:      []byte("true"),
+			Expected: mdl.SomeBool(true),
+		},
+		{
+			Datum:      []byte("TRUE"),
+			Expected: mdl.SomeBool(true),
+		},
+		{
+			Datum:      []byte("1"),
+			Expected: mdl.SomeBool(true),
+		},
+		{
+			Datum:      []byte("false"),
+			Expected: mdl.SomeBool(false),
+		},
+		{
+			Datum:      []byte("F"),
+			Expected: mdl.SomeBool(false),
+		},
+	}
+
+	for testNumber, test := range tests {
+
+		var actual mdl.Bool
+
+		err := actual.UnmarshalText(test.Datum)
+
+		if expected := test.Expected; nil != err {
+			t.Errorf("For test #%d, did not expect an error, but actually got one: (%T) %q", testNumber, err, err)
+			t.Errorf("\tDATUM: %q", test.Datum)
+			t.Errorf("\tEXPECTED: %#v", expected)
+			t.Errorf("\tACTUAL:   %#v", actual)
+			continue
+		}
+
+		if expected := test.Expected; expected != actual {
+			t.Errorf("For test #%d ...", testNumber)
+			t.Errorf("\tDATUM: %q", test.Datum)
+			t.Errorf("\tEXPECTED: %#v", expected)
+			t.Errorf("\tACTUAL:   %#v", actual)
+			continue
+		}
+	}
+
+	for testNumber, datum := range []string{"", "yes", "no", "on"} {
+
+		var actual mdl.Bool
+		if err := actual.UnmarshalText([]byte(datum)); nil == err {
+			t.Errorf("For bad text #%d (%q), expected an error, but did not actually get one.", testNumber, datum)
+			continue
+		}
+	}
+}
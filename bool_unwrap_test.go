@@ -0,0 +1,63 @@
+package mdl_test
+
+import (
+	"github.com/reiver/go-mdl"
+
+	"testing"
+)
+
+func TestBoolUnwrap(t *testing.T) {
+
+	tests := []struct{
+		Value          mdl.Bool
+		ExpectedLoaded bool
+		Expected       bool
+	}{
+		{
+			Value: mdl.NoBool(),
+			ExpectedLoaded: false,
+			Expected:                 false,
+		},
+		{
+			Value: mdl.Bool{},
+			ExpectedLoaded: false,
+			Expected:                 false,
+		},
+
+
+
+		{
+			Value: mdl.SomeBool(false),
+			ExpectedLoaded: true,
+			Expected:                 false,
+		},
+		{
+			Value: mdl.SomeBool(true),
+			ExpectedLoaded: true,
+			Expected:                 true,
+		},
+	}
+
+	for testNumber, test := range tests {
+
+		actual, actualLoaded := test.Value.Unwrap()
+
+		if expected, expectedLoaded := test.Expected, test.ExpectedLoaded; expectedLoaded != actualLoaded {
+			t.Errorf("For test #%d ...", testNumber)
+			t.Errorf("\tEXPECTED loaded: %t", expectedLoaded)
+			t.Errorf("\tACTUAL   loaded: %t", actualLoaded)
+			t.Errorf("\tEXPECTED: %t", expected)
+			t.Errorf("\tACTUAL:   %t", actual)
+			continue
+		}
+
+		if expected, expectedLoaded := test.Expected, test.ExpectedLoaded; expected != actual {
+			t.Errorf("For test #%d ...", testNumber)
+			t.Errorf("\tEXPECTED loaded: %t", expectedLoaded)
+			t.Errorf("\tACTUAL   loaded: %t", actualLoaded)
+			t.Errorf("\tEXPECTED: %t", expected)
+			t.Errorf("\tACTUAL:   %t", actual)
+			continue
+		}
+	}
+}
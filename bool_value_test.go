@@ -0,0 +1,73 @@
+package mdl_test
+
+import (
+	"github.com/reiver/go-mdl"
+
+	"testing"
+)
+
+func TestBoolValue(t *testing.T) {
+
+	tests := []struct{
+		Value          mdl.Bool
+		ExpectedLoaded bool
+		Expected       bool
+	}{
+		{
+			Value: mdl.NoBool(),
+			ExpectedLoaded: false,
+			Expected:                 false,
+		},
+		{
+			Value: mdl.SomeBool(false),
+			ExpectedLoaded: true,
+			Expected:                 false,
+		},
+		{
+			Value: mdl.SomeBool(true),
+			ExpectedLoaded: true,
+			Expected:                 true,
+		},
+	}
+
+	for testNumber, test := range tests {
+
+		actualInterface, err := test.Value.Value()
+
+		if !test.ExpectedLoaded && nil == err {
+			t.Errorf("For test #%d, expected an error, but did not actually get one: %#v", testNumber, err)
+			t.Errorf("\tEXPECTED loaded: %t", test.ExpectedLoaded)
+			t.Errorf("\tEXPECTED: %#v", test.Expected)
+			t.Errorf("\tACTUAL: %T %#v", actualInterface, actualInterface)
+			continue
+		}
+
+		if test.ExpectedLoaded && nil != err {
+			t.Errorf("For test #%d, did not expect an error, but actually got one: (%T) %q", testNumber, err, err)
+			t.Errorf("\tEXPECTED loaded: %t", test.ExpectedLoaded)
+			t.Errorf("\tEXPECTED: %#v", test.Expected)
+			t.Errorf("\tACTUAL: %T %#v", actualInterface, actualInterface)
+			continue
+		}
+
+		if !test.ExpectedLoaded {
+			continue
+		}
+		actual, casted := actualInterface.(bool)
+		if !casted {
+			t.Errorf("For test #%d, expected type ‘bool’, but actually got type ‘%T’.", testNumber, actualInterface)
+			t.Errorf("\tEXPECTED loaded: %t", test.ExpectedLoaded)
+			t.Errorf("\tEXPECTED: %#v", test.Expected)
+			t.Errorf("\tACTUAL: %T %#v", actualInterface, actualInterface)
+			continue
+		}
+
+		if expected := test.Expected; expected != actual {
+			t.Errorf("For test #%d ...", testNumber)
+			t.Errorf("\tEXPECTED loaded: %t", test.ExpectedLoaded)
+			t.Errorf("\tEXPECTED: %#v", expected)
+			t.Errorf("\tACTUAL:   %#v", actual)
+			continue
+		}
+	}
+}
@@ -0,0 +1,243 @@
+package mdl
+
+import (
+	"database/sql/driver"
+	"encoding/json"
+	"fmt"
+	"strings"
+)
+
+// Bytes is a []byte option type.
+//
+// It can have 2 kinds of value:
+//
+// • no []byte (i.e., ‘nothing’),
+//
+// • some []byte (i.e., ‘something’).
+//
+// Like with ‘mdl.String’, the value in this type is that it can differentiate between being assigned an empty []byte,
+// and a variable that hasn't been loaded.
+type Bytes struct {
+	datum  string
+	loaded bool
+}
+
+// NoBytes returns a mdl.Bytes which has no value (i.e., ‘nothing’).
+//
+// Note that this is not the same thing as an empty []byte!
+// That is mdl.SomeBytes([]byte{}).
+//
+// Example
+//
+//	var datum mdl.Bytes
+//	
+//	// ...
+//	
+//	if mdl.NoBytes() == datum {
+//		//@TODO
+//	}
+func NoBytes() Bytes {
+	return Bytes{}
+}
+
+// SomeBytes returns a mdl.Bytes which has some value (i.e., ‘something’).
+//
+// Example
+//
+//	var datum mdl.Bytes
+//	
+//	// ...
+//	
+//	datum = mdl.SomeBytes([]byte("Hello world!"))
+func SomeBytes(datum []byte) Bytes {
+	return Bytes{
+		loaded: true,
+		datum:  string(datum),
+	}
+}
+
+func (receiver Bytes) Else(datum []byte) Bytes {
+	if NoBytes() != receiver {
+		return receiver
+	}
+
+	return SomeBytes(datum)
+}
+
+func (receiver Bytes) ElseUnwrap(datum []byte) []byte {
+	if NoBytes() == receiver {
+		return datum
+	}
+
+	return []byte(receiver.datum)
+}
+
+// Format makes ‘mdl.Bytes’ fit the ‘fmt.Formatter’ interface.
+//
+// The %s, %x, %X, and %v verbs format the value the same way they would format a []byte.
+// The %q verb formats its text form (see .MarshalText()) as a quoted string, and the %#v verb formats it with .GoString().
+func (receiver Bytes) Format(f fmt.State, c rune) {
+	switch {
+	case 'v' == c && f.Flag('#'):
+		fmt.Fprint(f, receiver.GoString())
+	case NoBytes() == receiver && 'q' == c:
+		fmt.Fprint(f, "«no-bytes»")
+	case NoBytes() == receiver:
+		fmt.Fprintf(f, "%%!%s(%s)", string(c), receiver.GoString())
+	case 'q' == c:
+		fmt.Fprintf(f, "%q", receiver.datum)
+	case strings.ContainsRune("sxXv", c):
+		fmt.Fprintf(f, optionFormatDirective(f, c), []byte(receiver.datum))
+	default:
+		fmt.Fprintf(f, "%%!%s(%s)", string(c), receiver.GoString())
+	}
+}
+
+// GoString makes mdl.Bytes fit the fmt.GoStringer interface.
+//
+// It gets used with the %#v verb with the printing family of functions
+// in the Go built-in "fmt" package.
+func (receiver Bytes) GoString() string {
+	if NoBytes() == receiver {
+		return "mdl.NoBytes()"
+	}
+
+	return fmt.Sprintf("mdl.SomeBytes([]byte(%q))", receiver.datum)
+}
+
+// MarshalJSON makes mdl.Bytes fit the encoding/json.Marshaler interface.
+//
+// mdl.NoBytes() is marshaled as JSON null, and mdl.SomeBytes() is marshaled as a JSON string of the bytes in base64 (the same as encoding/json does for a []byte).
+func (receiver Bytes) MarshalJSON() ([]byte, error) {
+	if NoBytes() == receiver {
+		return []byte("null"), nil
+	}
+
+	return json.Marshal([]byte(receiver.datum))
+}
+
+// MarshalText makes mdl.Bytes fit the encoding.TextMarshaler interface.
+//
+// mdl.SomeBytes() is marshaled as the bytes, as is.
+// Since text has no way of saying ‘nothing’, mdl.NoBytes() cannot be marshaled as text, and returns an error.
+func (receiver Bytes) MarshalText() ([]byte, error) {
+	if NoBytes() == receiver {
+		return nil, errNotLoaded
+	}
+
+	return []byte(receiver.datum), nil
+}
+
+func (receiver Bytes) Map(fn func([]byte)[]byte) Bytes {
+	if NoBytes() == receiver {
+		return receiver
+	}
+
+	return SomeBytes(fn([]byte(receiver.datum)))
+}
+
+// Scan makes mdl.Bytes fit the database/sql.Scanner interface.
+//
+// A nil source (i.e., SQL NULL) scans as mdl.NoBytes().
+// The source can be a []byte, a string, or an ‘mdl.Bytes’. (The bytes are copied.)
+func (receiver *Bytes) Scan(src interface{}) error {
+	if nil == receiver {
+		return errNilReceiver
+	}
+
+	switch casted := src.(type) {
+	case nil:
+		*receiver = NoBytes()
+		return nil
+	case Bytes:
+		*receiver = casted
+		return nil
+	case string:
+		return receiver.scanText(casted)
+	case []byte:
+		if nil == casted {
+			*receiver = NoBytes()
+			return nil
+		}
+		return receiver.scanText(string(casted))
+	default:
+		return unsupportedSource(src)
+	}
+}
+
+// String returns the text form of the value (see .MarshalText()).
+func (receiver Bytes) String() (string, error) {
+	if NoBytes() == receiver {
+		return "", errNotLoaded
+	}
+
+	return receiver.datum, nil
+}
+
+func (receiver Bytes) Then(fn func([]byte)Bytes) Bytes {
+	if NoBytes() == receiver {
+		return receiver
+	}
+
+	return fn([]byte(receiver.datum))
+}
+
+// UnmarshalJSON makes mdl.Bytes fit the encoding/json.Unmarshaler interface.
+//
+// It is the opposite of .MarshalJSON(); i.e., JSON null is unmarshaled as mdl.NoBytes().
+func (receiver *Bytes) UnmarshalJSON(data []byte) error {
+	if nil == receiver {
+		return errNilReceiver
+	}
+
+	if "null" == string(data) {
+		*receiver = NoBytes()
+		return nil
+	}
+
+	var datum []byte
+	if err := json.Unmarshal(data, &datum); nil != err {
+		return err
+	}
+
+	*receiver = SomeBytes(datum)
+	return nil
+}
+
+// UnmarshalText makes mdl.Bytes fit the encoding.TextUnmarshaler interface.
+//
+// It is the opposite of .MarshalText(). (A nil text is unmarshaled as mdl.NoBytes().)
+func (receiver *Bytes) UnmarshalText(text []byte) error {
+	if nil == receiver {
+		return errNilReceiver
+	}
+
+	if nil == text {
+		*receiver = NoBytes()
+		return nil
+	}
+
+	return receiver.scanText(string(text))
+}
+
+func (receiver Bytes) Unwrap() ([]byte, bool) {
+	return []byte(receiver.datum), receiver.loaded
+}
+
+// Value makes mdl.Bytes fit the database/sql/driver.Valuer interface.
+func (receiver Bytes) Value() (driver.Value, error) {
+	if NoBytes() == receiver {
+		return nil, errNotLoaded
+	}
+
+	return []byte(receiver.datum), nil
+}
+
+func (receiver Bytes) isNothing() bool {
+	return NoBytes() == receiver
+}
+
+func (receiver *Bytes) scanText(text string) error {
+	*receiver = SomeBytes([]byte(text))
+	return nil
+}
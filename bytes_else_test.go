@@ -0,0 +1,54 @@
+package mdl_test
+
+import (
+	"github.com/reiver/go-mdl"
+
+	"testing"
+)
+
+func TestBytesElse(t *testing.T) {
+
+	tests := []struct{
+		Value    mdl.Bytes
+		Else     []byte
+		Expected mdl.Bytes
+	}{
+		{
+			Value:    mdl.NoBytes(),
+			Else:                   []byte{0x00, 0xff},
+			Expected: mdl.SomeBytes([]byte{0x00, 0xff}),
+		},
+		{
+			Value:    mdl.NoBytes(),
+			Else:                   []byte{},
+			Expected: mdl.SomeBytes([]byte{}),
+		},
+
+
+
+		{
+			Value:    mdl.SomeBytes([]byte{}),
+			Else:                   []byte{0x00, 0xff},
+			Expected: mdl.SomeBytes([]byte{}),
+		},
+		{
+			Value:    mdl.SomeBytes([]byte("Hello world!")),
+			Else:                   []byte{0x00, 0xff},
+			Expected: mdl.SomeBytes([]byte("Hello world!")),
+		},
+	}
+
+	for testNumber, test := range tests {
+
+		actual := test.Value.Else(test.Else)
+
+		if expected := test.Expected; expected != actual {
+			t.Errorf("For test #%d ...", testNumber)
+			t.Errorf("\tVALUE:    %#v", test.Value)
+			t.Errorf("\tELSE:                   %#v", test.Else)
+			t.Errorf("\tEXPECTED: %#v", expected)
+			t.Errorf("\tACTUAL:   %#v", actual)
+			continue
+		}
+	}
+}
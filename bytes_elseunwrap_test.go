@@ -0,0 +1,55 @@
+package mdl_test
+
+import (
+	"github.com/reiver/go-mdl"
+
+	"bytes"
+	"testing"
+)
+
+func TestBytesElseUnwrap(t *testing.T) {
+
+	tests := []struct{
+		Value    mdl.Bytes
+		Else     []byte
+		Expected []byte
+	}{
+		{
+			Value:    mdl.NoBytes(),
+			Else:                   []byte{0x00, 0xff},
+			Expected:               []byte{0x00, 0xff},
+		},
+		{
+			Value:    mdl.NoBytes(),
+			Else:                   []byte{},
+			Expected:               []byte{},
+		},
+
+
+
+		{
+			Value:    mdl.SomeBytes([]byte{}),
+			Else:                   []byte{0x00, 0xff},
+			Expected:               []byte{},
+		},
+		{
+			Value:    mdl.SomeBytes([]byte("Hello world!")),
+			Else:                   []byte{0x00, 0xff},
+			Expected:               []byte("Hello world!"),
+		},
+	}
+
+	for testNumber, test := range tests {
+
+		actual := test.Value.ElseUnwrap(test.Else)
+
+		if expected := test.Expected; !bytes.Equal(expected, actual) {
+			t.Errorf("For test #%d ...", testNumber)
+			t.Errorf("\tVALUE: %#v", test.Value)
+			t.Errorf("\tELSE:               %#v", test.Else)
+			t.Errorf("\tEXPECTED:           %#v", expected)
+			t.Errorf("\tACTUAL:             %#v", actual)
+			continue
+		}
+	}
+}
@@ -0,0 +1,89 @@
+package mdl_test
+
+import (
+	"github.com/reiver/go-mdl"
+
+	"fmt"
+
+	"testing"
+)
+
+func TestBytesFormat(t *testing.T) {
+
+	tests := []struct{
+		Format   string
+		Bytes    mdl.Bytes
+		Expected string
+	}{
+		{
+			Format: "%q",
+			Bytes:  mdl.NoBytes(),
+			Expected:      `«no-bytes»`,
+		},
+		{
+			Format: "%q",
+			Bytes:  mdl.SomeBytes([]byte("Hello world!")),
+			Expected:      `"Hello world!"`,
+		},
+		{
+			Format: "%q",
+			Bytes:  mdl.SomeBytes([]byte{0x00, 0xff}),
+			Expected:      `"\x00\xff"`,
+		},
+
+
+
+		{
+			Format: "%s",
+			Bytes:  mdl.SomeBytes([]byte("Hello world!")),
+			Expected:      `Hello world!`,
+		},
+		{
+			Format: "%x",
+			Bytes:  mdl.SomeBytes([]byte("Hi!")),
+			Expected:      `486921`,
+		},
+		{
+			Format: "%X",
+			Bytes:  mdl.SomeBytes([]byte{0x00, 0xff}),
+			Expected:      `00FF`,
+		},
+		{
+			Format: "%v",
+			Bytes:  mdl.SomeBytes([]byte{0x00, 0xff}),
+			Expected:      `[0 255]`,
+		},
+		{
+			Format: "%8s|",
+			Bytes:  mdl.SomeBytes([]byte("Hi!")),
+			Expected:      `     Hi!|`,
+		},
+
+
+
+		{
+			Format: "%s",
+			Bytes:  mdl.NoBytes(),
+			Expected:      `%!s(mdl.NoBytes())`,
+		},
+		{
+			Format: "%d",
+			Bytes:  mdl.SomeBytes([]byte("Hi!")),
+			Expected:      `%!d(mdl.SomeBytes([]byte("Hi!")))`,
+		},
+	}
+
+	for testNumber, test := range tests {
+
+		actual := fmt.Sprintf(test.Format, test.Bytes)
+		if expected := test.Expected; expected != actual {
+			t.Errorf("For test #%d, for print verb, did not actually get what was expected.", testNumber)
+			t.Logf("\tEXPECTED: %q", expected)
+			t.Logf("\tACTUAL:   %q", actual)
+			t.Logf("\tFormat: %q", test.Format)
+			t.Logf("\tBytes: %#v", test.Bytes)
+			continue
+		}
+
+	}
+}
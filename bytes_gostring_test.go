@@ -0,0 +1,44 @@
+package mdl_test
+
+import (
+	"github.com/reiver/go-mdl"
+
+	"testing"
+)
+
+func TestBytesGoString(t *testing.T) {
+
+	tests := []struct{
+		Value    mdl.Bytes
+		Expected string
+	}{
+		{
+			Value:    mdl.NoBytes(),
+			Expected: `mdl.NoBytes()`,
+		},
+		{
+			Value:    mdl.SomeBytes([]byte{}),
+			Expected: `mdl.SomeBytes([]byte(""))`,
+		},
+		{
+			Value:    mdl.SomeBytes([]byte("Hello world!")),
+			Expected: `mdl.SomeBytes([]byte("Hello world!"))`,
+		},
+		{
+			Value:    mdl.SomeBytes([]byte{0x00, 0xff}),
+			Expected: `mdl.SomeBytes([]byte("\x00\xff"))`,
+		},
+	}
+
+	for testNumber, test := range tests {
+
+		actual := test.Value.GoString()
+
+		if expected := test.Expected; expected != actual {
+			t.Errorf("For test #%d ...", testNumber)
+			t.Errorf("\tEXPECTED: %#v", expected)
+			t.Errorf("\tACTUAL:   %#v", actual)
+			continue
+		}
+	}
+}
@@ -0,0 +1,71 @@
+package mdl_test
+
+import (
+	"github.com/reiver/go-mdl"
+
+	"encoding/json"
+	"testing"
+)
+
+func TestBytesJSON(t *testing.T) {
+
+	tests := []struct{
+		Datum    mdl.Bytes
+		Expected string
+	}{
+		{
+			Datum:    mdl.NoBytes(),
+			Expected: `null`,
+		},
+		{
+			Datum:    mdl.SomeBytes([]byte{}),
+			Expected: `""`,
+		},
+		{
+			Datum:    mdl.SomeBytes([]byte("Hello world!")),
+			Expected: `"SGVsbG8gd29ybGQh"`,
+		},
+		{
+			Datum:    mdl.SomeBytes([]byte{0x00, 0xff}),
+			Expected: `"AP8="`,
+		},
+	}
+
+	for testNumber, test := range tests {
+
+		data, err := json.Marshal(test.Datum)
+		if nil != err {
+			t.Errorf("For test #%d, did not expect an error, but actually got one: (%T) %q", testNumber, err, err)
+			continue
+		}
+
+		if expected, actual := test.Expected, string(data); expected != actual {
+			t.Errorf("For test #%d, expected %s, but actually got %s.", testNumber, expected, actual)
+			continue
+		}
+
+		var actual mdl.Bytes = mdl.SomeBytes([]byte("something else"))
+		if err := json.Unmarshal(data, &actual); nil != err {
+			t.Errorf("For test #%d, did not expect an error, but actually got one: (%T) %q", testNumber, err, err)
+			continue
+		}
+
+		if expected := test.Datum; expected != actual {
+			t.Errorf("For test #%d, expected %#v, but actually got %#v.", testNumber, expected, actual)
+			continue
+		}
+	}
+}
+
+func TestBytesJSONError(t *testing.T) {
+
+	for testNumber, data := range []string{ `5`, `true`, `"not base64!"` } {
+
+		var actual mdl.Bytes
+		if err := json.Unmarshal([]byte(data), &actual); nil == err {
+			t.Errorf("For test #%d, expected an error for %s, but did not actually get one.", testNumber, data)
+			t.Errorf("\tACTUAL: %#v", actual)
+			continue
+		}
+	}
+}
@@ -0,0 +1,46 @@
+package mdl_test
+
+import (
+	"github.com/reiver/go-mdl"
+
+	"bytes"
+	"testing"
+)
+
+func TestBytesMap(t *testing.T) {
+
+	tests := []struct{
+		Value    mdl.Bytes
+		Func     func([]byte)[]byte
+		Expected mdl.Bytes
+	}{
+		{
+			Value:    mdl.NoBytes(),
+			Func:     bytes.ToUpper,
+			Expected: mdl.NoBytes(),
+		},
+		{
+			Value:    mdl.SomeBytes([]byte{}),
+			Func:     bytes.ToUpper,
+			Expected: mdl.SomeBytes([]byte{}),
+		},
+		{
+			Value:    mdl.SomeBytes([]byte("Hello world!")),
+			Func:     bytes.ToUpper,
+			Expected: mdl.SomeBytes([]byte("HELLO WORLD!")),
+		},
+	}
+
+	for testNumber, test := range tests {
+
+		actual := test.Value.Map(test.Func)
+
+		if expected := test.Expected; expected != actual {
+			t.Errorf("For test #%d ...", testNumber)
+			t.Errorf("\tVALUE:    %#v", test.Value)
+			t.Errorf("\tEXPECTED: %#v", expected)
+			t.Errorf("\tACTUAL:   %#v", actual)
+			continue
+		}
+	}
+}
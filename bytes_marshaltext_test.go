@@ -0,0 +1,46 @@
+package mdl_test
+
+import (
+	"github.com/reiver/go-mdl"
+
+	"testing"
+)
+
+func TestBytesMarshalText(t *testing.T) {
+
+	tests := []struct{
+		Value    mdl.Bytes
+		Expected string
+	}{
+		{
+			Value:    mdl.SomeBytes([]byte{}),
+			Expected: "",
+		},
+		{
+			Value:    mdl.SomeBytes([]byte("Hello world!")),
+			Expected: "Hello world!",
+		},
+		{
+			Value:    mdl.SomeBytes([]byte{0x00, 0xff}),
+			Expected: "\x00\xff",
+		},
+	}
+
+	for testNumber, test := range tests {
+
+		text, err := test.Value.MarshalText()
+		if nil != err {
+			t.Errorf("For test #%d, did not expect an error, but actually got one: (%T) %q", testNumber, err, err)
+			continue
+		}
+
+		if expected, actual := test.Expected, string(text); expected != actual {
+			t.Errorf("For test #%d, expected %q, but actually got %q.", testNumber, expected, actual)
+			continue
+		}
+	}
+
+	if _, err := mdl.NoBytes().MarshalText(); nil == err {
+		t.Errorf("Expected an error for mdl.NoBytes(), but did not actually get one.")
+	}
+}
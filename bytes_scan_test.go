@@ -0,0 +1,122 @@
+package mdl_test
+
+import (
+	"github.com/reiver/go-mdl"
+
+	"testing"
+)
+
+func TestBytesScan(t *testing.T) {
+
+	tests := []struct{
+		Datum    interface{}
+		Expected mdl.Bytes
+	}{
+		{
+			Datum:    nil,
+			Expected: mdl.NoBytes(),
+		},
+		{
+			Datum:    []byte(nil),
+			Expected: mdl.NoBytes(),
+		},
+
+
+
+		{
+			Datum:    mdl.NoBytes(),
+			Expected: mdl.NoBytes(),
+		},
+		{
+			Datum:    mdl.SomeBytes([]byte("Hello world!")),
+			Expected: mdl.SomeBytes([]byte("Hello world!")),
+		},
+
+
+
+		{
+			Datum:                   "Hello world!",
+			Expected: mdl.SomeBytes([]byte("Hello world!")),
+		},
+		{
+			Datum:                   "",
+			Expected: mdl.SomeBytes([]byte{}),
+		},
+
+
+
+		{
+			Datum:            []byte("Hello world!"),
+			Expected: mdl.SomeBytes([]byte("Hello world!")),
+		},
+		{
+			Datum:            []byte{},
+			Expected: mdl.SomeBytes([]byte{}),
+		},
+		{
+			Datum:            []byte{0x00, 0xff},
+			Expected: mdl.SomeBytes([]byte{0x00, 0xff}),
+		},
+	}
+
+	for testNumber, test := range tests {
+
+		var actual mdl.Bytes = mdl.SomeBytes([]byte("something else"))
+
+		err := actual.Scan(test.Datum)
+
+		if expected := test.Expected; nil != err {
+			t.Errorf("For test #%d, did not expect an error, but actually got one: (%T) %q", testNumber, err, err)
+			t.Errorf("\tDATUM: (%T) %#v", test.Datum, test.Datum)
+			t.Errorf("\tEXPECTED: %#v", expected)
+			t.Errorf("\tACTUAL:   %#v", actual)
+			continue
+		}
+
+		if expected := test.Expected; expected != actual {
+			t.Errorf("For test #%d ...", testNumber)
+			t.Errorf("\tDATUM: (%T) %#v", test.Datum, test.Datum)
+			t.Errorf("\tEXPECTED: %#v", expected)
+			t.Errorf("\tACTUAL:   %#v", actual)
+			continue
+		}
+	}
+}
+
+func TestBytesScanCopies(t *testing.T) {
+
+	// Database drivers may reuse the []byte they scan from; so what was scanned must not change with it.
+	var datum []byte = []byte("Hello world!")
+
+	var actual mdl.Bytes
+	if err := actual.Scan(datum); nil != err {
+		t.Fatalf("Did not expect an error, but actually got one: (%T) %q", err, err)
+	}
+
+	datum[0] = 'J'
+
+	if expected := mdl.SomeBytes([]byte("Hello world!")); expected != actual {
+		t.Errorf("Expected %#v, but actually got %#v.", expected, actual)
+	}
+}
+
+func TestBytesScanError(t *testing.T) {
+
+	tests := []interface{}{
+		int64(5),
+		true,
+		1.5,
+	}
+
+	for testNumber, datum := range tests {
+
+		var actual mdl.Bytes
+
+		if err := actual.Scan(datum); nil == err {
+			t.Errorf("For test #%d, expected an error, but did not actually get one.", testNumber)
+			t.Errorf("\tDATUM: (%T) %#v", datum, datum)
+			t.Errorf("\tACTUAL: %#v", actual)
+			continue
+		}
+	}
+}
@@ -0,0 +1,53 @@
+package mdl_test
+
+import (
+	"github.com/reiver/go-mdl"
+
+	"testing"
+)
+
+func TestBytesThen(t *testing.T) {
+
+	// Empty bytes are treated as if they were never set.
+	nonEmpty := func(datum []byte) mdl.Bytes {
+		if 0 == len(datum) {
+			return mdl.NoBytes()
+		}
+		return mdl.SomeBytes(datum)
+	}
+
+	tests := []struct{
+		Value    mdl.Bytes
+		Func     func([]byte)mdl.Bytes
+		Expected mdl.Bytes
+	}{
+		{
+			Value:    mdl.NoBytes(),
+			Func:     nonEmpty,
+			Expected: mdl.NoBytes(),
+		},
+		{
+			Value:    mdl.SomeBytes([]byte{}),
+			Func:     nonEmpty,
+			Expected: mdl.NoBytes(),
+		},
+		{
+			Value:    mdl.SomeBytes([]byte("Hello world!")),
+			Func:     nonEmpty,
+			Expected: mdl.SomeBytes([]byte("Hello world!")),
+		},
+	}
+
+	for testNumber, test := range tests {
+
+		actual := test.Value.Then(test.Func)
+
+		if expected := test.Expected; expected != actual {
+			t.Errorf("For test #%d ...", testNumber)
+			t.Errorf("\tVALUE:    %#v", test.Value)
+			t.Errorf("\tEXPECTED: %#v", expected)
+			t.Errorf("\tACTUAL:   %#v", actual)
+			continue
+		}
+	}
+}
@@ -0,0 +1,58 @@
+package mdl_test
+
+import (
+	"github.com/reiver/go-mdl"
+
+	"testing"
+)
+
+func TestBytesUnmarshalText(t *testing.T) {
+
+	tests := []struct{
+		Datum    []byte
+		Expected mdl.Bytes
+	}{
+		{
+			Datum:                   []byte(nil),
+			Expected: mdl.NoBytes(),
+		},
+
+
+
+		{
+			Datum:                   []byte{},
+			Expected: mdl.SomeBytes([]byte{}),
+		},
+		{
+			Datum:                   []byte("Hello world!"),
+			Expected: mdl.SomeBytes([]byte("Hello world!")),
+		},
+		{
+			Datum:                   []byte{0x00, 0xff},
+			Expected: mdl.SomeBytes([]byte{0x00, 0xff}),
+		},
+	}
+
+	for testNumber, test := range tests {
+
+		var actual mdl.Bytes = mdl.SomeBytes([]byte("something else"))
+
+		err := actual.UnmarshalText(test.Datum)
+
+		if expected := test.Expected; nil != err {
+			t.Errorf("For test #%d, did not expect an error, but actually got one: (%T) %q", testNumber, err, err)
+			t.Errorf("\tDATUM: %q", test.Datum)
+			t.Errorf("\tEXPECTED: %#v", expected)
+			t.Errorf("\tACTUAL:   %#v", actual)
+			continue
+		}
+
+		if expected := test.Expected; expected != actual {
+			t.Errorf("For test #%d ...", testNumber)
+			t.Errorf("\tDATUM: %q", test.Datum)
+			t.Errorf("\tEXPECTED: %#v", expected)
+			t.Errorf("\tACTUAL:   %#v", actual)
+			continue
+		}
+	}
+}
@@ -0,0 +1,69 @@
+package mdl_test
+
+import (
+	"github.com/reiver/go-mdl"
+
+	"bytes"
+	"testing"
+)
+
+func TestBytesUnwrap(t *testing.T) {
+
+	tests := []struct{
+		Value          mdl.Bytes
+		ExpectedLoaded bool
+		Expected       []byte
+	}{
+		{
+			Value: mdl.NoBytes(),
+			ExpectedLoaded: false,
+			Expected:                 nil,
+		},
+		{
+			Value: mdl.Bytes{},
+			ExpectedLoaded: false,
+			Expected:                 nil,
+		},
+
+
+
+		{
+			Value: mdl.SomeBytes([]byte{}),
+			ExpectedLoaded: true,
+			Expected:                 []byte{},
+		},
+		{
+			Value: mdl.SomeBytes([]byte("Hello world!")),
+			ExpectedLoaded: true,
+			Expected:                 []byte("Hello world!"),
+		},
+		{
+			Value: mdl.SomeBytes([]byte{0x00, 0xff}),
+			ExpectedLoaded: true,
+			Expected:                 []byte{0x00, 0xff},
+		},
+	}
+
+	for testNumber, test := range tests {
+
+		actual, actualLoaded := test.Value.Unwrap()
+
+		if expected, expectedLoaded := test.Expected, test.ExpectedLoaded; expectedLoaded != actualLoaded {
+			t.Errorf("For test #%d ...", testNumber)
+			t.Errorf("\tEXPECTED loaded: %t", expectedLoaded)
+			t.Errorf("\tACTUAL   loaded: %t", actualLoaded)
+			t.Errorf("\tEXPECTED: %#v", expected)
+			t.Errorf("\tACTUAL:   %#v", actual)
+			continue
+		}
+
+		if expected, expectedLoaded := test.Expected, test.ExpectedLoaded; !bytes.Equal(expected, actual) {
+			t.Errorf("For test #%d ...", testNumber)
+			t.Errorf("\tEXPECTED loaded: %t", expectedLoaded)
+			t.Errorf("\tACTUAL   loaded: %t", actualLoaded)
+			t.Errorf("\tEXPECTED: %#v", expected)
+			t.Errorf("\tACTUAL:   %#v", actual)
+			continue
+		}
+	}
+}
@@ -0,0 +1,92 @@
+package mdl_test
+
+import (
+	"github.com/reiver/go-mdl"
+
+	"bytes"
+	"testing"
+)
+
+func TestBytesValue(t *testing.T) {
+
+	tests := []struct{
+		Value          mdl.Bytes
+		ExpectedLoaded bool
+		Expected       []byte
+	}{
+		{
+			Value: mdl.NoBytes(),
+			ExpectedLoaded: false,
+			Expected:                 nil,
+		},
+		{
+			Value: mdl.SomeBytes([]byte{}),
+			ExpectedLoaded: true,
+			Expected:                 []byte{},
+		},
+		{
+			Value: mdl.SomeBytes([]byte("Hello world!")),
+			ExpectedLoaded: true,
+			Expected:                 []byte("Hello world!"),
+		},
+		{
+			Value: mdl.SomeBytes([]byte{0x00, 0xff}),
+			ExpectedLoaded: true,
+			Expected:                 []byte{0x00, 0xff},
+		},
+	}
+
+	for testNumber, test := range tests {
+
+		actualInterface, err := test.Value.Value()
+
+		if !test.ExpectedLoaded && nil == err {
+			t.Errorf("For test #%d, expected an error, but did not actually get one: %#v", testNumber, err)
+			t.Errorf("\tEXPECTED loaded: %t", test.ExpectedLoaded)
+			t.Errorf("\tEXPECTED: %#v", test.Expected)
+			t.Errorf("\tACTUAL: %T %#v", actualInterface, actualInterface)
+			continue
+		}
+
+		if test.ExpectedLoaded && nil != err {
+			t.Errorf("For test #%d, did not expect an error, but actually got one: (%T) %q", testNumber, err, err)
+			t.Errorf("\tEXPECTED loaded: %t", test.ExpectedLoaded)
+			t.Errorf("\tEXPECTED: %#v", test.Expected)
+			t.Errorf("\tACTUAL: %T %#v", actualInterface, actualInterface)
+			continue
+		}
+
+		if !test.ExpectedLoaded {
+			continue
+		}
+		actual, casted := actualInterface.([]byte)
+		if !casted {
+			t.Errorf("For test #%d, expected type ‘[]byte’, but actually got type ‘%T’.", testNumber, actualInterface)
+			t.Errorf("\tEXPECTED loaded: %t", test.ExpectedLoaded)
+			t.Errorf("\tEXPECTED: %#v", test.Expected)
+			t.Errorf("\tACTUAL: %T %#v", actualInterface, actualInterface)
+			continue
+		}
+
+		if expected := test.Expected; !bytes.Equal(expected, actual) {
+			t.Errorf("For test #%d ...", testNumber)
+			t.Errorf("\tEXPECTED loaded: %t", test.ExpectedLoaded)
+			t.Errorf("\tEXPECTED: %#v", expected)
+			t.Errorf("\tACTUAL:   %#v", actual)
+			continue
+		}
+
+		// What .Value() returns scans back as the same value.
+		var scanned mdl.Bytes
+		if err := scanned.Scan(actualInterface); nil != err {
+			t.Errorf("For test #%d, did not expect an error, but actually got one: (%T) %q", testNumber, err, err)
+			continue
+		}
+		if expected := test.Value; expected != scanned {
+			t.Errorf("For test #%d ...", testNumber)
+			t.Errorf("\tEXPECTED scanned: %#v", expected)
+			t.Errorf("\tACTUAL   scanned: %#v", scanned)
+			continue
+		}
+	}
+}
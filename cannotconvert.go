@@ -0,0 +1,80 @@
+package mdl
+
+import (
+	"fmt"
+)
+
+// CannotConvert is the error returned from mdl.KeyValues.Bind() when a value stored in the ‘mdl.KeyValues’
+// cannot be converted to the type of the struct field it is being bound to.
+// It is also returned from the typed loaders (mdl.KeyValues.LoadInt64(), mdl.KeyValues.LoadTime(), etc)
+// when a value cannot be converted to the type being loaded.
+//
+// For example:
+//
+//	var keyvalues mdl.KeyValues
+//	
+//	// ...
+//	
+//	err := keyvalues.Bind(&user)
+//	
+//	if nil != err {
+//		switch casted := err.(type) {
+//		case mdl.CannotConvert:
+//			fmt.Printf("bad value for %q\n", casted.Key())
+//		default:
+//			//@TODO
+//		}
+//	}
+type CannotConvert interface {
+	error
+	CannotConvert()
+
+	// Key returns the key the value that could not be converted is stored at.
+	Key() Key
+
+	// Value returns the value that could not be converted.
+	Value() string
+
+	// Unwrap returns the error that caused this error, if there is one.
+	Unwrap() error
+}
+
+type internalCannotConvert struct {
+	key Key
+	value string
+	typeName string
+	err error
+}
+
+func cannotConvert(key Key, value string, typeName string, err error) CannotConvert {
+	return internalCannotConvert{
+		key:      key,
+		value:    value,
+		typeName: typeName,
+		err:      err,
+	}
+}
+
+func (receiver internalCannotConvert) Error() string {
+	if nil == receiver.err {
+		return fmt.Sprintf("mdl: cannot convert value %q at key %#v to %s", receiver.value, receiver.key, receiver.typeName)
+	}
+
+	return fmt.Sprintf("mdl: cannot convert value %q at key %#v to %s: %s", receiver.value, receiver.key, receiver.typeName, receiver.err)
+}
+
+func (receiver internalCannotConvert) Key() Key {
+	return receiver.key
+}
+
+func (receiver internalCannotConvert) Unwrap() error {
+	return receiver.err
+}
+
+func (receiver internalCannotConvert) Value() string {
+	return receiver.value
+}
+
+func (internalCannotConvert) CannotConvert() {
+	// Nothing here.
+}
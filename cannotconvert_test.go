@@ -0,0 +1,23 @@
+package mdl
+
+import (
+	"testing"
+)
+
+func TestInternalCannotConvertAsError(t *testing.T) {
+	var err error = internalCannotConvert{} // THIS IS THE LINE THAT ACTUALLY MATTERS.
+
+	if nil == err {
+		t.Errorf("This should never happen.")
+		return
+	}
+}
+
+func TestInternalCannotConvertAsCannotConvert(t *testing.T) {
+	var complainer CannotConvert = internalCannotConvert{} // THIS IS THE LINE THAT ACTUALLY MATTERS.
+
+	if nil == complainer {
+		t.Errorf("This should never happen.")
+		return
+	}
+}
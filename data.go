@@ -1,18 +1,12 @@
 package mdl
 
 import (
-	"github.com/reiver/go-errhttp"
-
 	"fmt"
-	"io"
-	"io/ioutil"
+	"mime"
 	"net/http"
-	"net/url"
-	"reflect"
-	"unsafe"
 )
 
-func inferData(keyvalues *KeyValues, request *http.Request) error {
+func (receiver InstructionDecoder) inferData(keyvalues *KeyValues, attachments *[]Attachment, request *http.Request) error {
 	if nil == keyvalues {
 		return errNilKeyValues
 	}
@@ -23,56 +17,44 @@ func inferData(keyvalues *KeyValues, request *http.Request) error {
 
 	contentType := request.Header.Get("Content-Type")
 
-	switch contentType {
+	mediaType, params, err := mime.ParseMediaType(contentType)
+	if nil != err {
+		return unsupportedMediaType(fmt.Errorf("%q", contentType))
+	}
+
+	if !receiver.accepts(mediaType) {
+		return unsupportedMediaType(fmt.Errorf("%q", contentType))
+	}
+
+	switch mediaType {
 	case "application/x-www-form-urlencoded":
 		if nil == request.Body {
 			return nil
 		}
 
-		var limit int64 = 100 << 20 // 100 MB
-		var reader io.Reader = io.LimitReader(request.Body, limit)
-
-		var body string
-		{
-			bytes, err := ioutil.ReadAll(reader)
-			if nil != err {
-				return errhttp.BadRequestWrap(err)
-			}
-
-			{
-				var buffer [1]byte
-				n, _ := request.Body.Read(buffer[:])
-				if n > 0 {
-					return errhttp.PayloadTooLargeWrap(fmt.Errorf("HTTP request payload too large; limit = %d MB", limit))
-				}
-			}
-
-			sliceHeader := (*reflect.SliceHeader)(unsafe.Pointer(&bytes))
-			stringHeader := reflect.StringHeader{Data: sliceHeader.Data, Len: sliceHeader.Len}
-			body = *(*string)(unsafe.Pointer(&stringHeader))
+		body, err := readBody(request, receiver.bodyLimit())
+		if nil != err {
+			return err
 		}
 
+		return inferDataURLEncoded(keyvalues, body, receiver.keySplitter())
 
+	case "application/json":
+		if nil == request.Body {
+			return nil
+		}
 
-		kv, err := url.ParseQuery(body)
+		body, err := readBody(request, receiver.bodyLimit())
 		if nil != err {
-			return errhttp.BadRequestWrap(err)
+			return err
 		}
 
-		for k, _ := range kv {
-			v := kv.Get(k)
-
-			var key Key = SomeKey(k)
-
-			if err := keyvalues.Store(key, v); nil != err {
-				return errhttp.BadRequestWrap(err)
-			}
-		}
+		return inferDataJSON(keyvalues, body)
 
-		return nil
+	case "multipart/form-data":
+		return inferDataMultipart(keyvalues, attachments, request.Body, params["boundary"], receiver.keySplitter(), receiver.multipartPartLimit(), receiver.bodyLimit())
 
-//	case "application/json":
 	default:
-		return errhttp.UnsupportedMediaTypeWrap(fmt.Errorf("mdl: %q is an unsupported media type", contentType))
+		return unsupportedMediaType(fmt.Errorf("%q", contentType))
 	}
 }
@@ -1,6 +1,7 @@
 package mdl
 
 import (
+	"fmt"
 	"net/http"
 	"strings"
 
@@ -68,13 +69,121 @@ func TestInferData(t *testing.T) {
 				return &keyvalues
 			}(),
 		},
+
+
+
+		{
+			HttpRequest: func()*http.Request{
+				var body string = "tag=b&name=joe&tag=a&tag=b"
+
+				r, err := http.NewRequest(http.MethodPost, "/v1/posts", strings.NewReader(body))
+				if nil != err {
+					panic(err)
+				}
+				r.Header.Add("X-Idempotent-ID", "z-2015-05-07T10:27:24Z_cjaLIpvS2MmsLqzPOJIFHwPxqgbdCmi749u9rfAiUu0wZHQ4Z714zSgjumgj")
+				r.Header.Add("X-HTTP-Method-Override", "TAG_POST")
+				r.Header.Add("Content-Type", "application/x-www-form-urlencoded")
+
+				return r
+			}(),
+			Expected: func() *KeyValues {
+				var keyvalues KeyValues
+
+				if err := keyvalues.ShallowStore("name", "joe"); nil != err {
+					panic(err)
+				}
+				if err := keyvalues.Append(SomeKey("tag"), "b"); nil != err {
+					panic(err)
+				}
+				if err := keyvalues.Append(SomeKey("tag"), "a"); nil != err {
+					panic(err)
+				}
+				if err := keyvalues.Append(SomeKey("tag"), "b"); nil != err {
+					panic(err)
+				}
+
+				return &keyvalues
+			}(),
+		},
+
+
+
+		{
+			HttpRequest: func()*http.Request{
+				var body string = `{"email_address":"joeblow@example.com"}`
+
+				r, err := http.NewRequest(http.MethodPost, "/v1/users", strings.NewReader(body))
+				if nil != err {
+					panic(err)
+				}
+				r.Header.Add("X-Idempotent-ID", "z-2015-05-07T10:25:09Z_tleEiguQe67zJFYUa7pngSZT8HX7FMAcHb1Z4yOO2ANtltRPRwF5p9TWwf7m")
+				r.Header.Add("X-HTTP-Method-Override", "RECORD_EMAIL")
+				r.Header.Add("Content-Type", "application/json; charset=utf-8")
+
+				return r
+			}(),
+			Expected: func() *KeyValues {
+				var keyvalues KeyValues
+
+				if err := keyvalues.ShallowStore("email_address", "joeblow@example.com"); nil != err {
+					panic(err)
+				}
+
+				return &keyvalues
+			}(),
+		},
+		{
+			HttpRequest: func()*http.Request{
+				var body string = `{"database":{"username":"joeblow","password":"x","port":5432,"ssl":true,"timeout":null},"tags":["a","b",{"c":-2.5}],"i/o":false}`
+
+				r, err := http.NewRequest(http.MethodPost, "/v1/config", strings.NewReader(body))
+				if nil != err {
+					panic(err)
+				}
+				r.Header.Add("X-Idempotent-ID", "z-2015-05-07T10:27:24Z_cjaLIpvS2MmsLqzPOJIFHwPxqgbdCmi749u9rfAiUu0wZHQ4Z714zSgjumgj")
+				r.Header.Add("X-HTTP-Method-Override", "CONFIGURE")
+				r.Header.Add("Content-Type", "application/json")
+
+				return r
+			}(),
+			Expected: func() *KeyValues {
+				var keyvalues KeyValues
+
+				if err := keyvalues.Store(SomeKey("database", "username"), "joeblow"); nil != err {
+					panic(err)
+				}
+				if err := keyvalues.Store(SomeKey("database", "password"), "x"); nil != err {
+					panic(err)
+				}
+				if err := keyvalues.Store(SomeKey("database", "port"), "5432"); nil != err {
+					panic(err)
+				}
+				if err := keyvalues.Store(SomeKey("database", "ssl"), "true"); nil != err {
+					panic(err)
+				}
+				if err := keyvalues.Store(SomeKey("tags", "0"), "a"); nil != err {
+					panic(err)
+				}
+				if err := keyvalues.Store(SomeKey("tags", "1"), "b"); nil != err {
+					panic(err)
+				}
+				if err := keyvalues.Store(SomeKey("tags", "2", "c"), "-2.5"); nil != err {
+					panic(err)
+				}
+				if err := keyvalues.ShallowStore("i/o", "false"); nil != err {
+					panic(err)
+				}
+
+				return &keyvalues
+			}(),
+		},
 	}
 
 	for testNumber, test := range tests {
 
 		var keyvalues KeyValues
 
-		if err := inferData(&keyvalues, test.HttpRequest); nil != err {
+		if err := (InstructionDecoder{}).inferData(&keyvalues, nil, test.HttpRequest); nil != err {
 			t.Errorf("For test #%d, did not expect an error, but actually got one: (%T) %q", testNumber, err, err)
 			continue
 		}
@@ -89,3 +198,74 @@ func TestInferData(t *testing.T) {
 		}
 	}
 }
+
+func TestInferDataJSONError(t *testing.T) {
+
+	tests := []struct{
+		Body string
+	}{
+		{
+			Body: `["apple","banana","cherry"]`,
+		},
+		{
+			Body: `"apple"`,
+		},
+		{
+			Body: `null`,
+		},
+		{
+			Body: `{"apple":`,
+		},
+		{
+			Body: `{"apple":"one"} {"banana":"two"}`,
+		},
+	}
+
+	for testNumber, test := range tests {
+
+		r, err := http.NewRequest(http.MethodPost, "/v1/users", strings.NewReader(test.Body))
+		if nil != err {
+			t.Errorf("For test #%d, did not expect an error, but actually got one: (%T) %q", testNumber, err, err)
+			continue
+		}
+		r.Header.Add("Content-Type", "application/json")
+
+		var keyvalues KeyValues
+
+		if err := (InstructionDecoder{}).inferData(&keyvalues, nil, r); nil == err {
+			t.Errorf("For test #%d, expected an error, but did not actually get one.", testNumber)
+			t.Logf("BODY: %s", test.Body)
+			t.Logf("KEY-VALUES:...")
+			t.Log(keyvalues.CanonicalForm())
+			continue
+		}
+	}
+}
+
+func TestInferDataURLEncodedOrder(t *testing.T) {
+
+	// Both field names are split into the same key.
+	splitter := func(name string) (Key, error) {
+		return SomeKey(strings.ToLower(name)), nil
+	}
+
+	const body string = "b=3&A=1&a=2&B=4"
+
+	for i := 0; i < 20; i++ {
+		var keyvalues KeyValues
+
+		if err := inferDataURLEncoded(&keyvalues, body, splitter); nil != err {
+			t.Errorf("Did not expect an error, but actually got one: (%T) %q", err, err)
+			return
+		}
+
+		if expected, actual := "[1 2]", fmt.Sprint(keyvalues.LoadAll(SomeKey("a"))); expected != actual {
+			t.Errorf("For try #%d, expected the values %s, but actually got %s.", i, expected, actual)
+			return
+		}
+		if expected, actual := "[4 3]", fmt.Sprint(keyvalues.LoadAll(SomeKey("b"))); expected != actual {
+			t.Errorf("For try #%d, expected the values %s, but actually got %s.", i, expected, actual)
+			return
+		}
+	}
+}
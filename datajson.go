@@ -0,0 +1,76 @@
+package mdl
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+)
+
+// inferDataJSON stores the JSON object in ‘body’ into ‘keyvalues’.
+//
+// See the “Data From HTTP Request” section of the ‘mdl.Instruction’ documentation for how
+// each kind of JSON value gets mapped onto the (string) values of ‘mdl.KeyValues’.
+func inferDataJSON(keyvalues *KeyValues, body string) error {
+	if nil == keyvalues {
+		return errNilKeyValues
+	}
+
+	decoder := json.NewDecoder(strings.NewReader(body))
+	decoder.UseNumber()
+
+	var object map[string]interface{}
+	if err := decoder.Decode(&object); nil != err {
+		return malformedBody(err)
+	}
+	if nil == object {
+		return malformedBody(errJSONNotObject)
+	}
+
+	{
+		var extra interface{}
+		if err := decoder.Decode(&extra); io.EOF != err {
+			return malformedBody(errJSONTrailingData)
+		}
+	}
+
+	return jsonStore(keyvalues, nil, object)
+}
+
+func jsonStore(keyvalues *KeyValues, tokens []string, value interface{}) error {
+	switch casted := value.(type) {
+	case nil:
+		return nil
+	case map[string]interface{}:
+		for name, v := range casted {
+			if err := jsonStore(keyvalues, append(tokens[:len(tokens):len(tokens)], name), v); nil != err {
+				return err
+			}
+		}
+		return nil
+	case []interface{}:
+		for i, v := range casted {
+			if err := jsonStore(keyvalues, append(tokens[:len(tokens):len(tokens)], strconv.Itoa(i)), v); nil != err {
+				return err
+			}
+		}
+		return nil
+	case string:
+		return jsonStoreString(keyvalues, tokens, casted)
+	case json.Number:
+		return jsonStoreString(keyvalues, tokens, casted.String())
+	case bool:
+		return jsonStoreString(keyvalues, tokens, strconv.FormatBool(casted))
+	default:
+		return malformedBody(fmt.Errorf("unexpected JSON value of type %T", value))
+	}
+}
+
+func jsonStoreString(keyvalues *KeyValues, tokens []string, value string) error {
+	if err := keyvalues.Store(SomeKey(tokens...), value); nil != err {
+		return malformedBody(err)
+	}
+
+	return nil
+}
@@ -0,0 +1,191 @@
+package mdl
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"mime/multipart"
+)
+
+// Attachments larger than this are held in a temporary file, rather than in memory.
+const multipartMemoryLimit = 1 << 20 // 1 MB
+
+func inferDataMultipart(keyvalues *KeyValues, attachments *[]Attachment, body io.Reader, boundary string, splitter KeySplitter, partLimit int64, totalLimit int64) (err error) {
+	if nil == keyvalues {
+		return errNilKeyValues
+	}
+
+	if nil == attachments {
+		return errNilAttachments
+	}
+
+	if nil == body {
+		return nil
+	}
+
+	if "" == boundary {
+		return malformedBody(errNoBoundary)
+	}
+
+	var received []Attachment
+	defer func() {
+		if nil == err {
+			*attachments = append(*attachments, received...)
+			return
+		}
+
+		for _, attachment := range received {
+			attachment.remove()
+		}
+	}()
+
+	var limited multipartLimitReader = multipartLimitReader{
+		reader: body,
+		remaining: totalLimit,
+	}
+
+	reader := multipart.NewReader(&limited, boundary)
+
+	for {
+		part, err := reader.NextPart()
+		if io.EOF == err {
+			return nil
+		}
+		if limited.exceeded {
+			return payloadTooLarge(fmt.Errorf("limit is %d bytes", totalLimit))
+		}
+		if nil != err {
+			return malformedBody(err)
+		}
+
+		name := part.FormName()
+		if "" == name {
+			part.Close()
+			continue
+		}
+
+		key, err := splitter(name)
+		if nil != err {
+			part.Close()
+			return malformedBody(err)
+		}
+
+		fileName := part.FileName()
+
+		switch fileName {
+		case "":
+			value, err := ioutil.ReadAll(io.LimitReader(part, partLimit+1))
+			part.Close()
+			if limited.exceeded {
+				return payloadTooLarge(fmt.Errorf("limit is %d bytes", totalLimit))
+			}
+			if nil != err {
+				return malformedBody(err)
+			}
+			if int64(len(value)) > partLimit {
+				return payloadTooLarge(fmt.Errorf("part %q is larger than the limit of %d bytes", name, partLimit))
+			}
+
+			// Like with “application/x-www-form-urlencoded”, every value for a key is kept.
+			if err := keyvalues.Append(key, string(value)); nil != err {
+				return malformedBody(err)
+			}
+		default:
+			mediaType := part.Header.Get("Content-Type")
+			if "" == mediaType {
+				mediaType = "application/octet-stream"
+			}
+
+			attachment := Attachment{
+				key:       key,
+				fileName:  fileName,
+				mediaType: mediaType,
+			}
+
+			err := multipartReadAttachment(&attachment, part, partLimit)
+			part.Close()
+			if limited.exceeded {
+				if nil == err {
+					attachment.remove()
+				}
+				return payloadTooLarge(fmt.Errorf("limit is %d bytes", totalLimit))
+			}
+			if nil != err {
+				return err
+			}
+
+			received = append(received, attachment)
+		}
+	}
+}
+
+func multipartReadAttachment(attachment *Attachment, part io.Reader, partLimit int64) error {
+	var buffer bytes.Buffer
+
+	var reader io.Reader = io.LimitReader(part, partLimit+1)
+
+	n, err := io.CopyN(&buffer, reader, multipartMemoryLimit+1)
+	if nil != err && io.EOF != err {
+		return malformedBody(err)
+	}
+	if n > partLimit {
+		return payloadTooLarge(fmt.Errorf("part %q is larger than the limit of %d bytes", attachment.key.CanonicalForm(), partLimit))
+	}
+	if n <= multipartMemoryLimit {
+		attachment.content = buffer.Bytes()
+		attachment.size = n
+		return nil
+	}
+
+	file, err := ioutil.TempFile("", "mdl-attachment-")
+	if nil != err {
+		return err
+	}
+	attachment.tempFile = file.Name()
+
+	written, err := io.Copy(file, io.MultiReader(&buffer, reader))
+	if closeErr := file.Close(); nil == err {
+		err = closeErr
+	}
+	if nil != err {
+		attachment.remove()
+		return malformedBody(err)
+	}
+	if written > partLimit {
+		attachment.remove()
+		return payloadTooLarge(fmt.Errorf("part %q is larger than the limit of %d bytes", attachment.key.CanonicalForm(), partLimit))
+	}
+
+	attachment.size = written
+
+	return nil
+}
+
+// multipartLimitReader is similar to io.LimitReader, except it remembers if the limit was exceeded.
+//
+// This is needed since "mime/multipart" does not pass errors from the underlying reader back as is.
+type multipartLimitReader struct {
+	reader    io.Reader
+	remaining int64
+	exceeded  bool
+}
+
+func (receiver *multipartLimitReader) Read(p []byte) (int, error) {
+	if receiver.exceeded {
+		return 0, errPayloadTooLarge
+	}
+
+	if int64(len(p)) > receiver.remaining+1 {
+		p = p[:receiver.remaining+1]
+	}
+
+	n, err := receiver.reader.Read(p)
+	receiver.remaining -= int64(n)
+	if receiver.remaining < 0 {
+		receiver.exceeded = true
+		return 0, errPayloadTooLarge
+	}
+
+	return n, err
+}
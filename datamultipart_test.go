@@ -0,0 +1,223 @@
+package mdl
+
+import (
+	"bytes"
+	"io/ioutil"
+	"mime/multipart"
+	"net/http"
+	"net/textproto"
+	"strings"
+
+	"testing"
+)
+
+func TestInferDataMultipart(t *testing.T) {
+
+	var largeContent string = strings.Repeat("0123456789abcdef", (multipartMemoryLimit/16)+5)
+
+	var body bytes.Buffer
+	var contentType string
+	{
+		writer := multipart.NewWriter(&body)
+
+		if err := writer.WriteField("given_name", "Joe"); nil != err {
+			t.Errorf("Did not expect an error, but actually got one: (%T) %q", err, err)
+			return
+		}
+		if err := writer.WriteField("family_name", "Blow"); nil != err {
+			t.Errorf("Did not expect an error, but actually got one: (%T) %q", err, err)
+			return
+		}
+
+		{
+			header := textproto.MIMEHeader{}
+			header.Set("Content-Disposition", `form-data; name="avatar"; filename="joeblow.txt"`)
+			header.Set("Content-Type", "text/plain")
+
+			part, err := writer.CreatePart(header)
+			if nil != err {
+				t.Errorf("Did not expect an error, but actually got one: (%T) %q", err, err)
+				return
+			}
+			part.Write([]byte("Hello world!"))
+		}
+
+		{
+			part, err := writer.CreateFormFile("resume", "resume.bin")
+			if nil != err {
+				t.Errorf("Did not expect an error, but actually got one: (%T) %q", err, err)
+				return
+			}
+			part.Write([]byte(largeContent))
+		}
+
+		if err := writer.Close(); nil != err {
+			t.Errorf("Did not expect an error, but actually got one: (%T) %q", err, err)
+			return
+		}
+
+		contentType = writer.FormDataContentType()
+	}
+
+	r, err := http.NewRequest(http.MethodPost, "/v1/users", &body)
+	if nil != err {
+		t.Errorf("Did not expect an error, but actually got one: (%T) %q", err, err)
+		return
+	}
+	r.Header.Add("Content-Type", contentType)
+
+	var keyvalues KeyValues
+	var attachments []Attachment
+
+	if err := (InstructionDecoder{}).inferData(&keyvalues, &attachments, r); nil != err {
+		t.Errorf("Did not expect an error, but actually got one: (%T) %q", err, err)
+		return
+	}
+	defer func() {
+		for _, attachment := range attachments {
+			attachment.remove()
+		}
+	}()
+
+	{
+		var expected KeyValues
+		expected.ShallowStore("given_name", "Joe")
+		expected.ShallowStore("family_name", "Blow")
+
+		if expected, actual := expected.CanonicalForm(), keyvalues.CanonicalForm(); expected != actual {
+			t.Errorf("The key-values that were actually gotten were not what was expected.")
+			t.Logf("EXPECTED:...")
+			t.Log(expected)
+			t.Logf("ACTUAL:...")
+			t.Log(actual)
+			return
+		}
+	}
+
+	if expected, actual := 2, len(attachments); expected != actual {
+		t.Errorf("Expected %d attachments, but actually got %d.", expected, actual)
+		return
+	}
+
+	tests := []struct{
+		ExpectedKey       Key
+		ExpectedFileName  string
+		ExpectedMediaType string
+		ExpectedContent   string
+		ExpectedTempFile  bool
+	}{
+		{
+			ExpectedKey:       SomeKey("avatar"),
+			ExpectedFileName:  "joeblow.txt",
+			ExpectedMediaType: "text/plain",
+			ExpectedContent:   "Hello world!",
+			ExpectedTempFile:  false,
+		},
+		{
+			ExpectedKey:       SomeKey("resume"),
+			ExpectedFileName:  "resume.bin",
+			ExpectedMediaType: "application/octet-stream",
+			ExpectedContent:   largeContent,
+			ExpectedTempFile:  true,
+		},
+	}
+
+	for testNumber, test := range tests {
+
+		attachment := attachments[testNumber]
+
+		if expected, actual := test.ExpectedKey, attachment.Key(); expected != actual {
+			t.Errorf("For test #%d, expected key %#v, but actually got %#v.", testNumber, expected, actual)
+			continue
+		}
+		if expected, actual := test.ExpectedFileName, attachment.FileName(); expected != actual {
+			t.Errorf("For test #%d, expected file name %q, but actually got %q.", testNumber, expected, actual)
+			continue
+		}
+		if expected, actual := test.ExpectedMediaType, attachment.MediaType(); expected != actual {
+			t.Errorf("For test #%d, expected media type %q, but actually got %q.", testNumber, expected, actual)
+			continue
+		}
+		if expected, actual := int64(len(test.ExpectedContent)), attachment.Size(); expected != actual {
+			t.Errorf("For test #%d, expected size %d, but actually got %d.", testNumber, expected, actual)
+			continue
+		}
+		if expected, actual := test.ExpectedTempFile, "" != attachment.tempFile; expected != actual {
+			t.Errorf("For test #%d, expected temporary file to be %t, but actually was %t.", testNumber, expected, actual)
+			continue
+		}
+
+		reader, err := attachment.Open()
+		if nil != err {
+			t.Errorf("For test #%d, did not expect an error, but actually got one: (%T) %q", testNumber, err, err)
+			continue
+		}
+		content, err := ioutil.ReadAll(reader)
+		reader.Close()
+		if nil != err {
+			t.Errorf("For test #%d, did not expect an error, but actually got one: (%T) %q", testNumber, err, err)
+			continue
+		}
+
+		if expected, actual := test.ExpectedContent, string(content); expected != actual {
+			t.Errorf("For test #%d, the content that was actually gotten was not what was expected.", testNumber)
+			t.Logf("EXPECTED length: %d", len(expected))
+			t.Logf("ACTUAL   length: %d", len(actual))
+			continue
+		}
+	}
+}
+
+func TestInferDataMultipartTooLarge(t *testing.T) {
+
+	tests := []struct{
+		FieldValue string
+		FileContent string
+		PartLimit int64
+		TotalLimit int64
+	}{
+		{
+			FieldValue:  "0123456789",
+			PartLimit:   5,
+			TotalLimit:  1 << 20,
+		},
+		{
+			FileContent: "0123456789",
+			PartLimit:   5,
+			TotalLimit:  1 << 20,
+		},
+		{
+			FileContent: strings.Repeat("0123456789", 1000),
+			PartLimit:   1 << 20,
+			TotalLimit:  5000,
+		},
+	}
+
+	for testNumber, test := range tests {
+
+		var body bytes.Buffer
+		writer := multipart.NewWriter(&body)
+		if "" != test.FieldValue {
+			writer.WriteField("field", test.FieldValue)
+		}
+		if "" != test.FileContent {
+			part, _ := writer.CreateFormFile("file", "file.txt")
+			part.Write([]byte(test.FileContent))
+		}
+		writer.Close()
+
+		var keyvalues KeyValues
+		var attachments []Attachment
+
+		err := inferDataMultipart(&keyvalues, &attachments, &body, writer.Boundary(), KeySplitShallow, test.PartLimit, test.TotalLimit)
+		if nil == err {
+			t.Errorf("For test #%d, expected an error, but did not actually get one.", testNumber)
+			continue
+		}
+
+		if expected, actual := 0, len(attachments); expected != actual {
+			t.Errorf("For test #%d, expected %d attachments, but actually got %d.", testNumber, expected, actual)
+			continue
+		}
+	}
+}
@@ -0,0 +1,42 @@
+package mdl
+
+import (
+	"net/url"
+	"sort"
+)
+
+func inferDataURLEncoded(keyvalues *KeyValues, body string, splitter KeySplitter) error {
+	if nil == keyvalues {
+		return errNilKeyValues
+	}
+
+	kv, err := url.ParseQuery(body)
+	if nil != err {
+		return malformedBody(err)
+	}
+
+	// Different field names can be split into the same key (ex: “a[b]” and “a.b”); so the field names are gone through
+	// in sorted order (rather than map order), so that the values for that key always come out in the same order.
+	names := make([]string, 0, len(kv))
+	for name := range kv {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	for _, name := range names {
+		values := kv[name]
+
+		key, err := splitter(name)
+		if nil != err {
+			return malformedBody(err)
+		}
+
+		for _, v := range values {
+			if err := keyvalues.Append(key, v); nil != err {
+				return malformedBody(err)
+			}
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,265 @@
+package mdl
+
+import (
+	"database/sql/driver"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+)
+
+// Duration is a time.Duration option type.
+//
+// It can have 2 kinds of value:
+//
+// • no time.Duration (i.e., ‘nothing’),
+//
+// • some time.Duration (i.e., ‘something’).
+//
+// Like with ‘mdl.String’, the value in this type is that it can differentiate between being assigned zero (0),
+// and a variable that hasn't been loaded.
+type Duration struct {
+	datum  time.Duration
+	loaded bool
+}
+
+// NoDuration returns a mdl.Duration which has no value (i.e., ‘nothing’).
+//
+// Note that this is not the same thing as zero (0)!
+// That is mdl.SomeDuration(0).
+//
+// Example
+//
+//	var datum mdl.Duration
+//	
+//	// ...
+//	
+//	if mdl.NoDuration() == datum {
+//		//@TODO
+//	}
+func NoDuration() Duration {
+	return Duration{}
+}
+
+// SomeDuration returns a mdl.Duration which has some value (i.e., ‘something’).
+//
+// Example
+//
+//	var datum mdl.Duration
+//	
+//	// ...
+//	
+//	datum = mdl.SomeDuration(90 * time.Second)
+func SomeDuration(datum time.Duration) Duration {
+	return Duration{
+		loaded: true,
+		datum:  datum,
+	}
+}
+
+func (receiver Duration) Else(datum time.Duration) Duration {
+	if NoDuration() != receiver {
+		return receiver
+	}
+
+	return SomeDuration(datum)
+}
+
+func (receiver Duration) ElseUnwrap(datum time.Duration) time.Duration {
+	if NoDuration() == receiver {
+		return datum
+	}
+
+	return receiver.datum
+}
+
+// Format makes ‘mdl.Duration’ fit the ‘fmt.Formatter’ interface.
+//
+// The %d, %s, and %v verbs format the value the same way they would format a time.Duration.
+// The %q verb formats its text form (see .MarshalText()) as a quoted string, and the %#v verb formats it with .GoString().
+func (receiver Duration) Format(f fmt.State, c rune) {
+	switch {
+	case 'v' == c && f.Flag('#'):
+		fmt.Fprint(f, receiver.GoString())
+	case NoDuration() == receiver && 'q' == c:
+		fmt.Fprint(f, "«no-duration»")
+	case NoDuration() == receiver:
+		fmt.Fprintf(f, "%%!%s(%s)", string(c), receiver.GoString())
+	case 'q' == c:
+		fmt.Fprintf(f, "%q", receiver.datum.String())
+	case strings.ContainsRune("dsv", c):
+		fmt.Fprintf(f, optionFormatDirective(f, c), receiver.datum)
+	default:
+		fmt.Fprintf(f, "%%!%s(%s)", string(c), receiver.GoString())
+	}
+}
+
+// GoString makes mdl.Duration fit the fmt.GoStringer interface.
+//
+// It gets used with the %#v verb with the printing family of functions
+// in the Go built-in "fmt" package.
+func (receiver Duration) GoString() string {
+	if NoDuration() == receiver {
+		return "mdl.NoDuration()"
+	}
+
+	return fmt.Sprintf("mdl.SomeDuration(%d)", int64(receiver.datum))
+}
+
+// MarshalJSON makes mdl.Duration fit the encoding/json.Marshaler interface.
+//
+// mdl.NoDuration() is marshaled as JSON null, and mdl.SomeDuration() is marshaled as a JSON string (ex: "1m30s").
+func (receiver Duration) MarshalJSON() ([]byte, error) {
+	if NoDuration() == receiver {
+		return []byte("null"), nil
+	}
+
+	return json.Marshal(receiver.datum.String())
+}
+
+// MarshalText makes mdl.Duration fit the encoding.TextMarshaler interface.
+//
+// mdl.SomeDuration() is marshaled as what time.Duration.String() returns (ex: 1m30s).
+// Since text has no way of saying ‘nothing’, mdl.NoDuration() cannot be marshaled as text, and returns an error.
+func (receiver Duration) MarshalText() ([]byte, error) {
+	if NoDuration() == receiver {
+		return nil, errNotLoaded
+	}
+
+	return []byte(receiver.datum.String()), nil
+}
+
+func (receiver Duration) Map(fn func(time.Duration)time.Duration) Duration {
+	if NoDuration() == receiver {
+		return receiver
+	}
+
+	return SomeDuration(fn(receiver.datum))
+}
+
+// Scan makes mdl.Duration fit the database/sql.Scanner interface.
+//
+// A nil source (i.e., SQL NULL) scans as mdl.NoDuration().
+// The source can be a time.Duration, an int64 (as a number of nanoseconds), a string, a []byte, or an ‘mdl.Duration’.
+func (receiver *Duration) Scan(src interface{}) error {
+	if nil == receiver {
+		return errNilReceiver
+	}
+
+	switch casted := src.(type) {
+	case nil:
+		*receiver = NoDuration()
+		return nil
+	case Duration:
+		*receiver = casted
+		return nil
+	case string:
+		return receiver.scanText(casted)
+	case []byte:
+		if nil == casted {
+			*receiver = NoDuration()
+			return nil
+		}
+		return receiver.scanText(string(casted))
+	case time.Duration:
+		*receiver = SomeDuration(casted)
+		return nil
+	case int64:
+		*receiver = SomeDuration(time.Duration(casted))
+		return nil
+	default:
+		return unsupportedSource(src)
+	}
+}
+
+// String returns the text form of the value (see .MarshalText()).
+func (receiver Duration) String() (string, error) {
+	if NoDuration() == receiver {
+		return "", errNotLoaded
+	}
+
+	return receiver.datum.String(), nil
+}
+
+func (receiver Duration) Then(fn func(time.Duration)Duration) Duration {
+	if NoDuration() == receiver {
+		return receiver
+	}
+
+	return fn(receiver.datum)
+}
+
+// UnmarshalJSON makes mdl.Duration fit the encoding/json.Unmarshaler interface.
+//
+// It is the opposite of .MarshalJSON(); i.e., JSON null is unmarshaled as mdl.NoDuration().
+func (receiver *Duration) UnmarshalJSON(data []byte) error {
+	if nil == receiver {
+		return errNilReceiver
+	}
+
+	if "null" == string(data) {
+		*receiver = NoDuration()
+		return nil
+	}
+
+	// A JSON number is also accepted, as a number of nanoseconds; since that is how encoding/json marshals a time.Duration by itself.
+	if '"' != data[0] {
+		var nanoseconds int64
+		if err := json.Unmarshal(data, &nanoseconds); nil != err {
+			return err
+		}
+
+		*receiver = SomeDuration(time.Duration(nanoseconds))
+		return nil
+	}
+
+	var text string
+	if err := json.Unmarshal(data, &text); nil != err {
+		return err
+	}
+
+	return receiver.scanText(text)
+}
+
+// UnmarshalText makes mdl.Duration fit the encoding.TextUnmarshaler interface.
+//
+// It is the opposite of .MarshalText(). (A nil text is unmarshaled as mdl.NoDuration().)
+func (receiver *Duration) UnmarshalText(text []byte) error {
+	if nil == receiver {
+		return errNilReceiver
+	}
+
+	if nil == text {
+		*receiver = NoDuration()
+		return nil
+	}
+
+	return receiver.scanText(string(text))
+}
+
+func (receiver Duration) Unwrap() (time.Duration, bool) {
+	return receiver.datum, receiver.loaded
+}
+
+// Value makes mdl.Duration fit the database/sql/driver.Valuer interface.
+func (receiver Duration) Value() (driver.Value, error) {
+	if NoDuration() == receiver {
+		return nil, errNotLoaded
+	}
+
+	return int64(receiver.datum), nil
+}
+
+func (receiver Duration) isNothing() bool {
+	return NoDuration() == receiver
+}
+
+func (receiver *Duration) scanText(text string) error {
+	datum, err := time.ParseDuration(text)
+	if nil != err {
+		return err
+	}
+
+	*receiver = SomeDuration(datum)
+	return nil
+}
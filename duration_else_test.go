@@ -0,0 +1,55 @@
+package mdl_test
+
+import (
+	"github.com/reiver/go-mdl"
+
+	"testing"
+	"time"
+)
+
+func TestDurationElse(t *testing.T) {
+
+	tests := []struct{
+		Value    mdl.Duration
+		Else     time.Duration
+		Expected mdl.Duration
+	}{
+		{
+			Value:    mdl.NoDuration(),
+			Else:                      -1,
+			Expected: mdl.SomeDuration(-1),
+		},
+		{
+			Value:    mdl.NoDuration(),
+			Else:                      time.Hour,
+			Expected: mdl.SomeDuration(time.Hour),
+		},
+
+
+
+		{
+			Value:    mdl.SomeDuration(0),
+			Else:                      time.Hour,
+			Expected: mdl.SomeDuration(0),
+		},
+		{
+			Value:    mdl.SomeDuration(90 * time.Second),
+			Else:                      -1,
+			Expected: mdl.SomeDuration(90 * time.Second),
+		},
+	}
+
+	for testNumber, test := range tests {
+
+		actual := test.Value.Else(test.Else)
+
+		if expected := test.Expected; expected != actual {
+			t.Errorf("For test #%d ...", testNumber)
+			t.Errorf("\tVALUE:    %#v", test.Value)
+			t.Errorf("\tELSE:                      %v", test.Else)
+			t.Errorf("\tEXPECTED: %#v", expected)
+			t.Errorf("\tACTUAL:   %#v", actual)
+			continue
+		}
+	}
+}
@@ -0,0 +1,80 @@
+package mdl_test
+
+import (
+	"github.com/reiver/go-mdl"
+
+	"fmt"
+	"time"
+
+	"testing"
+)
+
+func TestDurationFormat(t *testing.T) {
+
+	tests := []struct{
+		Format   string
+		Duration mdl.Duration
+		Expected string
+	}{
+		{
+			Format:   "%q",
+			Duration: mdl.NoDuration(),
+			Expected:          `«no-duration»`,
+		},
+		{
+			Format:   "%q",
+			Duration: mdl.SomeDuration(90 * time.Second),
+			Expected:          `"1m30s"`,
+		},
+
+
+
+		{
+			Format:   "%s",
+			Duration: mdl.SomeDuration(90 * time.Second),
+			Expected:          `1m30s`,
+		},
+		{
+			Format:   "%v",
+			Duration: mdl.SomeDuration(1500 * time.Millisecond),
+			Expected:          `1.5s`,
+		},
+		{
+			Format:   "%d",
+			Duration: mdl.SomeDuration(90 * time.Second),
+			Expected:          `90000000000`,
+		},
+		{
+			Format:   "%8s|",
+			Duration: mdl.SomeDuration(90 * time.Second),
+			Expected:          `   1m30s|`,
+		},
+
+
+
+		{
+			Format:   "%s",
+			Duration: mdl.NoDuration(),
+			Expected:          `%!s(mdl.NoDuration())`,
+		},
+		{
+			Format:   "%t",
+			Duration: mdl.SomeDuration(0),
+			Expected:          `%!t(mdl.SomeDuration(0))`,
+		},
+	}
+
+	for testNumber, test := range tests {
+
+		actual := fmt.Sprintf(test.Format, test.Duration)
+		if expected := test.Expected; expected != actual {
+			t.Errorf("For test #%d, for print verb, did not actually get what was expected.", testNumber)
+			t.Logf("\tEXPECTED: %q", expected)
+			t.Logf("\tACTUAL:   %q", actual)
+			t.Logf("\tFormat: %q", test.Format)
+			t.Logf("\tDuration: %#v", test.Duration)
+			continue
+		}
+
+	}
+}
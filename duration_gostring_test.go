@@ -0,0 +1,45 @@
+package mdl_test
+
+import (
+	"github.com/reiver/go-mdl"
+
+	"testing"
+	"time"
+)
+
+func TestDurationGoString(t *testing.T) {
+
+	tests := []struct{
+		Value    mdl.Duration
+		Expected string
+	}{
+		{
+			Value:    mdl.NoDuration(),
+			Expected: "mdl.NoDuration()",
+		},
+		{
+			Value:    mdl.SomeDuration(0),
+			Expected: "mdl.SomeDuration(0)",
+		},
+		{
+			Value:    mdl.SomeDuration(90 * time.Second),
+			Expected: "mdl.SomeDuration(90000000000)",
+		},
+		{
+			Value:    mdl.SomeDuration(-1),
+			Expected: "mdl.SomeDuration(-1)",
+		},
+	}
+
+	for testNumber, test := range tests {
+
+		actual := test.Value.GoString()
+
+		if expected := test.Expected; expected != actual {
+			t.Errorf("For test #%d ...", testNumber)
+			t.Errorf("\tEXPECTED: %#v", expected)
+			t.Errorf("\tACTUAL:   %#v", actual)
+			continue
+		}
+	}
+}
@@ -0,0 +1,119 @@
+package mdl_test
+
+import (
+	"github.com/reiver/go-mdl"
+
+	"encoding/json"
+	"testing"
+	"time"
+)
+
+func TestDurationJSON(t *testing.T) {
+
+	tests := []struct{
+		Datum    mdl.Duration
+		Expected string
+	}{
+		{
+			Datum:    mdl.NoDuration(),
+			Expected: `null`,
+		},
+		{
+			Datum:    mdl.SomeDuration(0),
+			Expected: `"0s"`,
+		},
+		{
+			Datum:    mdl.SomeDuration(90 * time.Second),
+			Expected: `"1m30s"`,
+		},
+		{
+			Datum:    mdl.SomeDuration(-1500 * time.Millisecond),
+			Expected: `"-1.5s"`,
+		},
+	}
+
+	for testNumber, test := range tests {
+
+		data, err := json.Marshal(test.Datum)
+		if nil != err {
+			t.Errorf("For test #%d, did not expect an error, but actually got one: (%T) %q", testNumber, err, err)
+			continue
+		}
+
+		if expected, actual := test.Expected, string(data); expected != actual {
+			t.Errorf("For test #%d, expected %s, but actually got %s.", testNumber, expected, actual)
+			continue
+		}
+
+		var actual mdl.Duration = mdl.SomeDuration(-1)
+		if err := json.Unmarshal(data, &actual); nil != err {
+			t.Errorf("For test #%d, did not expect an error, but actually got one: (%T) %q", testNumber, err, err)
+			continue
+		}
+
+		if expected := test.Datum; expected != actual {
+			t.Errorf("For test #%d, expected %#v, but actually got %#v.", testNumber, expected, actual)
+			continue
+		}
+	}
+}
+
+func TestDurationUnmarshalJSON(t *testing.T) {
+
+	tests := []struct{
+		Data     string
+		Expected mdl.Duration
+	}{
+		{
+			Data:     `null`,
+			Expected: mdl.NoDuration(),
+		},
+		{
+			Data:     `"1m30s"`,
+			Expected: mdl.SomeDuration(90 * time.Second),
+		},
+
+
+
+		// A JSON number is a number of nanoseconds; which is how encoding/json marshals a time.Duration by itself.
+		{
+			Data:     `90000000000`,
+			Expected: mdl.SomeDuration(90 * time.Second),
+		},
+		{
+			Data:     `-1`,
+			Expected: mdl.SomeDuration(-1),
+		},
+	}
+
+	for testNumber, test := range tests {
+
+		var actual mdl.Duration = mdl.SomeDuration(time.Hour)
+		if err := json.Unmarshal([]byte(test.Data), &actual); nil != err {
+			t.Errorf("For test #%d, did not expect an error, but actually got one: (%T) %q", testNumber, err, err)
+			t.Errorf("\tDATA: %s", test.Data)
+			continue
+		}
+
+		if expected := test.Expected; expected != actual {
+			t.Errorf("For test #%d ...", testNumber)
+			t.Errorf("\tDATA: %s", test.Data)
+			t.Errorf("\tEXPECTED: %#v", expected)
+			t.Errorf("\tACTUAL:   %#v", actual)
+			continue
+		}
+	}
+}
+
+func TestDurationJSONError(t *testing.T) {
+
+	for testNumber, data := range []string{ `"soon"`, `""`, `1.5`, `true` } {
+
+		var actual mdl.Duration
+		if err := json.Unmarshal([]byte(data), &actual); nil == err {
+			t.Errorf("For test #%d, expected an error for %s, but did not actually get one.", testNumber, data)
+			t.Errorf("\tACTUAL: %#v", actual)
+			continue
+		}
+	}
+}
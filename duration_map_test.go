@@ -0,0 +1,50 @@
+package mdl_test
+
+import (
+	"github.com/reiver/go-mdl"
+
+	"testing"
+	"time"
+)
+
+func TestDurationMap(t *testing.T) {
+
+	double := func(d time.Duration) time.Duration {
+		return 2 * d
+	}
+
+	tests := []struct{
+		Value    mdl.Duration
+		Func     func(time.Duration)time.Duration
+		Expected mdl.Duration
+	}{
+		{
+			Value:    mdl.NoDuration(),
+			Func:     double,
+			Expected: mdl.NoDuration(),
+		},
+		{
+			Value:    mdl.SomeDuration(0),
+			Func:     double,
+			Expected: mdl.SomeDuration(0),
+		},
+		{
+			Value:    mdl.SomeDuration(90 * time.Second),
+			Func:     double,
+			Expected: mdl.SomeDuration(3 * time.Minute),
+		},
+	}
+
+	for testNumber, test := range tests {
+
+		actual := test.Value.Map(test.Func)
+
+		if expected := test.Expected; expected != actual {
+			t.Errorf("For test #%d ...", testNumber)
+			t.Errorf("\tVALUE:    %#v", test.Value)
+			t.Errorf("\tEXPECTED: %#v", expected)
+			t.Errorf("\tACTUAL:   %#v", actual)
+			continue
+		}
+	}
+}
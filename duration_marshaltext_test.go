@@ -0,0 +1,47 @@
+package mdl_test
+
+import (
+	"github.com/reiver/go-mdl"
+
+	"testing"
+	"time"
+)
+
+func TestDurationMarshalText(t *testing.T) {
+
+	tests := []struct{
+		Value    mdl.Duration
+		Expected string
+	}{
+		{
+			Value:    mdl.SomeDuration(0),
+			Expected: "0s",
+		},
+		{
+			Value:    mdl.SomeDuration(90 * time.Second),
+			Expected: "1m30s",
+		},
+		{
+			Value:    mdl.SomeDuration(-1),
+			Expected: "-1ns",
+		},
+	}
+
+	for testNumber, test := range tests {
+
+		text, err := test.Value.MarshalText()
+		if nil != err {
+			t.Errorf("For test #%d, did not expect an error, but actually got one: (%T) %q", testNumber, err, err)
+			continue
+		}
+
+		if expected, actual := test.Expected, string(text); expected != actual {
+			t.Errorf("For test #%d, expected %q, but actually got %q.", testNumber, expected, actual)
+			continue
+		}
+	}
+
+	if _, err := mdl.NoDuration().MarshalText(); nil == err {
+		t.Errorf("Expected an error for mdl.NoDuration(), but did not actually get one.")
+	}
+}
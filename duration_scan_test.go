@@ -0,0 +1,116 @@
+package mdl_test
+
+import (
+	"github.com/reiver/go-mdl"
+
+	"testing"
+	"time"
+)
+
+func TestDurationScan(t *testing.T) {
+
+	tests := []struct{
+		Datum    interface{}
+		Expected mdl.Duration
+	}{
+		{
+			Datum:    nil,
+			Expected: mdl.NoDuration(),
+		},
+		{
+			Datum:    []byte(nil),
+			Expected: mdl.NoDuration(),
+		},
+
+
+
+		{
+			Datum:    mdl.NoDuration(),
+			Expected: mdl.NoDuration(),
+		},
+		{
+			Datum:    mdl.SomeDuration(90 * time.Second),
+			Expected: mdl.SomeDuration(90 * time.Second),
+		},
+
+
+
+		{
+			Datum:                     "1m30s",
+			Expected: mdl.SomeDuration(90 * time.Second),
+		},
+		{
+			Datum:              []byte("1m30s"),
+			Expected: mdl.SomeDuration(90 * time.Second),
+		},
+		{
+			Datum:                     "-1.5s",
+			Expected: mdl.SomeDuration(-1500 * time.Millisecond),
+		},
+
+
+
+		{
+			Datum:                     90 * time.Second,
+			Expected: mdl.SomeDuration(90 * time.Second),
+		},
+
+
+
+		// An int64 is a number of nanoseconds.
+		{
+			Datum:               int64(90000000000),
+			Expected: mdl.SomeDuration(90 * time.Second),
+		},
+		{
+			Datum:               int64(0),
+			Expected: mdl.SomeDuration(0),
+		},
+	}
+
+	for testNumber, test := range tests {
+
+		var actual mdl.Duration = mdl.SomeDuration(-1)
+
+		err := actual.Scan(test.Datum)
+
+		if expected := test.Expected; nil != err {
+			t.Errorf("For test #%d, did not expect an error, but actually got one: (%T) %q", testNumber, err, err)
+			t.Errorf("\tDATUM: (%T) %#v", test.Datum, test.Datum)
+			t.Errorf("\tEXPECTED: %#v", expected)
+			t.Errorf("\tACTUAL:   %#v", actual)
+			continue
+		}
+
+		if expected := test.Expected; expected != actual {
+			t.Errorf("For test #%d ...", testNumber)
+			t.Errorf("\tDATUM: (%T) %#v", test.Datum, test.Datum)
+			t.Errorf("\tEXPECTED: %#v", expected)
+			t.Errorf("\tACTUAL:   %#v", actual)
+			continue
+		}
+	}
+}
+
+func TestDurationScanError(t *testing.T) {
+
+	tests := []interface{}{
+		"soon",
+		"",
+		[]byte("90"),
+		2.5,
+		int32(90),
+	}
+
+	for testNumber, datum := range tests {
+
+		var actual mdl.Duration
+
+		if err := actual.Scan(datum); nil == err {
+			t.Errorf("For test #%d, expected an error, but did not actually get one.", testNumber)
+			t.Errorf("\tDATUM: (%T) %#v", datum, datum)
+			t.Errorf("\tACTUAL: %#v", actual)
+			continue
+		}
+	}
+}
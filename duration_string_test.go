@@ -0,0 +1,68 @@
+package mdl_test
+
+import (
+	"github.com/reiver/go-mdl"
+
+	"testing"
+	"time"
+)
+
+func TestDurationString(t *testing.T) {
+
+	tests := []struct{
+		Value          mdl.Duration
+		ExpectedLoaded bool
+		Expected       string
+	}{
+		{
+			Value: mdl.NoDuration(),
+			ExpectedLoaded: false,
+			Expected:                 "",
+		},
+		{
+			Value: mdl.SomeDuration(0),
+			ExpectedLoaded: true,
+			Expected:                 "0s",
+		},
+		{
+			Value: mdl.SomeDuration(90 * time.Second),
+			ExpectedLoaded: true,
+			Expected:                 "1m30s",
+		},
+		{
+			Value: mdl.SomeDuration(-1500 * time.Millisecond),
+			ExpectedLoaded: true,
+			Expected:                 "-1.5s",
+		},
+	}
+
+	for testNumber, test := range tests {
+
+		actual, err := test.Value.String()
+
+		if !test.ExpectedLoaded && nil == err {
+			t.Errorf("For test #%d, expected an error, but did not actually get one: %#v", testNumber, err)
+			t.Errorf("\tEXPECTED loaded: %t", test.ExpectedLoaded)
+			t.Errorf("\tEXPECTED: %#v", test.Expected)
+			t.Errorf("\tACTUAL:   %#v", actual)
+			continue
+		}
+
+		if test.ExpectedLoaded && nil != err {
+			t.Errorf("For test #%d, did not expect an error, but actually got one: (%T) %q", testNumber, err, err)
+			t.Errorf("\tEXPECTED loaded: %t", test.ExpectedLoaded)
+			t.Errorf("\tEXPECTED: %#v", test.Expected)
+			t.Errorf("\tACTUAL:   %#v", actual)
+			continue
+		}
+
+		if expected := test.Expected; expected != actual {
+			t.Errorf("For test #%d ...", testNumber)
+			t.Errorf("\tEXPECTED loaded: %t", test.ExpectedLoaded)
+			t.Errorf("\tACTUAL   err:   (%T) %q", err, err)
+			t.Errorf("\tEXPECTED: %#v", expected)
+			t.Errorf("\tACTUAL:   %#v", actual)
+			continue
+		}
+	}
+}
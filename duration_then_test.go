@@ -0,0 +1,58 @@
+package mdl_test
+
+import (
+	"github.com/reiver/go-mdl"
+
+	"testing"
+	"time"
+)
+
+func TestDurationThen(t *testing.T) {
+
+	positive := func(d time.Duration) mdl.Duration {
+		if d <= 0 {
+			return mdl.NoDuration()
+		}
+		return mdl.SomeDuration(d)
+	}
+
+	tests := []struct{
+		Value    mdl.Duration
+		Func     func(time.Duration)mdl.Duration
+		Expected mdl.Duration
+	}{
+		{
+			Value:    mdl.NoDuration(),
+			Func:     positive,
+			Expected: mdl.NoDuration(),
+		},
+		{
+			Value:    mdl.SomeDuration(0),
+			Func:     positive,
+			Expected: mdl.NoDuration(),
+		},
+		{
+			Value:    mdl.SomeDuration(-1),
+			Func:     positive,
+			Expected: mdl.NoDuration(),
+		},
+		{
+			Value:    mdl.SomeDuration(90 * time.Second),
+			Func:     positive,
+			Expected: mdl.SomeDuration(90 * time.Second),
+		},
+	}
+
+	for testNumber, test := range tests {
+
+		actual := test.Value.Then(test.Func)
+
+		if expected := test.Expected; expected != actual {
+			t.Errorf("For test #%d ...", testNumber)
+			t.Errorf("\tVALUE:    %#v", test.Value)
+			t.Errorf("\tEXPECTED: %#v", expected)
+			t.Errorf("\tACTUAL:   %#v", actual)
+			continue
+		}
+	}
+}
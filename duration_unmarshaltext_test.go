@@ -0,0 +1,72 @@
+package mdl_test
+
+import (
+	"github.com/reiver/go-mdl"
+
+	"testing"
+	"time"
+)
+
+func TestDurationUnmarshalText(t *testing.T) {
+
+	tests := []struct{
+		Datum    []byte
+		Expected mdl.Duration
+	}{
+		{
+			Datum:      []byte(nil),
+			Expected: mdl.NoDuration(),
+		},
+
+
+
+		{
+			Datum:      []byte("0s"),
+			Expected: mdl.SomeDuration(0),
+		},
+		{
+			Datum:      []byte("1m30s"),
+			Expected: mdl.SomeDuration(90 * time.Second),
+		},
+		{
+			Datum:      []byte("90s"),
+			Expected: mdl.SomeDuration(90 * time.Second),
+		},
+		{
+			Datum:      []byte("-1ns"),
+			Expected: mdl.SomeDuration(-1),
+		},
+	}
+
+	for testNumber, test := range tests {
+
+		var actual mdl.Duration = mdl.SomeDuration(time.Hour)
+
+		err := actual.UnmarshalText(test.Datum)
+
+		if expected := test.Expected; nil != err {
+			t.Errorf("For test #%d, did not expect an error, but actually got one: (%T) %q", testNumber, err, err)
+			t.Errorf("\tDATUM: %q", test.Datum)
+			t.Errorf("\tEXPECTED: %#v", expected)
+			t.Errorf("\tACTUAL:   %#v", actual)
+			continue
+		}
+
+		if expected := test.Expected; expected != actual {
+			t.Errorf("For test #%d ...", testNumber)
+			t.Errorf("\tDATUM: %q", test.Datum)
+			t.Errorf("\tEXPECTED: %#v", expected)
+			t.Errorf("\tACTUAL:   %#v", actual)
+			continue
+		}
+	}
+
+	for testNumber, datum := range []string{"", "soon", "90"} {
+
+		var actual mdl.Duration
+		if err := actual.UnmarshalText([]byte(datum)); nil == err {
+			t.Errorf("For bad text #%d (%q), expected an error, but did not actually get one.", testNumber, datum)
+			continue
+		}
+	}
+}
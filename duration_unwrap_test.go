@@ -0,0 +1,69 @@
+package mdl_test
+
+import (
+	"github.com/reiver/go-mdl"
+
+	"testing"
+	"time"
+)
+
+func TestDurationUnwrap(t *testing.T) {
+
+	tests := []struct{
+		Value          mdl.Duration
+		ExpectedLoaded bool
+		Expected       time.Duration
+	}{
+		{
+			Value: mdl.NoDuration(),
+			ExpectedLoaded: false,
+			Expected:                 0,
+		},
+		{
+			Value: mdl.Duration{},
+			ExpectedLoaded: false,
+			Expected:                 0,
+		},
+
+
+
+		{
+			Value: mdl.SomeDuration(0),
+			ExpectedLoaded: true,
+			Expected:                 0,
+		},
+		{
+			Value: mdl.SomeDuration(90 * time.Second),
+			ExpectedLoaded: true,
+			Expected:                 90 * time.Second,
+		},
+		{
+			Value: mdl.SomeDuration(-1),
+			ExpectedLoaded: true,
+			Expected:                 -1,
+		},
+	}
+
+	for testNumber, test := range tests {
+
+		actual, actualLoaded := test.Value.Unwrap()
+
+		if expected, expectedLoaded := test.Expected, test.ExpectedLoaded; expectedLoaded != actualLoaded {
+			t.Errorf("For test #%d ...", testNumber)
+			t.Errorf("\tEXPECTED loaded: %t", expectedLoaded)
+			t.Errorf("\tACTUAL   loaded: %t", actualLoaded)
+			t.Errorf("\tEXPECTED: %v", expected)
+			t.Errorf("\tACTUAL:   %v", actual)
+			continue
+		}
+
+		if expected, expectedLoaded := test.Expected, test.ExpectedLoaded; expected != actual {
+			t.Errorf("For test #%d ...", testNumber)
+			t.Errorf("\tEXPECTED loaded: %t", expectedLoaded)
+			t.Errorf("\tACTUAL   loaded: %t", actualLoaded)
+			t.Errorf("\tEXPECTED: %v", expected)
+			t.Errorf("\tACTUAL:   %v", actual)
+			continue
+		}
+	}
+}
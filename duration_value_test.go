@@ -0,0 +1,92 @@
+package mdl_test
+
+import (
+	"github.com/reiver/go-mdl"
+
+	"testing"
+	"time"
+)
+
+func TestDurationValue(t *testing.T) {
+
+	tests := []struct{
+		Value          mdl.Duration
+		ExpectedLoaded bool
+		Expected       int64
+	}{
+		{
+			Value: mdl.NoDuration(),
+			ExpectedLoaded: false,
+			Expected:                 0,
+		},
+		{
+			Value: mdl.SomeDuration(0),
+			ExpectedLoaded: true,
+			Expected:                 0,
+		},
+		{
+			Value: mdl.SomeDuration(90 * time.Second),
+			ExpectedLoaded: true,
+			Expected:                 90000000000,
+		},
+		{
+			Value: mdl.SomeDuration(-1),
+			ExpectedLoaded: true,
+			Expected:                 -1,
+		},
+	}
+
+	for testNumber, test := range tests {
+
+		actualInterface, err := test.Value.Value()
+
+		if !test.ExpectedLoaded && nil == err {
+			t.Errorf("For test #%d, expected an error, but did not actually get one: %#v", testNumber, err)
+			t.Errorf("\tEXPECTED loaded: %t", test.ExpectedLoaded)
+			t.Errorf("\tEXPECTED: %#v", test.Expected)
+			t.Errorf("\tACTUAL: %T %#v", actualInterface, actualInterface)
+			continue
+		}
+
+		if test.ExpectedLoaded && nil != err {
+			t.Errorf("For test #%d, did not expect an error, but actually got one: (%T) %q", testNumber, err, err)
+			t.Errorf("\tEXPECTED loaded: %t", test.ExpectedLoaded)
+			t.Errorf("\tEXPECTED: %#v", test.Expected)
+			t.Errorf("\tACTUAL: %T %#v", actualInterface, actualInterface)
+			continue
+		}
+
+		if !test.ExpectedLoaded {
+			continue
+		}
+		actual, casted := actualInterface.(int64)
+		if !casted {
+			t.Errorf("For test #%d, expected type ‘int64’, but actually got type ‘%T’.", testNumber, actualInterface)
+			t.Errorf("\tEXPECTED loaded: %t", test.ExpectedLoaded)
+			t.Errorf("\tEXPECTED: %#v", test.Expected)
+			t.Errorf("\tACTUAL: %T %#v", actualInterface, actualInterface)
+			continue
+		}
+
+		if expected := test.Expected; expected != actual {
+			t.Errorf("For test #%d ...", testNumber)
+			t.Errorf("\tEXPECTED loaded: %t", test.ExpectedLoaded)
+			t.Errorf("\tEXPECTED: %#v", expected)
+			t.Errorf("\tACTUAL:   %#v", actual)
+			continue
+		}
+
+		// What .Value() returns scans back as the same value.
+		var scanned mdl.Duration
+		if err := scanned.Scan(actualInterface); nil != err {
+			t.Errorf("For test #%d, did not expect an error, but actually got one: (%T) %q", testNumber, err, err)
+			continue
+		}
+		if expected := test.Value; expected != scanned {
+			t.Errorf("For test #%d ...", testNumber)
+			t.Errorf("\tEXPECTED scanned: %#v", expected)
+			t.Errorf("\tACTUAL   scanned: %#v", scanned)
+			continue
+		}
+	}
+}
@@ -5,6 +5,37 @@ import (
 )
 
 var (
-	errEmptyKey       error = internalEmptyKey{}
-	errRuneError      error = errors.New("mdl: Rune Error")
+	errChecksumMismatch          error = errors.New("mdl: checksum mismatch")
+	errEmptyKey                  error = internalEmptyKey{}
+	errEmptyRecord               error = errors.New("mdl: empty record")
+	errEmptyStreamID             error = errors.New("mdl: empty stream ID")
+	errIdempotentIDNotReserved   error = errors.New("mdl: Idempotent ID Not Reserved")
+	errInstructionNoIdempotentID error = errors.New("mdl: Instruction Has No Idempotent ID")
+	errInstructionNoVerb         error = errors.New("mdl: Instruction Has No Verb")
+	errInternalError             error = errors.New("mdl: Internal Error")
+	errJSONNotObject             error = errors.New("mdl: JSON value is not a JSON object")
+	errJSONTrailingData          error = errors.New("mdl: unexpected data after JSON object")
+	errKeyPatternPartialWildcard error = errors.New("mdl: a “*” in a key pattern must be a whole token (use “\\*” for a literal asterisk)")
+	errKeyTrailingBackslash      error = errors.New("mdl: ends with a lone backslash")
+	errMalformedBinary           error = errors.New("mdl: malformed binary data")
+	errNilAttachments            error = errors.New("mdl: Nil Attachments")
+	errNilDB                     error = errors.New("mdl: Nil DB")
+	errNilEvent                  error = errors.New("mdl: Nil Event")
+	errNilEventStore             error = errors.New("mdl: Nil Event Store")
+	errNilHttpRequest            error = errors.New("mdl: Nil HTTP Request")
+	errNilInstruction            error = errors.New("mdl: Nil Instruction")
+	errNilKeyValues              error = errors.New("mdl: Nil Key-Values")
+	errNilReceiver               error = errors.New("mdl: Nil Receiver")
+	errNilWriter                 error = errors.New("mdl: Nil Writer")
+	errNoBoundary                error = errors.New("mdl: multipart boundary missing")
+	errNoIdempotentID            error = errors.New("mdl: no “X-Idempotent-ID” HTTP request header")
+	errNotAbsoluteURL            error = errors.New("mdl: not an absolute URL")
+	errNotBareEmailAddress       error = errors.New("mdl: not a bare e-mail address")
+	errNotLoaded                 error = errors.New("mdl: Not Loaded")
+	errNotStructPointer          error = errors.New("mdl: not a non-nil pointer to a struct")
+	errNoVerb                    error = errors.New("mdl: no HTTP request method")
+	errPayloadTooLarge           error = errors.New("mdl: payload too large")
+	errRecordTooLarge            error = errors.New("mdl: record too large")
+	errRuneError                 error = errors.New("mdl: Rune Error")
+	errTornRecord                error = errors.New("mdl: torn record")
 )
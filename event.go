@@ -0,0 +1,355 @@
+package mdl
+
+import (
+	"database/sql/driver"
+	"encoding/json"
+	"fmt"
+	"strings"
+)
+
+// Event represents something that happened.
+//
+// Some example events might be:
+//
+// • “that shopping cart was emptied”,
+//
+// • “this book was added to that shopping cart”,
+//
+// • “this item was added to that TODO list”, and
+//
+// • “this e-mail address was added to my profile”.
+//
+// Where a ‘mdl.Instruction’ asks for something to happen, a ‘mdl.Event’ records that it did happen.
+// In CQRS and Event Sourcing terminology, a ‘mdl.Event’ would be the equivalent of an ‘event’ (or a ‘domain event’).
+//
+//
+// ID
+//
+// ID is a unique identifier (ID) for the event.
+// (‘mdl.IdempotentIDGenerator’ can be used to generate these too.)
+//
+//
+// Type
+//
+// You can probably think of ‘Type’ as the name of the event. (Which is often the past tense of the ‘Verb’ of the instruction that caused it.)
+//
+// For example: “SHOPPING_CART_EMPTIED”, “ADDED_TO_SHOPPING_CART”, “APPENDED”, or “EMAIL_RECORDED”.
+//
+//
+// Stream ID and Version
+//
+// Events are grouped into streams. Usually there is a stream for each thing that events happen to (ex: each shopping cart, or each user).
+//
+// StreamID is the ID of the stream the event is in; and Version is the (one-based) position of the event in that stream.
+// So the first event in a stream has a Version of 1, the second event has a Version of 2, etc.
+//
+//
+// Position
+//
+// Position is the (one-based) position of the event among all the events in an ‘mdl.EventStore’, across all streams.
+// It is set by the ‘mdl.EventStore’ when the event is appended.
+//
+//
+// Occurred At
+//
+// OccurredAt is when the event happened.
+//
+//
+// Causation ID and Correlation ID
+//
+// CausationID is the ID of what caused the event; for example, the Idempotent ID of the ‘mdl.Instruction’ that caused it,
+// or the ID of another event.
+//
+// CorrelationID is the ID of the whole conversation (or workflow) the event is part of. It is usually copied from whatever caused the event.
+//
+//
+// Data and Metadata
+//
+// Data is what is recorded about the event itself (ex: the e-mail address that was added).
+//
+// Metadata is anything else someone wants to record with the event (ex: the IP address, or user agent, of the HTTP request that caused it).
+//
+//
+// Database
+//
+// ‘mdl.Event’ fits the database/sql.Scanner and database/sql/driver.Valuer interfaces; so a whole event can be stored in (and loaded from)
+// a single column. It is stored as JSON (see .MarshalJSON()).
+type Event struct {
+	ID            String
+	Type          String
+	StreamID      String
+	Version       Uint64
+	Position      Uint64
+	OccurredAt    Time
+	CausationID   String
+	CorrelationID String
+	Data          KeyValues
+	Metadata      KeyValues
+}
+
+// eventJSON is what a ‘mdl.Event’ looks like as JSON.
+type eventJSON struct {
+	ID            String              `json:"id"`
+	Type          String              `json:"type"`
+	StreamID      String              `json:"stream_id"`
+	Version       Uint64              `json:"version"`
+	Position      Uint64              `json:"position"`
+	OccurredAt    Time                `json:"occurred_at"`
+	CausationID   String              `json:"causation_id"`
+	CorrelationID String              `json:"correlation_id"`
+	Data          map[string][]string `json:"data,omitempty"`
+	Metadata      map[string][]string `json:"metadata,omitempty"`
+}
+
+// CopyTo copies the ‘mdl.Event’ to ‘dst’.
+//
+// (Since ‘mdl.Event’ has ‘mdl.KeyValues’ in it, it should not be copied with an assignment.)
+func (receiver *Event) CopyTo(dst *Event) error {
+	if nil == receiver {
+		return errNilReceiver
+	}
+	if nil == dst {
+		return errNilEvent
+	}
+	if receiver == dst {
+		return nil
+	}
+
+	dst.ID            = receiver.ID
+	dst.Type          = receiver.Type
+	dst.StreamID      = receiver.StreamID
+	dst.Version       = receiver.Version
+	dst.Position      = receiver.Position
+	dst.OccurredAt    = receiver.OccurredAt
+	dst.CausationID   = receiver.CausationID
+	dst.CorrelationID = receiver.CorrelationID
+	dst.Data.replace(receiver.Data.snapshot())
+	dst.Metadata.replace(receiver.Metadata.snapshot())
+
+	return nil
+}
+
+// Format makes ‘mdl.Event’ fit the fmt.Formatter interface.
+//
+// With the %v and %s verbs, the event is written as JSON (see .MarshalJSON()); and with the %#v verb, .GoString() is used.
+func (receiver *Event) Format(f fmt.State, c rune) {
+	switch {
+	case 'v' == c && f.Flag('#'):
+		fmt.Fprint(f, receiver.GoString())
+	case 'v' == c, 's' == c:
+		p, err := receiver.MarshalJSON()
+		if nil != err {
+			fmt.Fprintf(f, "%%!%c(%s)", c, err)
+			return
+		}
+		f.Write(p)
+	default:
+		fmt.Fprintf(f, "%%!%c(%s)", c, receiver.GoString())
+	}
+}
+
+// GoString makes ‘mdl.Event’ fit the fmt.GoStringer interface.
+//
+// It gets used with the %#v verb with the printing family of functions
+// in the Go built-in "fmt" package.
+func (receiver *Event) GoString() string {
+	if nil == receiver {
+		return "(*mdl.Event)(nil)"
+	}
+
+	var builder strings.Builder
+
+	builder.WriteString("&mdl.Event{")
+	builder.WriteString("ID:")
+	builder.WriteString(receiver.ID.GoString())
+	builder.WriteString(", Type:")
+	builder.WriteString(receiver.Type.GoString())
+	builder.WriteString(", StreamID:")
+	builder.WriteString(receiver.StreamID.GoString())
+	builder.WriteString(", Version:")
+	builder.WriteString(receiver.Version.GoString())
+	builder.WriteString(", Position:")
+	builder.WriteString(receiver.Position.GoString())
+	builder.WriteString(", OccurredAt:")
+	builder.WriteString(receiver.OccurredAt.GoString())
+	builder.WriteString(", CausationID:")
+	builder.WriteString(receiver.CausationID.GoString())
+	builder.WriteString(", CorrelationID:")
+	builder.WriteString(receiver.CorrelationID.GoString())
+	builder.WriteString(", Data:")
+	eventWriteKeyValuesGoString(&builder, &receiver.Data)
+	builder.WriteString(", Metadata:")
+	eventWriteKeyValuesGoString(&builder, &receiver.Metadata)
+	builder.WriteRune('}')
+
+	return builder.String()
+}
+
+// MarshalJSON makes ‘mdl.Event’ fit the encoding/json.Marshaler interface.
+//
+// An event is marshaled as a JSON object; with Data and Metadata each as a JSON object whose names are keys in canonical form,
+// and whose values are JSON arrays of all the values for that key. Fields that are ‘nothing’ are marshaled as JSON null.
+//
+// For example:
+//
+//	{"id":"01","type":"EMAIL_RECORDED","stream_id":"user/123","version":3,"position":null,"occurred_at":"2015-05-07T10:25:09Z","causation_id":null,"correlation_id":null,"data":{"email_address":["joeblow@example.com"]}}
+func (receiver *Event) MarshalJSON() ([]byte, error) {
+	if nil == receiver {
+		return nil, errNilReceiver
+	}
+
+	var data eventJSON = eventJSON{
+		ID:            receiver.ID,
+		Type:          receiver.Type,
+		StreamID:      receiver.StreamID,
+		Version:       receiver.Version,
+		Position:      receiver.Position,
+		OccurredAt:    receiver.OccurredAt,
+		CausationID:   receiver.CausationID,
+		CorrelationID: receiver.CorrelationID,
+		Data:          eventKeyValuesToJSON(&receiver.Data),
+		Metadata:      eventKeyValuesToJSON(&receiver.Metadata),
+	}
+
+	return json.Marshal(data)
+}
+
+// Scan makes ‘mdl.Event’ fit the database/sql.Scanner interface.
+//
+// The source can be the JSON form of the event (see .MarshalJSON()), as a string or a []byte; or another *mdl.Event, which is copied.
+//
+// A nil source scans as an empty event (i.e., with every field being ‘nothing’).
+func (receiver *Event) Scan(src interface{}) error {
+	if nil == receiver {
+		return errNilReceiver
+	}
+
+	switch casted := src.(type) {
+	case nil:
+		receiver.reset()
+		return nil
+	case *Event:
+		return casted.CopyTo(receiver)
+	case string:
+		return receiver.UnmarshalJSON([]byte(casted))
+	case []byte:
+		if nil == casted {
+			receiver.reset()
+			return nil
+		}
+		return receiver.UnmarshalJSON(casted)
+	default:
+		return unsupportedSource(src)
+	}
+}
+
+// UnmarshalJSON makes ‘mdl.Event’ fit the encoding/json.Unmarshaler interface.
+//
+// It is the opposite of .MarshalJSON(). Anything already in the event is replaced.
+func (receiver *Event) UnmarshalJSON(p []byte) error {
+	if nil == receiver {
+		return errNilReceiver
+	}
+
+	var data eventJSON
+	if err := json.Unmarshal(p, &data); nil != err {
+		return err
+	}
+
+	eventData, err := eventKeyValuesFromJSON(data.Data)
+	if nil != err {
+		return err
+	}
+	eventMetadata, err := eventKeyValuesFromJSON(data.Metadata)
+	if nil != err {
+		return err
+	}
+
+	receiver.ID            = data.ID
+	receiver.Type          = data.Type
+	receiver.StreamID      = data.StreamID
+	receiver.Version       = data.Version
+	receiver.Position      = data.Position
+	receiver.OccurredAt    = data.OccurredAt
+	receiver.CausationID   = data.CausationID
+	receiver.CorrelationID = data.CorrelationID
+	receiver.Data.replace(eventData)
+	receiver.Metadata.replace(eventMetadata)
+
+	return nil
+}
+
+// Value makes ‘mdl.Event’ fit the database/sql/driver.Valuer interface.
+//
+// The event is stored as its JSON form (see .MarshalJSON()).
+func (receiver *Event) Value() (driver.Value, error) {
+	if nil == receiver {
+		return nil, errNilReceiver
+	}
+
+	p, err := receiver.MarshalJSON()
+	if nil != err {
+		return nil, err
+	}
+
+	return string(p), nil
+}
+
+func (receiver *Event) reset() {
+	receiver.ID            = NoString()
+	receiver.Type          = NoString()
+	receiver.StreamID      = NoString()
+	receiver.Version       = NoUint64()
+	receiver.Position      = NoUint64()
+	receiver.OccurredAt    = NoTime()
+	receiver.CausationID   = NoString()
+	receiver.CorrelationID = NoString()
+	receiver.Data.replace(nil)
+	receiver.Metadata.replace(nil)
+}
+
+func eventKeyValuesFromJSON(data map[string][]string) (map[Key][]string, error) {
+	if 0 == len(data) {
+		return nil, nil
+	}
+
+	var result map[Key][]string = make(map[Key][]string, len(data))
+	for serialized, values := range data {
+		var key Key
+		if err := key.scanSerialized(serialized); nil != err {
+			return nil, err
+		}
+		if 0 == len(values) {
+			continue
+		}
+		result[key] = append(result[key], values...)
+	}
+
+	return result, nil
+}
+
+func eventKeyValuesToJSON(keyvalues *KeyValues) map[string][]string {
+	var result map[string][]string
+
+	keyvalues.rangePrefix(NoKey(), func(key Key, values []string) bool {
+		if nil == result {
+			result = map[string][]string{}
+		}
+		result[key.CanonicalForm()] = values
+		return true
+	})
+
+	return result
+}
+
+func eventWriteKeyValuesGoString(builder *strings.Builder, keyvalues *KeyValues) {
+	var lines []string
+	keyvalues.rangePrefix(NoKey(), func(key Key, values []string) bool {
+		lines = append(lines, fmt.Sprintf("%q:%#v", key.CanonicalForm(), values))
+		return true
+	})
+
+	builder.WriteString("mdl.KeyValues{")
+	builder.WriteString(strings.Join(lines, ", "))
+	builder.WriteRune('}')
+}
@@ -0,0 +1,175 @@
+package mdl_test
+
+import (
+	"github.com/reiver/go-mdl"
+
+	"database/sql"
+	"database/sql/driver"
+	"fmt"
+	"testing"
+	"time"
+)
+
+var (
+	_ sql.Scanner   = &mdl.Event{}
+	_ driver.Valuer = &mdl.Event{}
+)
+
+func eventFixture() *mdl.Event {
+	var event mdl.Event
+
+	event.ID            = mdl.SomeString("z-2015-05-07T10:25:09Z_abc")
+	event.Type          = mdl.SomeString("EMAIL_RECORDED")
+	event.StreamID      = mdl.SomeString("user/123")
+	event.Version       = mdl.SomeUint64(3)
+	event.Position      = mdl.SomeUint64(17)
+	event.OccurredAt    = mdl.SomeTime(time.Date(2015, 5, 7, 10, 25, 9, 0, time.UTC))
+	event.CausationID   = mdl.SomeString("z-2015-05-07T10:25:08Z_xyz")
+	event.Data.Store(mdl.SomeKey("email_address"), "joeblow@example.com")
+	event.Data.Append(mdl.SomeKey("tags"), "b")
+	event.Data.Append(mdl.SomeKey("tags"), "a")
+	event.Data.Store(mdl.SomeKey("i/o", "x"), "y")
+	event.Metadata.Store(mdl.SomeKey("user_agent"), "curl/7.64.1")
+
+	return &event
+}
+
+func TestEventMarshalJSON(t *testing.T) {
+
+	event := eventFixture()
+
+	actual, err := event.MarshalJSON()
+	if nil != err {
+		t.Errorf("Did not expect an error, but actually got one: (%T) %q", err, err)
+		return
+	}
+
+	expected :=
+		`{"id":"z-2015-05-07T10:25:09Z_abc","type":"EMAIL_RECORDED","stream_id":"user/123","version":3,"position":17,` +
+		`"occurred_at":"2015-05-07T10:25:09Z","causation_id":"z-2015-05-07T10:25:08Z_xyz","correlation_id":null,` +
+		`"data":{"email_address":["joeblow@example.com"],"i\\/o/x":["y"],"tags":["b","a"]},` +
+		`"metadata":{"user_agent":["curl/7.64.1"]}}`
+
+	if expected != string(actual) {
+		t.Errorf("The actual JSON is not what was expected.")
+		t.Logf("EXPECTED: %s", expected)
+		t.Logf("ACTUAL:   %s", actual)
+		return
+	}
+
+	if expected, actual := expected, fmt.Sprintf("%v", event); expected != actual {
+		t.Errorf("Expected %%v to be the JSON, but actually got %q.", actual)
+		return
+	}
+}
+
+func TestEventScanValue(t *testing.T) {
+
+	event := eventFixture()
+
+	value, err := event.Value()
+	if nil != err {
+		t.Errorf("Did not expect an error, but actually got one: (%T) %q", err, err)
+		return
+	}
+
+	for _, src := range []interface{}{value, []byte(value.(string)), event} {
+
+		var scanned mdl.Event
+		scanned.Data.Store(mdl.SomeKey("left-over"), "should be gone")
+
+		if err := scanned.Scan(src); nil != err {
+			t.Errorf("For source of type %T, did not expect an error, but actually got one: (%T) %q", src, err, err)
+			continue
+		}
+
+		if expected, actual := event.GoString(), scanned.GoString(); expected != actual {
+			t.Errorf("For source of type %T, the scanned event is not what was expected.", src)
+			t.Logf("EXPECTED: %s", expected)
+			t.Logf("ACTUAL:   %s", actual)
+			continue
+		}
+
+		if expected, actual := []string{"b", "a"}, scanned.Data.FetchAll("tags"); fmt.Sprint(expected) != fmt.Sprint(actual) {
+			t.Errorf("For source of type %T, expected %#v, but actually got %#v.", src, expected, actual)
+			continue
+		}
+	}
+}
+
+func TestEventScanNil(t *testing.T) {
+
+	event := eventFixture()
+
+	if err := event.Scan(nil); nil != err {
+		t.Errorf("Did not expect an error, but actually got one: (%T) %q", err, err)
+		return
+	}
+
+	var empty mdl.Event
+
+	if expected, actual := empty.GoString(), event.GoString(); expected != actual {
+		t.Errorf("Expected %s, but actually got %s.", expected, actual)
+		return
+	}
+}
+
+func TestEventScanError(t *testing.T) {
+
+	tests := []interface{}{
+		5,
+		`{"id":`,
+		`{"data":{"a\\":["b"]}}`,
+		`{"version":"three"}`,
+	}
+
+	for testNumber, src := range tests {
+
+		var event mdl.Event
+
+		if err := event.Scan(src); nil == err {
+			t.Errorf("For test #%d, expected an error, but did not actually get one.", testNumber)
+			continue
+		}
+	}
+}
+
+func TestEventCopyTo(t *testing.T) {
+
+	event := eventFixture()
+
+	var copied mdl.Event
+	if err := event.CopyTo(&copied); nil != err {
+		t.Errorf("Did not expect an error, but actually got one: (%T) %q", err, err)
+		return
+	}
+
+	event.Data.Store(mdl.SomeKey("changed"), "after")
+
+	if expected, actual := mdl.NoString(), copied.Data.Fetch("changed"); expected != actual {
+		t.Errorf("Expected the copy not to be changed, but it actually was: %#v", actual)
+		return
+	}
+	if expected, actual := event.Version, copied.Version; expected != actual {
+		t.Errorf("Expected %#v, but actually got %#v.", expected, actual)
+		return
+	}
+}
+
+func TestEventGoString(t *testing.T) {
+
+	var event mdl.Event
+	event.Type = mdl.SomeString("APPENDED")
+	event.Data.Append(mdl.SomeKey("tags"), "a")
+
+	expected := `&mdl.Event{ID:mdl.NoString(), Type:mdl.SomeString("APPENDED"), StreamID:mdl.NoString(), Version:mdl.NoUint64(), Position:mdl.NoUint64(), ` +
+		`OccurredAt:mdl.NoTime(), CausationID:mdl.NoString(), CorrelationID:mdl.NoString(), ` +
+		`Data:mdl.KeyValues{"tags":[]string{"a"}}, Metadata:mdl.KeyValues{}}`
+
+	if actual := fmt.Sprintf("%#v", &event); expected != actual {
+		t.Errorf("The actual value is not what was expected.")
+		t.Logf("EXPECTED: %s", expected)
+		t.Logf("ACTUAL:   %s", actual)
+		return
+	}
+}
@@ -0,0 +1,140 @@
+package mdl
+
+import (
+	"sync"
+)
+
+// EventStore is where events are appended to streams, and read back from.
+//
+// Package mdl comes with an in-memory implementation of ‘mdl.EventStore’, ‘mdl.MemoryEventStore’; a file-based one, ‘mdl.FileEventStore’;
+// and one for SQL databases, ‘mdl.SQLEventStore’.
+// Other implementations can be plugged in; and can be checked with the conformance tests in package
+// "github.com/reiver/go-mdl/mdltest" (see mdltest.TestEventStore()).
+//
+// Implementations must be safe to use from multiple goroutines.
+//
+// Events read from an ‘mdl.EventStore’ are copies; so changing them does not change what is in the ‘mdl.EventStore’.
+type EventStore interface {
+
+	// Append atomically appends ‘events’ to the end of the stream ‘streamID’, and returns the new version of the stream.
+	//
+	// ‘expectedVersion’ is used for optimistic concurrency:
+	//
+	// • mdl.NoUint64() means to append no matter what version the stream is at,
+	//
+	// • mdl.SomeUint64(0) means to only append if the stream does not exist yet (i.e., has no events), and
+	//
+	// • mdl.SomeUint64(n) means to only append if the stream is at version ‘n’ (i.e., its last event has a Version of ‘n’).
+	//
+	// If the stream is not at ‘expectedVersion’, then nothing is appended, and Append returns an ‘mdl.VersionConflict’ error.
+	//
+	// The StreamID, Version, and Position of each of the ‘events’ is set by Append; whatever they were before is ignored.
+	Append(streamID string, expectedVersion Uint64, events ...*Event) (version uint64, err error)
+
+	// ReadStreamForward returns the events in the stream ‘streamID’ with a Version of ‘fromVersion’ or more, in order.
+	//
+	// At most ‘limit’ events are returned; unless ‘limit’ is zero (or less), in which case all of them are returned.
+	//
+	// If the stream does not exist, then ReadStreamForward returns no events, and no error.
+	ReadStreamForward(streamID string, fromVersion uint64, limit int) ([]*Event, error)
+
+	// ReadStreamBackward returns the events in the stream ‘streamID’ with a Version of ‘fromVersion’ or less, in reverse order.
+	// (Use math.MaxUint64 as ‘fromVersion’ to start from the last event.)
+	//
+	// At most ‘limit’ events are returned; unless ‘limit’ is zero (or less), in which case all of them are returned.
+	//
+	// If the stream does not exist, then ReadStreamBackward returns no events, and no error.
+	ReadStreamBackward(streamID string, fromVersion uint64, limit int) ([]*Event, error)
+
+	// ReadAll returns the events (from all streams) with a Position of ‘fromPosition’ or more, in order of their Position.
+	//
+	// At most ‘limit’ events are returned; unless ‘limit’ is zero (or less), in which case all of them are returned.
+	ReadAll(fromPosition uint64, limit int) ([]*Event, error)
+}
+
+// eventStoreCheckAppend checks the arguments to mdl.EventStore.Append() that do not depend on what is in the ‘mdl.EventStore’.
+func eventStoreCheckAppend(streamID string, events []*Event) error {
+	if "" == streamID {
+		return errEmptyStreamID
+	}
+
+	for _, event := range events {
+		if nil == event {
+			return errNilEvent
+		}
+	}
+
+	return nil
+}
+
+// eventStoreCheckVersion returns an ‘mdl.VersionConflict’ error if a stream at version ‘actualVersion’ cannot be appended to
+// with ‘expectedVersion’.
+func eventStoreCheckVersion(streamID string, expectedVersion Uint64, actualVersion uint64) error {
+	expected, something := expectedVersion.Unwrap()
+	if !something {
+		return nil
+	}
+
+	if expected != actualVersion {
+		return internalVersionConflict{
+			streamID:streamID,
+			expectedVersion:expected,
+			actualVersion:actualVersion,
+		}
+	}
+
+	return nil
+}
+
+// eventStoreLimit returns how many of ‘length’ events to return for ‘limit’.
+func eventStoreLimit(length int, limit int) int {
+	if 0 < limit && limit < length {
+		return limit
+	}
+
+	return length
+}
+
+// EventStoreNotifier is an optional interface an ‘mdl.EventStore’ can have, so that subscriptions (see mdl.Subscribe())
+// find out about appended events right away, rather than having to poll for them.
+//
+// ‘mdl.MemoryEventStore’, ‘mdl.FileEventStore’, and ‘mdl.SQLEventStore’ all have it. (Although ‘mdl.SQLEventStore’ only
+// knows about events appended through it; and not ones appended by other programs sharing the same database.)
+type EventStoreNotifier interface {
+
+	// Notify returns a channel that is closed the next time events are appended.
+	//
+	// To not miss any events, call Notify before reading; and then wait on the channel if there was nothing new to read.
+	Notify() <-chan struct{}
+}
+
+// eventStoreNotifier is what ‘mdl.EventStore’ implementations in package mdl use to have the ‘mdl.EventStoreNotifier’ interface.
+//
+// The zero value is ready to use.
+type eventStoreNotifier struct {
+	mutex    sync.Mutex
+	appended chan struct{}
+}
+
+// Notify returns a channel that is closed the next time notify is called.
+func (receiver *eventStoreNotifier) Notify() <-chan struct{} {
+	receiver.mutex.Lock()
+	defer receiver.mutex.Unlock()
+
+	if nil == receiver.appended {
+		receiver.appended = make(chan struct{})
+	}
+
+	return receiver.appended
+}
+
+// notify should be called after events are appended, once they can be read.
+func (receiver *eventStoreNotifier) notify() {
+	receiver.mutex.Lock()
+	defer receiver.mutex.Unlock()
+
+	if nil != receiver.appended {
+		close(receiver.appended)
+		receiver.appended = nil
+	}
+}
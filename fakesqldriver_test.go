@@ -0,0 +1,407 @@
+package mdl_test
+
+import (
+	"database/sql"
+	"database/sql/driver"
+	"errors"
+	"fmt"
+	"io"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+)
+
+// fakesql is an in-process database/sql driver, that only knows the SQL that mdl.SQLEventStore uses.
+// It is so mdl.SQLEventStore can be tested without a database server.
+//
+// The data source name is the dialect ("postgresql", "mysql", or "sqlite") and the name of the database, separated by a colon.
+// For example, "sqlite:test1". The dialect decides which placeholders the fake database accepts, and what its errors look like.
+//
+// Each statement sees what has been committed, plus what its own transaction has inserted. Unique constraints are checked
+// on insert, and then again on commit; so two transactions racing to insert the same row both get to commit, and the second one fails.
+const fakeSQLDriverName = "mdl-fakesql"
+
+var fakeSQL = &fakeSQLDriver{
+	databases: map[string]*fakeSQLDatabase{},
+}
+
+func init() {
+	sql.Register(fakeSQLDriverName, fakeSQL)
+}
+
+type fakeSQLDriver struct {
+	mutex     sync.Mutex
+	databases map[string]*fakeSQLDatabase
+}
+
+type fakeSQLDatabase struct {
+	mutex   sync.Mutex
+	dialect string
+	tables  map[string][]fakeSQLRow
+
+	// insertErr, if it is not nil, is returned by every insert; and inserts counts them.
+	insertErr error
+	inserts   int
+}
+
+type fakeSQLRow struct {
+	position  int64
+	streamID  string
+	version   int64
+	eventType interface{}
+	event     string
+}
+
+type fakeSQLConn struct {
+	database *fakeSQLDatabase
+	inTx     bool
+	pending  map[string][]fakeSQLRow
+}
+
+type fakeSQLStmt struct {
+	conn  *fakeSQLConn
+	query string
+}
+
+type fakeSQLTx struct {
+	conn *fakeSQLConn
+}
+
+type fakeSQLRows struct {
+	columns []string
+	values  [][]driver.Value
+}
+
+var (
+	fakeSQLCreateTable = regexp.MustCompile(`^CREATE TABLE IF NOT EXISTS (\w+) \(`)
+	fakeSQLMaxVersion  = regexp.MustCompile(`^SELECT COALESCE\(MAX\(version\), 0\) FROM (\w+) WHERE stream_id = \?$`)
+	fakeSQLMaxPosition = regexp.MustCompile(`^SELECT COALESCE\(MAX\(position\), 0\) FROM (\w+)$`)
+	fakeSQLInsert      = regexp.MustCompile(`^INSERT INTO (\w+) \(position, stream_id, version, event_type, event\) VALUES \(\?, \?, \?, \?, \?\)$`)
+	fakeSQLSelect      = regexp.MustCompile(`^SELECT position, stream_id, version, event FROM (\w+) WHERE (position >= \?|stream_id = \? AND version (<=|>=) \?) ORDER BY (position|version) (ASC|DESC)(?: LIMIT (\d+))?$`)
+
+	fakeSQLPostgreSQLPlaceholder = regexp.MustCompile(`\$\d+`)
+)
+
+func (receiver *fakeSQLDriver) Open(name string) (driver.Conn, error) {
+	colon := strings.IndexByte(name, ':')
+	if colon < 0 {
+		return nil, fmt.Errorf("fakesql: bad data source name %q", name)
+	}
+
+	receiver.mutex.Lock()
+	defer receiver.mutex.Unlock()
+
+	database, found := receiver.databases[name]
+	if !found {
+		database = &fakeSQLDatabase{
+			dialect: name[:colon],
+			tables: map[string][]fakeSQLRow{},
+		}
+		receiver.databases[name] = database
+	}
+
+	return &fakeSQLConn{database:database}, nil
+}
+
+func (receiver *fakeSQLConn) Begin() (driver.Tx, error) {
+	if receiver.inTx {
+		return nil, errors.New("fakesql: already in a transaction")
+	}
+
+	receiver.inTx = true
+	receiver.pending = map[string][]fakeSQLRow{}
+
+	return &fakeSQLTx{conn:receiver}, nil
+}
+
+func (receiver *fakeSQLConn) Close() error {
+	return nil
+}
+
+func (receiver *fakeSQLConn) Prepare(query string) (driver.Stmt, error) {
+	return &fakeSQLStmt{conn:receiver, query:query}, nil
+}
+
+func (receiver *fakeSQLTx) Commit() error {
+	conn := receiver.conn
+	defer func() {
+		conn.inTx = false
+		conn.pending = nil
+	}()
+
+	database := conn.database
+	database.mutex.Lock()
+	defer database.mutex.Unlock()
+
+	for table, rows := range conn.pending {
+		for _, row := range rows {
+			if err := database.checkUnique(table, database.tables[table], row); nil != err {
+				return err
+			}
+		}
+	}
+
+	for table, rows := range conn.pending {
+		database.tables[table] = append(database.tables[table], rows...)
+	}
+
+	return nil
+}
+
+func (receiver *fakeSQLTx) Rollback() error {
+	receiver.conn.inTx = false
+	receiver.conn.pending = nil
+
+	return nil
+}
+
+func (receiver *fakeSQLStmt) Close() error {
+	return nil
+}
+
+func (receiver *fakeSQLStmt) NumInput() int {
+	return -1
+}
+
+func (receiver *fakeSQLStmt) Exec(args []driver.Value) (driver.Result, error) {
+	query, err := receiver.conn.database.normalize(receiver.query)
+	if nil != err {
+		return nil, err
+	}
+
+	database := receiver.conn.database
+	database.mutex.Lock()
+	defer database.mutex.Unlock()
+
+	if match := fakeSQLCreateTable.FindStringSubmatch(query); nil != match {
+		if _, found := database.tables[match[1]]; !found {
+			database.tables[match[1]] = nil
+		}
+		return driver.RowsAffected(0), nil
+	}
+
+	if match := fakeSQLInsert.FindStringSubmatch(query); nil != match {
+		table := match[1]
+		if _, found := database.tables[table]; !found {
+			return nil, fmt.Errorf("fakesql: no such table: %s", table)
+		}
+
+		database.inserts++
+		if nil != database.insertErr {
+			return nil, database.insertErr
+		}
+
+		if 5 != len(args) {
+			return nil, fmt.Errorf("fakesql: expected 5 arguments, but got %d", len(args))
+		}
+		position, ok1 := args[0].(int64)
+		streamID, ok2 := args[1].(string)
+		version, ok3 := args[2].(int64)
+		event, ok4 := args[4].(string)
+		if !ok1 || !ok2 || !ok3 || !ok4 {
+			return nil, fmt.Errorf("fakesql: unexpected argument types %T %T %T %T", args[0], args[1], args[2], args[4])
+		}
+
+		row := fakeSQLRow{
+			position:position,
+			streamID:streamID,
+			version:version,
+			eventType:args[3],
+			event:event,
+		}
+
+		if err := database.checkUnique(table, receiver.conn.rows(table), row); nil != err {
+			return nil, err
+		}
+
+		if receiver.conn.inTx {
+			receiver.conn.pending[table] = append(receiver.conn.pending[table], row)
+		} else {
+			database.tables[table] = append(database.tables[table], row)
+		}
+
+		return driver.RowsAffected(1), nil
+	}
+
+	return nil, fmt.Errorf("fakesql: unexpected statement %q", query)
+}
+
+func (receiver *fakeSQLStmt) Query(args []driver.Value) (driver.Rows, error) {
+	query, err := receiver.conn.database.normalize(receiver.query)
+	if nil != err {
+		return nil, err
+	}
+
+	database := receiver.conn.database
+	database.mutex.Lock()
+	defer database.mutex.Unlock()
+
+	tableRows := func(table string) ([]fakeSQLRow, error) {
+		if _, found := database.tables[table]; !found {
+			return nil, fmt.Errorf("fakesql: no such table: %s", table)
+		}
+		return receiver.conn.rows(table), nil
+	}
+
+	if match := fakeSQLMaxVersion.FindStringSubmatch(query); nil != match {
+		rows, err := tableRows(match[1])
+		if nil != err {
+			return nil, err
+		}
+
+		var max int64
+		for _, row := range rows {
+			if args[0] == row.streamID && max < row.version {
+				max = row.version
+			}
+		}
+		return &fakeSQLRows{columns:[]string{"max"}, values:[][]driver.Value{{max}}}, nil
+	}
+
+	if match := fakeSQLMaxPosition.FindStringSubmatch(query); nil != match {
+		rows, err := tableRows(match[1])
+		if nil != err {
+			return nil, err
+		}
+
+		var max int64
+		for _, row := range rows {
+			if max < row.position {
+				max = row.position
+			}
+		}
+		return &fakeSQLRows{columns:[]string{"max"}, values:[][]driver.Value{{max}}}, nil
+	}
+
+	if match := fakeSQLSelect.FindStringSubmatch(query); nil != match {
+		rows, err := tableRows(match[1])
+		if nil != err {
+			return nil, err
+		}
+
+		var selected []fakeSQLRow
+		for _, row := range rows {
+			switch {
+			case "position >= ?" == match[2]:
+				if args[0].(int64) <= row.position {
+					selected = append(selected, row)
+				}
+			case ">=" == match[3]:
+				if args[0] == row.streamID && args[1].(int64) <= row.version {
+					selected = append(selected, row)
+				}
+			case "<=" == match[3]:
+				if args[0] == row.streamID && row.version <= args[1].(int64) {
+					selected = append(selected, row)
+				}
+			}
+		}
+
+		sort.Slice(selected, func(i, j int) bool {
+			var less bool
+			if "position" == match[4] {
+				less = selected[i].position < selected[j].position
+			} else {
+				less = selected[i].version < selected[j].version
+			}
+			if "DESC" == match[5] {
+				return !less
+			}
+			return less
+		})
+
+		if "" != match[6] {
+			limit, _ := strconv.Atoi(match[6])
+			if limit < len(selected) {
+				selected = selected[:limit]
+			}
+		}
+
+		result := &fakeSQLRows{columns:[]string{"position", "stream_id", "version", "event"}}
+		for _, row := range selected {
+			// Like some real drivers, text is returned as []byte.
+			result.values = append(result.values, []driver.Value{row.position, row.streamID, row.version, []byte(row.event)})
+		}
+		return result, nil
+	}
+
+	return nil, fmt.Errorf("fakesql: unexpected query %q", query)
+}
+
+func (receiver *fakeSQLRows) Columns() []string {
+	return receiver.columns
+}
+
+func (receiver *fakeSQLRows) Close() error {
+	return nil
+}
+
+func (receiver *fakeSQLRows) Next(dest []driver.Value) error {
+	if len(receiver.values) <= 0 {
+		return io.EOF
+	}
+
+	copy(dest, receiver.values[0])
+	receiver.values = receiver.values[1:]
+
+	return nil
+}
+
+// rows returns the rows of ‘table’ this connection can see.
+// The caller must hold the database lock.
+func (receiver *fakeSQLConn) rows(table string) []fakeSQLRow {
+	var rows []fakeSQLRow
+	rows = append(rows, receiver.database.tables[table]...)
+	rows = append(rows, receiver.pending[table]...)
+
+	return rows
+}
+
+// checkUnique returns the error the dialect's database would, if ‘row’ cannot be added to ‘rows’.
+func (receiver *fakeSQLDatabase) checkUnique(table string, rows []fakeSQLRow, row fakeSQLRow) error {
+	for _, existing := range rows {
+		if existing.streamID == row.streamID && existing.version == row.version {
+			switch receiver.dialect {
+			case "postgresql":
+				return fmt.Errorf(`pq: duplicate key value violates unique constraint "%s_stream_version"`, table)
+			case "mysql":
+				return fmt.Errorf(`Error 1062: Duplicate entry '%s-%d' for key '%s.%s_stream_version'`, row.streamID, row.version, table, table)
+			default:
+				return fmt.Errorf(`UNIQUE constraint failed: %s.stream_id, %s.version`, table, table)
+			}
+		}
+	}
+
+	for _, existing := range rows {
+		if existing.position == row.position {
+			switch receiver.dialect {
+			case "postgresql":
+				return fmt.Errorf(`pq: duplicate key value violates unique constraint "%s_position"`, table)
+			case "mysql":
+				return fmt.Errorf(`Error 1062: Duplicate entry '%d' for key 'PRIMARY'`, row.position)
+			default:
+				return fmt.Errorf(`UNIQUE constraint failed: %s.position`, table)
+			}
+		}
+	}
+
+	return nil
+}
+
+// normalize checks that ‘query’ uses the placeholders of the dialect; and returns it with them all as “?”.
+func (receiver *fakeSQLDatabase) normalize(query string) (string, error) {
+	if "postgresql" != receiver.dialect {
+		if strings.Contains(query, "$") {
+			return "", fmt.Errorf("fakesql: %s does not use $n placeholders: %q", receiver.dialect, query)
+		}
+		return query, nil
+	}
+
+	if strings.Contains(query, "?") {
+		return "", fmt.Errorf("fakesql: postgresql does not use ? placeholders: %q", query)
+	}
+
+	return fakeSQLPostgreSQLPlaceholder.ReplaceAllString(query, "?"), nil
+}
@@ -0,0 +1,687 @@
+package mdl
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/binary"
+	"encoding/json"
+	"fmt"
+	"hash/crc32"
+	"io"
+	"math"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+)
+
+// FileEventStoreSync is when an ‘mdl.FileEventStore’ calls fsync on its segment files.
+type FileEventStoreSync int
+
+const (
+	// FileEventStoreSyncAlways means to fsync after every mdl.FileEventStore.Append(); so an event is on disk
+	// before Append returns. This is the default.
+	FileEventStoreSyncAlways FileEventStoreSync = iota
+
+	// FileEventStoreSyncSegment means to only fsync a segment file when it is rolled over, and on mdl.FileEventStore.Close().
+	// Events appended since then can be lost if the computer (rather than just the program) crashes.
+	FileEventStoreSyncSegment
+
+	// FileEventStoreSyncNever means to never fsync; and to leave it to the operating system to write to disk whenever it wants to.
+	FileEventStoreSyncNever
+)
+
+// DefaultFileEventStoreMaxSegmentSize is the size (in bytes) a segment file of an ‘mdl.FileEventStore’ gets to
+// before a new segment file is started, if mdl.FileEventStore.MaxSegmentSize is not set.
+const DefaultFileEventStoreMaxSegmentSize = 64 * 1024 * 1024
+
+const (
+	fileEventStoreHeaderSize = 8
+	fileEventStoreSegmentExt = ".segment"
+)
+
+var fileEventStoreCRCTable *crc32.Table = crc32.MakeTable(crc32.Castagnoli)
+
+// FileEventStore is an ‘mdl.EventStore’ that keeps its events in append-only segment files in a directory.
+// It does not need a database server.
+//
+// Each call to Append writes one record to the end of the current segment file. A record is:
+//
+// • a 4 byte (big-endian) length of the payload,
+//
+// • a 4 byte (big-endian) CRC-32 (Castagnoli) checksum of the payload, and
+//
+// • the payload, which is the JSON array of the events that were appended.
+//
+// Since all the events from one call to Append are in the same record, they are either all there, or (after a crash) none of them are.
+//
+// Once the current segment file is MaxSegmentSize (or more) bytes, a new segment file is started.
+// Segment files are named after the Position of their first event; for example, “00000000000000000001.segment”.
+//
+// The index of the streams is not kept on disk. Instead it is rebuilt by reading all the segment files the first time the
+// ‘mdl.FileEventStore’ is used. If the last record of the last segment file is incomplete (or fails its checksum)
+// because the program crashed in the middle of writing it, it is truncated away. So is a tail of zeros at the end of the last
+// segment file (which some file systems leave after a crash). Any other damage to a segment file is returned as an error.
+//
+// Only one ‘mdl.FileEventStore’ (in only one program) should use a directory at a time.
+//
+// Example
+//
+//	var store = mdl.FileEventStore{
+//		Dir: "/var/lib/myapp/events",
+//	}
+//	defer store.Close()
+type FileEventStore struct {
+
+	// Dir is the directory the segment files are kept in. It must already exist.
+	Dir string
+
+	// MaxSegmentSize is the size (in bytes) a segment file gets to before a new one is started.
+	// If it is zero (or less), then DefaultFileEventStoreMaxSegmentSize is used.
+	MaxSegmentSize int64
+
+	// Sync is when segment files are fsync'ed.
+	Sync FileEventStoreSync
+
+	mutex    sync.RWMutex
+	loaded   bool
+	segments []*fileEventStoreSegment
+	events   []fileEventStoreEntry
+	streams  map[string][]uint64
+
+	notifier eventStoreNotifier
+}
+
+type fileEventStoreSegment struct {
+	file  *os.File
+	first uint64
+	size  int64
+}
+
+// fileEventStoreEntry is where an event is, in the segment files.
+type fileEventStoreEntry struct {
+	segment *fileEventStoreSegment
+	offset  int64
+	length  uint32
+	index   int
+}
+
+// Append makes ‘mdl.FileEventStore’ fit the ‘mdl.EventStore’ interface.
+func (receiver *FileEventStore) Append(streamID string, expectedVersion Uint64, events ...*Event) (uint64, error) {
+	if nil == receiver {
+		return 0, errNilReceiver
+	}
+
+	if err := eventStoreCheckAppend(streamID, events); nil != err {
+		return 0, err
+	}
+
+	// The events are copied before taking the lock; since copying them locks each of their ‘mdl.KeyValues’.
+	var appended []*Event = make([]*Event, len(events))
+	for i, event := range events {
+		var copied Event
+		if err := event.CopyTo(&copied); nil != err {
+			return 0, err
+		}
+		appended[i] = &copied
+	}
+
+	receiver.mutex.Lock()
+	defer receiver.mutex.Unlock()
+
+	if err := receiver.load(); nil != err {
+		return 0, err
+	}
+
+	version := uint64(len(receiver.streams[streamID]))
+	if err := eventStoreCheckVersion(streamID, expectedVersion, version); nil != err {
+		return version, err
+	}
+
+	if len(appended) <= 0 {
+		return version, nil
+	}
+
+	position := uint64(len(receiver.events))
+	for i, event := range appended {
+		event.StreamID = SomeString(streamID)
+		event.Version  = SomeUint64(version + uint64(i) + 1)
+		event.Position = SomeUint64(position + uint64(i) + 1)
+	}
+
+	payload, err := json.Marshal(appended)
+	if nil != err {
+		return version, err
+	}
+	if math.MaxUint32 < uint64(len(payload)) {
+		return version, errRecordTooLarge
+	}
+
+	segment, err := receiver.currentSegment()
+	if nil != err {
+		return version, err
+	}
+
+	offset := segment.size
+	if err := fileEventStoreWriteRecord(segment.file, offset, payload); nil != err {
+		// So that a later Append does not write after a partial record.
+		segment.file.Truncate(offset)
+		return version, err
+	}
+	if FileEventStoreSyncAlways == receiver.Sync {
+		if err := segment.file.Sync(); nil != err {
+			segment.file.Truncate(offset)
+			return version, err
+		}
+	}
+	segment.size += fileEventStoreHeaderSize + int64(len(payload))
+
+	for i, event := range appended {
+		receiver.events = append(receiver.events, fileEventStoreEntry{
+			segment:segment,
+			offset:offset,
+			length:uint32(len(payload)),
+			index:i,
+		})
+		receiver.streams[streamID] = append(receiver.streams[streamID], event.Position.ElseUnwrap(0))
+
+		events[i].StreamID = event.StreamID
+		events[i].Version  = event.Version
+		events[i].Position = event.Position
+	}
+
+	receiver.notifier.notify()
+
+	return version + uint64(len(appended)), nil
+}
+
+// Close closes the segment files.
+//
+// The ‘mdl.FileEventStore’ can still be used after it is closed; in which case its index is rebuilt from the segment files again.
+func (receiver *FileEventStore) Close() error {
+	if nil == receiver {
+		return errNilReceiver
+	}
+
+	receiver.mutex.Lock()
+	defer receiver.mutex.Unlock()
+
+	var err error
+
+	if last := len(receiver.segments) - 1; 0 <= last && FileEventStoreSyncNever != receiver.Sync {
+		err = receiver.segments[last].file.Sync()
+	}
+
+	for _, segment := range receiver.segments {
+		if closeErr := segment.file.Close(); nil == err {
+			err = closeErr
+		}
+	}
+
+	receiver.loaded = false
+	receiver.segments = nil
+	receiver.events = nil
+	receiver.streams = nil
+
+	return err
+}
+
+// Notify makes ‘mdl.FileEventStore’ fit the ‘mdl.EventStoreNotifier’ interface.
+func (receiver *FileEventStore) Notify() <-chan struct{} {
+	if nil == receiver {
+		return nil
+	}
+
+	return receiver.notifier.Notify()
+}
+
+// ReadAll makes ‘mdl.FileEventStore’ fit the ‘mdl.EventStore’ interface.
+func (receiver *FileEventStore) ReadAll(fromPosition uint64, limit int) ([]*Event, error) {
+	if nil == receiver {
+		return nil, errNilReceiver
+	}
+
+	if err := receiver.rlock(); nil != err {
+		return nil, err
+	}
+
+	var found []fileEventStoreEntry
+	func() {
+		defer receiver.mutex.RUnlock()
+
+		if 0 == fromPosition {
+			fromPosition = 1
+		}
+		if fromPosition <= uint64(len(receiver.events)) {
+			found = receiver.events[fromPosition-1:]
+		}
+	}()
+
+	return fileEventStoreRead(found[:eventStoreLimit(len(found), limit)])
+}
+
+// ReadStreamBackward makes ‘mdl.FileEventStore’ fit the ‘mdl.EventStore’ interface.
+func (receiver *FileEventStore) ReadStreamBackward(streamID string, fromVersion uint64, limit int) ([]*Event, error) {
+	if nil == receiver {
+		return nil, errNilReceiver
+	}
+
+	if err := receiver.rlock(); nil != err {
+		return nil, err
+	}
+
+	var found []fileEventStoreEntry
+	func() {
+		defer receiver.mutex.RUnlock()
+
+		stream := receiver.streams[streamID]
+		if fromVersion < uint64(len(stream)) {
+			stream = stream[:fromVersion]
+		}
+
+		found = make([]fileEventStoreEntry, eventStoreLimit(len(stream), limit))
+		for i := range found {
+			found[i] = receiver.events[stream[len(stream)-1-i]-1]
+		}
+	}()
+
+	return fileEventStoreRead(found)
+}
+
+// ReadStreamForward makes ‘mdl.FileEventStore’ fit the ‘mdl.EventStore’ interface.
+func (receiver *FileEventStore) ReadStreamForward(streamID string, fromVersion uint64, limit int) ([]*Event, error) {
+	if nil == receiver {
+		return nil, errNilReceiver
+	}
+
+	if err := receiver.rlock(); nil != err {
+		return nil, err
+	}
+
+	var found []fileEventStoreEntry
+	func() {
+		defer receiver.mutex.RUnlock()
+
+		stream := receiver.streams[streamID]
+		if 0 == fromVersion {
+			fromVersion = 1
+		}
+		if uint64(len(stream)) < fromVersion {
+			return
+		}
+		stream = stream[fromVersion-1:]
+
+		found = make([]fileEventStoreEntry, eventStoreLimit(len(stream), limit))
+		for i := range found {
+			found[i] = receiver.events[stream[i]-1]
+		}
+	}()
+
+	return fileEventStoreRead(found)
+}
+
+// currentSegment returns the segment file to append to; starting a new one if there are none, or if the last one is full.
+//
+// The caller must hold the write lock.
+func (receiver *FileEventStore) currentSegment() (*fileEventStoreSegment, error) {
+	maxSegmentSize := receiver.MaxSegmentSize
+	if maxSegmentSize <= 0 {
+		maxSegmentSize = DefaultFileEventStoreMaxSegmentSize
+	}
+
+	last := len(receiver.segments) - 1
+	if 0 <= last && receiver.segments[last].size < maxSegmentSize {
+		return receiver.segments[last], nil
+	}
+
+	if 0 <= last && FileEventStoreSyncSegment == receiver.Sync {
+		if err := receiver.segments[last].file.Sync(); nil != err {
+			return nil, err
+		}
+	}
+
+	first := uint64(len(receiver.events)) + 1
+
+	file, err := os.OpenFile(receiver.segmentPath(first), os.O_RDWR|os.O_CREATE|os.O_EXCL, 0644)
+	if nil != err {
+		return nil, err
+	}
+
+	if FileEventStoreSyncNever != receiver.Sync {
+		if err := fileEventStoreSyncDir(receiver.Dir); nil != err {
+			file.Close()
+			os.Remove(file.Name())
+			return nil, err
+		}
+	}
+
+	segment := &fileEventStoreSegment{
+		file:file,
+		first:first,
+	}
+	receiver.segments = append(receiver.segments, segment)
+
+	return segment, nil
+}
+
+// load rebuilds the index from the segment files, if that has not been done yet.
+//
+// The caller must hold the write lock.
+func (receiver *FileEventStore) load() error {
+	if receiver.loaded {
+		return nil
+	}
+
+	paths, err := filepath.Glob(filepath.Join(receiver.Dir, "*"+fileEventStoreSegmentExt))
+	if nil != err {
+		return err
+	}
+	sort.Strings(paths)
+
+	var segments []*fileEventStoreSegment
+	var events []fileEventStoreEntry
+	var streams map[string][]uint64 = map[string][]uint64{}
+
+	closeAll := func() {
+		for _, segment := range segments {
+			segment.file.Close()
+		}
+	}
+
+	for i, path := range paths {
+		last := len(paths)-1 == i
+
+		segment, err := fileEventStoreLoadSegment(path, last, &events, streams)
+		if nil != err {
+			closeAll()
+			return err
+		}
+
+		segments = append(segments, segment)
+	}
+
+	receiver.loaded = true
+	receiver.segments = segments
+	receiver.events = events
+	receiver.streams = streams
+
+	return nil
+}
+
+// rlock takes the read lock, after making sure the index has been rebuilt from the segment files.
+// If it returns nil, the caller must release the read lock.
+func (receiver *FileEventStore) rlock() error {
+	for {
+		receiver.mutex.RLock()
+		if receiver.loaded {
+			return nil
+		}
+		receiver.mutex.RUnlock()
+
+		err := func() error {
+			receiver.mutex.Lock()
+			defer receiver.mutex.Unlock()
+
+			return receiver.load()
+		}()
+		if nil != err {
+			return err
+		}
+	}
+}
+
+func (receiver *FileEventStore) segmentPath(first uint64) string {
+	return filepath.Join(receiver.Dir, fmt.Sprintf("%020d%s", first, fileEventStoreSegmentExt))
+}
+
+// fileEventStoreLoadSegment reads the segment file at ‘path’, and adds its events to ‘events’ and ‘streams’.
+//
+// If ‘last’ is true, a torn record at the end of the segment file is truncated away, and the segment file is opened for writing.
+func fileEventStoreLoadSegment(path string, last bool, events *[]fileEventStoreEntry, streams map[string][]uint64) (*fileEventStoreSegment, error) {
+	first, err := strconv.ParseUint(strings.TrimSuffix(filepath.Base(path), fileEventStoreSegmentExt), 10, 64)
+	if nil != err {
+		return nil, fmt.Errorf("mdl: unexpected event store segment file name %q", path)
+	}
+	if expected := uint64(len(*events)) + 1; expected != first {
+		return nil, fmt.Errorf("mdl: event store segment file %q was expected to start at position %d", path, expected)
+	}
+
+	var flag int = os.O_RDONLY
+	if last {
+		flag = os.O_RDWR
+	}
+
+	file, err := os.OpenFile(path, flag, 0)
+	if nil != err {
+		return nil, err
+	}
+
+	segment, err := func() (*fileEventStoreSegment, error) {
+		info, err := file.Stat()
+		if nil != err {
+			return nil, err
+		}
+		fileSize := info.Size()
+
+		var reader *bufio.Reader = bufio.NewReader(file)
+		var offset int64
+
+		for offset < fileSize {
+			payload, err := fileEventStoreReadRecord(reader, fileSize-offset)
+			if errTornRecord == err && last {
+				if err := file.Truncate(offset); nil != err {
+					return nil, err
+				}
+				break
+			}
+			if nil != err {
+				return nil, fmt.Errorf("mdl: corrupt event store segment file %q at offset %d: %s", path, offset, err)
+			}
+
+			var batch []*Event
+			if err := json.Unmarshal(payload, &batch); nil != err {
+				return nil, fmt.Errorf("mdl: corrupt event store segment file %q at offset %d: %s", path, offset, err)
+			}
+
+			for i, event := range batch {
+				if err := fileEventStoreCheckLoaded(event, uint64(len(*events)), streams); nil != err {
+					return nil, fmt.Errorf("mdl: corrupt event store segment file %q at offset %d: %s", path, offset, err)
+				}
+
+				streamID := event.StreamID.ElseUnwrap("")
+
+				*events = append(*events, fileEventStoreEntry{
+					offset:offset,
+					length:uint32(len(payload)),
+					index:i,
+				})
+				streams[streamID] = append(streams[streamID], uint64(len(*events)))
+			}
+
+			offset += fileEventStoreHeaderSize + int64(len(payload))
+		}
+
+		return &fileEventStoreSegment{
+			file:file,
+			first:first,
+			size:offset,
+		}, nil
+	}()
+	if nil != err {
+		file.Close()
+		return nil, err
+	}
+
+	for i := int(first) - 1; i < len(*events); i++ {
+		(*events)[i].segment = segment
+	}
+
+	return segment, nil
+}
+
+// fileEventStoreCheckLoaded checks that an event read from a segment file is where it should be.
+func fileEventStoreCheckLoaded(event *Event, count uint64, streams map[string][]uint64) error {
+	if nil == event {
+		return errNilEvent
+	}
+
+	streamID, something := event.StreamID.Unwrap()
+	if !something || "" == streamID {
+		return errEmptyStreamID
+	}
+
+	if expected, actual := SomeUint64(count+1), event.Position; expected != actual {
+		return fmt.Errorf("expected position %#v, but got %#v", expected, actual)
+	}
+	if expected, actual := SomeUint64(uint64(len(streams[streamID]))+1), event.Version; expected != actual {
+		return fmt.Errorf("expected version %#v of stream %q, but got %#v", expected, streamID, actual)
+	}
+
+	return nil
+}
+
+// fileEventStoreRead reads the events at ‘entries’ from the segment files.
+func fileEventStoreRead(entries []fileEventStoreEntry) ([]*Event, error) {
+	var result []*Event = make([]*Event, len(entries))
+
+	// Events appended together are in the same record; so the last record read is kept, rather than reading it again for each of them.
+	var record fileEventStoreEntry
+	var batch []*Event
+
+	for i, entry := range entries {
+		if nil == batch || record.segment != entry.segment || record.offset != entry.offset {
+			var buffer []byte = make([]byte, fileEventStoreHeaderSize+int(entry.length))
+			if _, err := entry.segment.file.ReadAt(buffer, entry.offset); nil != err {
+				return nil, err
+			}
+
+			payload, err := fileEventStoreReadRecord(bytes.NewReader(buffer), int64(len(buffer)))
+			if nil != err {
+				return nil, err
+			}
+
+			batch = nil
+			if err := json.Unmarshal(payload, &batch); nil != err {
+				return nil, err
+			}
+			record = entry
+		}
+
+		if len(batch) <= entry.index {
+			return nil, errInternalError
+		}
+
+		// Each record is decoded once, but its events can be returned more than once (e.g., going backwards); so each one is copied.
+		var copied Event
+		if err := batch[entry.index].CopyTo(&copied); nil != err {
+			return nil, err
+		}
+		result[i] = &copied
+	}
+
+	return result, nil
+}
+
+// fileEventStoreReadRecord reads a record (of at most ‘remaining’ bytes) from ‘reader’, and returns its payload.
+//
+// It returns errTornRecord if the record runs past ‘remaining’; if it is the last record and its checksum does not match; or if
+// it is all zeros, and so is everything after it. (A record always has at least “[]” as its payload; so an empty record is never
+// written, and what looks like one is a tail of zeros.)
+func fileEventStoreReadRecord(reader io.Reader, remaining int64) ([]byte, error) {
+	var header [fileEventStoreHeaderSize]byte
+
+	if remaining < fileEventStoreHeaderSize {
+		return nil, errTornRecord
+	}
+	if _, err := io.ReadFull(reader, header[:]); nil != err {
+		return nil, err
+	}
+
+	length := binary.BigEndian.Uint32(header[0:4])
+	checksum := binary.BigEndian.Uint32(header[4:8])
+
+	if 0 == length {
+		if 0 != checksum {
+			return nil, errEmptyRecord
+		}
+
+		zeros, err := fileEventStoreZeros(reader, remaining-fileEventStoreHeaderSize)
+		if nil != err {
+			return nil, err
+		}
+		if !zeros {
+			return nil, errEmptyRecord
+		}
+		return nil, errTornRecord
+	}
+
+	size := fileEventStoreHeaderSize + int64(length)
+	if remaining < size {
+		return nil, errTornRecord
+	}
+
+	var payload []byte = make([]byte, length)
+	if _, err := io.ReadFull(reader, payload); nil != err {
+		return nil, err
+	}
+
+	if checksum != crc32.Checksum(payload, fileEventStoreCRCTable) {
+		if remaining == size {
+			return nil, errTornRecord
+		}
+		return nil, errChecksumMismatch
+	}
+
+	return payload, nil
+}
+
+// fileEventStoreZeros returns whether the next ‘n’ bytes from ‘reader’ are all zeros.
+func fileEventStoreZeros(reader io.Reader, n int64) (bool, error) {
+	var buffer [4096]byte
+
+	for 0 < n {
+		chunk := buffer[:]
+		if n < int64(len(chunk)) {
+			chunk = chunk[:n]
+		}
+
+		if _, err := io.ReadFull(reader, chunk); nil != err {
+			return false, err
+		}
+		for _, b := range chunk {
+			if 0 != b {
+				return false, nil
+			}
+		}
+
+		n -= int64(len(chunk))
+	}
+
+	return true, nil
+}
+
+// fileEventStoreWriteRecord writes ‘payload’, as a record, to ‘file’ at ‘offset’.
+func fileEventStoreWriteRecord(file *os.File, offset int64, payload []byte) error {
+	var record []byte = make([]byte, fileEventStoreHeaderSize+len(payload))
+
+	binary.BigEndian.PutUint32(record[0:4], uint32(len(payload)))
+	binary.BigEndian.PutUint32(record[4:8], crc32.Checksum(payload, fileEventStoreCRCTable))
+	copy(record[fileEventStoreHeaderSize:], payload)
+
+	_, err := file.WriteAt(record, offset)
+
+	return err
+}
+
+// fileEventStoreSyncDir fsyncs the directory ‘dir’, so that a file just created in it is not lost.
+func fileEventStoreSyncDir(dir string) error {
+	file, err := os.Open(dir)
+	if nil != err {
+		return err
+	}
+	defer file.Close()
+
+	return file.Sync()
+}
@@ -0,0 +1,312 @@
+package mdl_test
+
+import (
+	"github.com/reiver/go-mdl"
+	"github.com/reiver/go-mdl/mdltest"
+
+	"bytes"
+	"encoding/binary"
+	"hash/crc32"
+	"io/ioutil"
+	"math"
+	"os"
+	"path/filepath"
+
+	"testing"
+)
+
+var _ mdl.EventStore = &mdl.FileEventStore{}
+
+func TestFileEventStore(t *testing.T) {
+
+	tests := []struct{
+		Name           string
+		MaxSegmentSize int64
+		Sync           mdl.FileEventStoreSync
+	}{
+		{"Default", 0, mdl.FileEventStoreSyncAlways},
+		{"SmallSegments", 256, mdl.FileEventStoreSyncSegment},
+		{"NoSync", 1, mdl.FileEventStoreSyncNever},
+	}
+
+	for _, test := range tests {
+		test := test
+
+		t.Run(test.Name, func(t *testing.T) {
+			var dirs []string
+			var stores []*mdl.FileEventStore
+			defer func() {
+				for _, store := range stores {
+					store.Close()
+				}
+				for _, dir := range dirs {
+					os.RemoveAll(dir)
+				}
+			}()
+
+			mdltest.TestEventStore(t, func(t *testing.T) mdl.EventStore {
+				dir, err := ioutil.TempDir("", "mdl-events-")
+				if nil != err {
+					t.Fatalf("Did not expect an error, but actually got one: (%T) %q", err, err)
+				}
+				dirs = append(dirs, dir)
+
+				store := &mdl.FileEventStore{
+					Dir: dir,
+					MaxSegmentSize: test.MaxSegmentSize,
+					Sync: test.Sync,
+				}
+				stores = append(stores, store)
+
+				return store
+			})
+		})
+	}
+}
+
+func fileEventStoreFixture(t *testing.T, maxSegmentSize int64) (string, *mdl.FileEventStore) {
+	dir, err := ioutil.TempDir("", "mdl-events-")
+	if nil != err {
+		t.Fatalf("Did not expect an error, but actually got one: (%T) %q", err, err)
+	}
+
+	store := &mdl.FileEventStore{
+		Dir: dir,
+		MaxSegmentSize: maxSegmentSize,
+	}
+
+	for _, streamID := range []string{"a", "b", "a", "a", "b"} {
+		if _, err := store.Append(streamID, mdl.NoUint64(), mdltest.NewEvent("E", "k", "v")); nil != err {
+			os.RemoveAll(dir)
+			t.Fatalf("Did not expect an error from .Append(), but actually got one: (%T) %q", err, err)
+		}
+	}
+	if _, err := store.Append("c", mdl.NoUint64(), mdltest.NewEvent("E"), mdltest.NewEvent("E")); nil != err {
+		os.RemoveAll(dir)
+		t.Fatalf("Did not expect an error from .Append(), but actually got one: (%T) %q", err, err)
+	}
+
+	return dir, store
+}
+
+func fileEventStoreSegments(t *testing.T, dir string) []string {
+	paths, err := filepath.Glob(filepath.Join(dir, "*.segment"))
+	if nil != err {
+		t.Fatalf("Did not expect an error, but actually got one: (%T) %q", err, err)
+	}
+
+	return paths
+}
+
+func TestFileEventStoreReopen(t *testing.T) {
+
+	dir, store := fileEventStoreFixture(t, 1)
+	defer os.RemoveAll(dir)
+	defer store.Close()
+
+	// Each record is bigger than 1 byte; so every Append should have started a new segment file.
+	if expected, actual := 6, len(fileEventStoreSegments(t, dir)); expected != actual {
+		t.Fatalf("Expected %d segment files, but actually got %d.", expected, actual)
+	}
+
+	before, err := store.ReadAll(0, 0)
+	if nil != err {
+		t.Fatalf("Did not expect an error from .ReadAll(), but actually got one: (%T) %q", err, err)
+	}
+
+	if err := store.Close(); nil != err {
+		t.Fatalf("Did not expect an error from .Close(), but actually got one: (%T) %q", err, err)
+	}
+
+	reopened := &mdl.FileEventStore{
+		Dir: dir,
+	}
+	defer reopened.Close()
+
+	for _, s := range []mdl.EventStore{store, reopened} {
+
+		after, err := s.ReadAll(0, 0)
+		if nil != err {
+			t.Fatalf("Did not expect an error from .ReadAll(), but actually got one: (%T) %q", err, err)
+		}
+		if expected, actual := len(before), len(after); expected != actual {
+			t.Fatalf("Expected %d events, but actually got %d.", expected, actual)
+		}
+		for i := range before {
+			if err := mdltest.SameEvent(before[i], after[i]); nil != err {
+				t.Errorf("For event #%d, %s.", i, err)
+			}
+		}
+
+		if _, err := s.Append("a", mdl.SomeUint64(2), mdltest.NewEvent("E")); nil == err {
+			t.Errorf("Expected an error from .Append(), since the rebuilt index has stream “a” at version 3, but did not actually get one.")
+		}
+	}
+
+	version, err := reopened.Append("a", mdl.SomeUint64(3), mdltest.NewEvent("E"))
+	if nil != err {
+		t.Fatalf("Did not expect an error from .Append(), but actually got one: (%T) %q", err, err)
+	}
+	if expected, actual := uint64(4), version; expected != actual {
+		t.Fatalf("Expected version %d, but actually got %d.", expected, actual)
+	}
+
+	events, err := reopened.ReadStreamBackward("a", math.MaxUint64, 1)
+	if nil != err {
+		t.Fatalf("Did not expect an error from .ReadStreamBackward(), but actually got one: (%T) %q", err, err)
+	}
+	if expected, actual := mdl.SomeUint64(8), events[0].Position; expected != actual {
+		t.Fatalf("Expected position %#v, but actually got %#v.", expected, actual)
+	}
+}
+
+func TestFileEventStoreTornTail(t *testing.T) {
+
+	tests := []struct{
+		Name string
+		Tear func(data []byte) []byte
+	}{
+		{"PartialHeader", func(data []byte) []byte { return append(data, 0, 0, 1) }},
+		{"PartialPayload", func(data []byte) []byte { return append(data, 0, 0, 0, 9, 1, 2, 3, 4, '[', '{') }},
+		{"BadChecksum", func(data []byte) []byte { data[len(data)-2] ^= 0xFF; return data }},
+		{"ZeroFilledTail", func(data []byte) []byte { return append(data, make([]byte, 4096)...) }},
+	}
+
+	for _, test := range tests {
+		test := test
+
+		t.Run(test.Name, func(t *testing.T) {
+			dir, store := fileEventStoreFixture(t, 0)
+			defer os.RemoveAll(dir)
+
+			if err := store.Close(); nil != err {
+				t.Fatalf("Did not expect an error from .Close(), but actually got one: (%T) %q", err, err)
+			}
+
+			paths := fileEventStoreSegments(t, dir)
+			if expected, actual := 1, len(paths); expected != actual {
+				t.Fatalf("Expected %d segment files, but actually got %d.", expected, actual)
+			}
+
+			data, err := ioutil.ReadFile(paths[0])
+			if nil != err {
+				t.Fatalf("Did not expect an error, but actually got one: (%T) %q", err, err)
+			}
+			if err := ioutil.WriteFile(paths[0], test.Tear(data), 0644); nil != err {
+				t.Fatalf("Did not expect an error, but actually got one: (%T) %q", err, err)
+			}
+
+			// Tearing the checksum loses the last record (the 2 events in stream “c”); otherwise nothing is lost.
+			var expectedCount int = 7
+			if "BadChecksum" == test.Name {
+				expectedCount = 5
+			}
+
+			events, err := store.ReadAll(0, 0)
+			if nil != err {
+				t.Fatalf("Did not expect an error from .ReadAll(), but actually got one: (%T) %q", err, err)
+			}
+			if expected, actual := expectedCount, len(events); expected != actual {
+				t.Fatalf("Expected %d events, but actually got %d.", expected, actual)
+			}
+
+			// The torn record should have been truncated away, so appending carries on where the good records end.
+			if _, err := store.Append("d", mdl.SomeUint64(0), mdltest.NewEvent("E")); nil != err {
+				t.Fatalf("Did not expect an error from .Append(), but actually got one: (%T) %q", err, err)
+			}
+			if err := store.Close(); nil != err {
+				t.Fatalf("Did not expect an error from .Close(), but actually got one: (%T) %q", err, err)
+			}
+
+			events, err = store.ReadAll(0, 0)
+			if nil != err {
+				t.Fatalf("Did not expect an error from .ReadAll(), but actually got one: (%T) %q", err, err)
+			}
+			if expected, actual := expectedCount+1, len(events); expected != actual {
+				t.Fatalf("Expected %d events, but actually got %d.", expected, actual)
+			}
+			if expected, actual := mdl.SomeString("d"), events[expectedCount].StreamID; expected != actual {
+				t.Fatalf("Expected stream %#v, but actually got %#v.", expected, actual)
+			}
+			store.Close()
+		})
+	}
+}
+
+// fileEventStoreRecords returns the offset of each record in the segment file ‘data’.
+func fileEventStoreRecords(data []byte) []int {
+	var offsets []int
+	for offset := 0; offset+8 <= len(data); offset += 8 + int(binary.BigEndian.Uint32(data[offset:offset+4])) {
+		offsets = append(offsets, offset)
+	}
+
+	return offsets
+}
+
+func TestFileEventStoreCorrupt(t *testing.T) {
+
+	crcTable := crc32.MakeTable(crc32.Castagnoli)
+
+	// undecodable makes the payload of the record at ‘offset’ not JSON, but with a checksum that matches.
+	undecodable := func(data []byte, offset int) {
+		length := int(binary.BigEndian.Uint32(data[offset:offset+4]))
+		payload := data[offset+8:offset+8+length]
+		payload[0] = '{'
+		binary.BigEndian.PutUint32(data[offset+4:offset+8], crc32.Checksum(payload, crcTable))
+	}
+
+	tests := []struct{
+		Name   string
+		Damage func(data []byte, records []int)
+	}{
+		{"BadChecksum",           func(data []byte, records []int) { data[10] ^= 0xFF }},
+		{"UndecodableRecord",     func(data []byte, records []int) { undecodable(data, records[1]) }},
+		{"UndecodableLastRecord", func(data []byte, records []int) { undecodable(data, records[len(records)-1]) }},
+		{"ZeroLength",            func(data []byte, records []int) { copy(data[records[1]:], make([]byte, 8)) }},
+		{"ShortLength",           func(data []byte, records []int) { data[records[1]+3]-- }},
+		{"LongLength",            func(data []byte, records []int) { data[records[1]+3]++ }},
+	}
+
+	for _, test := range tests {
+		test := test
+
+		t.Run(test.Name, func(t *testing.T) {
+			dir, store := fileEventStoreFixture(t, 0)
+			defer os.RemoveAll(dir)
+
+			if err := store.Close(); nil != err {
+				t.Fatalf("Did not expect an error from .Close(), but actually got one: (%T) %q", err, err)
+			}
+
+			path := fileEventStoreSegments(t, dir)[0]
+
+			data, err := ioutil.ReadFile(path)
+			if nil != err {
+				t.Fatalf("Did not expect an error, but actually got one: (%T) %q", err, err)
+			}
+
+			// The damage is not to the end of the last segment file (or is a record that was written whole); so, unlike a torn
+			// final record, it should not be truncated away.
+			test.Damage(data, fileEventStoreRecords(data))
+			if err := ioutil.WriteFile(path, data, 0644); nil != err {
+				t.Fatalf("Did not expect an error, but actually got one: (%T) %q", err, err)
+			}
+
+			if _, err := store.ReadAll(0, 0); nil == err {
+				t.Fatalf("Expected an error from .ReadAll(), but did not actually get one.")
+			}
+			if _, err := store.Append("a", mdl.NoUint64(), mdltest.NewEvent("E")); nil == err {
+				t.Fatalf("Expected an error from .Append(), but did not actually get one.")
+			}
+			store.Close()
+
+			after, err := ioutil.ReadFile(path)
+			if nil != err {
+				t.Fatalf("Did not expect an error, but actually got one: (%T) %q", err, err)
+			}
+			if !bytes.Equal(data, after) {
+				t.Fatalf("Expected the segment file to be left as it was (%d bytes), but it was changed (to %d bytes).", len(data), len(after))
+			}
+		})
+	}
+}
@@ -0,0 +1,336 @@
+package mdl
+
+import (
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"time"
+)
+
+const (
+	defaultFileIdempotencyLease          time.Duration = 5 * time.Minute
+	fileIdempotencyStoreLockPollInterval time.Duration = 5 * time.Millisecond
+	fileIdempotencyStoreStaleLock        time.Duration = 10 * time.Second
+)
+
+// FileIdempotencyStore is an ‘mdl.IdempotencyStore’ that keeps its records as files in a directory.
+//
+// Each record is kept in its own file, which is only ever changed while holding a lock file for it (created
+// with O_CREATE|O_EXCL); so multiple programs can share the same directory.
+//
+// A record that is still in progress only lasts for Lease (rather than the whole TTL), so that an Idempotent ID
+// is not held up for long by a program that crashed while handling it. Once completed, the record lasts for the
+// whole TTL (counting from when it was reserved).
+//
+// Complete and Release only act on a record that was reserved through the same ‘mdl.FileIdempotencyStore’.
+//
+// Example
+//
+//	var store = mdl.FileIdempotencyStore{
+//		Dir: "/var/lib/myapp/idempotency",
+//	}
+type FileIdempotencyStore struct {
+
+	// Dir is the directory the record files are kept in. It must already exist.
+	Dir string
+
+	// Lease is how long a record that is still in progress lasts; i.e., about how long a program has to handle
+	// an HTTP request before the Idempotent ID can be reserved again. It should be longer than handling an HTTP
+	// request ever takes.
+	//
+	// If zero, then 5 minutes is used. (If it is longer than the TTL, then the TTL is used.)
+	Lease time.Duration
+
+	mutex  sync.Mutex
+	owners map[string]string
+}
+
+// fileIdempotencyStoreRecord is what is kept in each record file.
+type fileIdempotencyStoreRecord struct {
+	IdempotencyRecord
+
+	// Owner identifies the reservation, so that only whoever made it completes or releases it.
+	Owner string
+
+	// Reserved and TTL are when the record was reserved, and for how long; so that Complete can set when it expires.
+	Reserved time.Time
+	TTL      time.Duration
+}
+
+// Purge removes all the record files that have expired as of ‘now’.
+func (receiver *FileIdempotencyStore) Purge(now time.Time) error {
+	if nil == receiver {
+		return errNilReceiver
+	}
+
+	matches, err := filepath.Glob(filepath.Join(receiver.Dir, "*.json"))
+	if nil != err {
+		return err
+	}
+
+	for _, path := range matches {
+		if err := receiver.purge(path, now); nil != err {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// Reserve makes ‘mdl.FileIdempotencyStore’ fit the ‘mdl.IdempotencyStore’ interface.
+func (receiver *FileIdempotencyStore) Reserve(id string, fingerprint string, now time.Time, ttl time.Duration) (IdempotencyRecord, bool, error) {
+	if nil == receiver {
+		return IdempotencyRecord{}, false, errNilReceiver
+	}
+
+	receiver.mutex.Lock()
+	defer receiver.mutex.Unlock()
+
+	path := receiver.path(id)
+
+	unlock, err := fileIdempotencyStoreLock(path)
+	if nil != err {
+		return IdempotencyRecord{}, false, err
+	}
+	defer unlock()
+
+	record, found, err := fileIdempotencyStoreRead(path)
+	if nil != err {
+		return IdempotencyRecord{}, false, err
+	}
+	if found && now.Before(record.Expires) {
+		return record.IdempotencyRecord, true, nil
+	}
+
+	owner, err := fileIdempotencyStoreOwner()
+	if nil != err {
+		return IdempotencyRecord{}, false, err
+	}
+
+	lease := receiver.lease()
+	if ttl < lease {
+		lease = ttl
+	}
+
+	// Whatever was there has expired; so it is replaced.
+	if err := receiver.write(path, fileIdempotencyStoreRecord{
+		IdempotencyRecord: IdempotencyRecord{
+			Fingerprint: fingerprint,
+			Expires:     now.Add(lease),
+		},
+		Owner:    owner,
+		Reserved: now,
+		TTL:      ttl,
+	}); nil != err {
+		return IdempotencyRecord{}, false, err
+	}
+
+	if nil == receiver.owners {
+		receiver.owners = map[string]string{}
+	}
+	receiver.owners[path] = owner
+
+	return IdempotencyRecord{}, false, nil
+}
+
+// Complete makes ‘mdl.FileIdempotencyStore’ fit the ‘mdl.IdempotencyStore’ interface.
+func (receiver *FileIdempotencyStore) Complete(id string, response IdempotencyResponse) error {
+	if nil == receiver {
+		return errNilReceiver
+	}
+
+	receiver.mutex.Lock()
+	defer receiver.mutex.Unlock()
+
+	path := receiver.path(id)
+
+	unlock, err := fileIdempotencyStoreLock(path)
+	if nil != err {
+		return err
+	}
+	defer unlock()
+
+	record, found, err := fileIdempotencyStoreRead(path)
+	if nil != err {
+		return err
+	}
+	if owner, ok := receiver.owners[path]; !found || !ok || owner != record.Owner {
+		return errIdempotentIDNotReserved
+	}
+
+	record.Completed = true
+	record.Response = response
+	record.Expires = record.Reserved.Add(record.TTL)
+
+	if err := receiver.write(path, record); nil != err {
+		return err
+	}
+
+	delete(receiver.owners, path)
+
+	return nil
+}
+
+// Release makes ‘mdl.FileIdempotencyStore’ fit the ‘mdl.IdempotencyStore’ interface.
+//
+// Only a record that is still in progress, and that was reserved through this ‘mdl.FileIdempotencyStore’, is removed.
+func (receiver *FileIdempotencyStore) Release(id string) error {
+	if nil == receiver {
+		return errNilReceiver
+	}
+
+	receiver.mutex.Lock()
+	defer receiver.mutex.Unlock()
+
+	path := receiver.path(id)
+
+	owner, ok := receiver.owners[path]
+	if !ok {
+		return nil
+	}
+	delete(receiver.owners, path)
+
+	unlock, err := fileIdempotencyStoreLock(path)
+	if nil != err {
+		return err
+	}
+	defer unlock()
+
+	record, found, err := fileIdempotencyStoreRead(path)
+	if nil != err {
+		return err
+	}
+	if !found || record.Completed || owner != record.Owner {
+		return nil
+	}
+
+	if err := os.Remove(path); nil != err && !os.IsNotExist(err) {
+		return err
+	}
+
+	return nil
+}
+
+func (receiver *FileIdempotencyStore) lease() time.Duration {
+	if 0 >= receiver.Lease {
+		return defaultFileIdempotencyLease
+	}
+
+	return receiver.Lease
+}
+
+// The Idempotent ID is hashed to get the file name, since the Idempotent ID came from the HTTP client, and could
+// contain anything (such as "../").
+func (receiver *FileIdempotencyStore) path(id string) string {
+	sum := sha256.Sum256([]byte(id))
+
+	return filepath.Join(receiver.Dir, hex.EncodeToString(sum[:])+".json")
+}
+
+func (receiver *FileIdempotencyStore) purge(path string, now time.Time) error {
+	unlock, err := fileIdempotencyStoreLock(path)
+	if nil != err {
+		return err
+	}
+	defer unlock()
+
+	record, found, err := fileIdempotencyStoreRead(path)
+	if nil != err {
+		return err
+	}
+	if !found || now.Before(record.Expires) {
+		return nil
+	}
+
+	if err := os.Remove(path); nil != err && !os.IsNotExist(err) {
+		return err
+	}
+
+	return nil
+}
+
+// write atomically replaces the record file at ‘path’. The lock for ‘path’ must be held.
+func (receiver *FileIdempotencyStore) write(path string, record fileIdempotencyStoreRecord) error {
+	file, err := ioutil.TempFile(receiver.Dir, ".tmp-")
+	if nil != err {
+		return err
+	}
+
+	err = json.NewEncoder(file).Encode(record)
+	if closeErr := file.Close(); nil == err {
+		err = closeErr
+	}
+	if nil == err {
+		err = os.Rename(file.Name(), path)
+	}
+	if nil != err {
+		os.Remove(file.Name())
+		return err
+	}
+
+	return nil
+}
+
+// fileIdempotencyStoreLock takes the lock for the record file at ‘path’, and returns the function that gives it up.
+//
+// The lock is a file, created with O_CREATE|O_EXCL; so only one program (or goroutine) can hold it at a time.
+// A lock file older than fileIdempotencyStoreStaleLock was left behind by a program that crashed while holding it
+// (since it is only ever held for as long as it takes to read and write a small file), and is removed.
+func fileIdempotencyStoreLock(path string) (func(), error) {
+	lockPath := strings.TrimSuffix(path, ".json") + ".lock"
+
+	for {
+		file, err := os.OpenFile(lockPath, os.O_WRONLY|os.O_CREATE|os.O_EXCL, 0600)
+		if nil == err {
+			file.Close()
+			return func() {
+				os.Remove(lockPath)
+			}, nil
+		}
+		if !os.IsExist(err) {
+			return nil, err
+		}
+
+		if info, err := os.Stat(lockPath); nil == err && fileIdempotencyStoreStaleLock < time.Since(info.ModTime()) {
+			os.Remove(lockPath)
+			continue
+		}
+
+		time.Sleep(fileIdempotencyStoreLockPollInterval)
+	}
+}
+
+// fileIdempotencyStoreOwner returns a new random value for fileIdempotencyStoreRecord.Owner.
+func fileIdempotencyStoreOwner() (string, error) {
+	var buffer [16]byte
+
+	if _, err := rand.Read(buffer[:]); nil != err {
+		return "", err
+	}
+
+	return hex.EncodeToString(buffer[:]), nil
+}
+
+func fileIdempotencyStoreRead(path string) (fileIdempotencyStoreRecord, bool, error) {
+	var record fileIdempotencyStoreRecord
+
+	data, err := ioutil.ReadFile(path)
+	if os.IsNotExist(err) {
+		return record, false, nil
+	}
+	if nil != err {
+		return record, false, err
+	}
+
+	if err := json.Unmarshal(data, &record); nil != err {
+		return record, false, err
+	}
+
+	return record, true, nil
+}
@@ -0,0 +1,146 @@
+package mdl_test
+
+import (
+	"github.com/reiver/go-mdl"
+
+	"io/ioutil"
+	"net/http"
+	"os"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"testing"
+)
+
+func TestFileIdempotencyStore(t *testing.T) {
+	dir, err := ioutil.TempDir("", "mdl-idempotency-")
+	if nil != err {
+		t.Errorf("Did not expect an error, but actually got one: (%T) %q", err, err)
+		return
+	}
+	defer os.RemoveAll(dir)
+
+	var store = mdl.FileIdempotencyStore{
+		Dir: dir,
+	}
+
+	testIdempotencyStore(t, &store)
+}
+
+func TestFileIdempotencyStoreLease(t *testing.T) {
+	dir, err := ioutil.TempDir("", "mdl-idempotency-")
+	if nil != err {
+		t.Errorf("Did not expect an error, but actually got one: (%T) %q", err, err)
+		return
+	}
+	defer os.RemoveAll(dir)
+
+	var now time.Time = time.Date(2015, 5, 7, 10, 25, 9, 0, time.UTC)
+	var ttl time.Duration = 24 * time.Hour
+	var lease time.Duration = time.Minute
+
+	// 2 stores, sharing the same directory, are like 2 programs.
+	var store1 = mdl.FileIdempotencyStore{Dir:dir, Lease:lease}
+	var store2 = mdl.FileIdempotencyStore{Dir:dir, Lease:lease}
+
+	const id string = "z-2015-05-07T10:25:09Z_lease"
+
+	if _, found, err := store1.Reserve(id, "fingerprint-1", now, ttl); nil != err || found {
+		t.Errorf("Expected the first reserve to succeed, but actually got found=%t err=%v.", found, err)
+		return
+	}
+
+	// Store 2 did not reserve it; so its Release must not remove store 1's reservation.
+	if err := store2.Release(id); nil != err {
+		t.Errorf("Did not expect an error, but actually got one: (%T) %q", err, err)
+		return
+	}
+
+	if _, found, err := store2.Reserve(id, "fingerprint-1", now.Add(lease-time.Second), ttl); nil != err || !found {
+		t.Errorf("Expected the reservation to still be there within the lease, but actually got found=%t err=%v.", found, err)
+		return
+	}
+
+	// As if store 1 crashed; once the lease is up, the Idempotent ID can be reserved again.
+	if _, found, err := store2.Reserve(id, "fingerprint-1", now.Add(lease), ttl); nil != err || found {
+		t.Errorf("Expected the reservation to have expired after the lease, but actually got found=%t err=%v.", found, err)
+		return
+	}
+
+	// Store 1's reservation was replaced; so it can neither complete, nor release, store 2's.
+	if err := store1.Complete(id, mdl.IdempotencyResponse{StatusCode:http.StatusCreated}); nil == err {
+		t.Errorf("Expected an error completing a reservation that was replaced, but did not actually get one.")
+		return
+	}
+	if err := store1.Release(id); nil != err {
+		t.Errorf("Did not expect an error, but actually got one: (%T) %q", err, err)
+		return
+	}
+
+	if err := store2.Complete(id, mdl.IdempotencyResponse{StatusCode:http.StatusCreated}); nil != err {
+		t.Errorf("Did not expect an error, but actually got one: (%T) %q", err, err)
+		return
+	}
+
+	// Once completed, the record lasts for the whole TTL.
+	record, found, err := store1.Reserve(id, "fingerprint-1", now.Add(lease+ttl-time.Second), ttl)
+	if nil != err || !found {
+		t.Errorf("Expected the completed record to last for the TTL, but actually got found=%t err=%v.", found, err)
+		return
+	}
+	if !record.Completed {
+		t.Errorf("Expected the record to be completed.")
+		return
+	}
+}
+
+func TestFileIdempotencyStoreReserveConcurrent(t *testing.T) {
+	dir, err := ioutil.TempDir("", "mdl-idempotency-")
+	if nil != err {
+		t.Errorf("Did not expect an error, but actually got one: (%T) %q", err, err)
+		return
+	}
+	defer os.RemoveAll(dir)
+
+	var now time.Time = time.Date(2015, 5, 7, 10, 25, 9, 0, time.UTC)
+	var ttl time.Duration = time.Hour
+
+	const id string = "z-2015-05-07T10:25:09Z_concurrent"
+	const count = 16
+
+	for round := 0; round < 50; round++ {
+		// Each round starts with an expired record; which all the stores (like separate programs) race to replace.
+		reserved := now.Add(time.Duration(round) * ttl)
+
+		var reservations int32
+		var start = make(chan struct{})
+		var waitgroup sync.WaitGroup
+		for i := 0; i < count; i++ {
+			waitgroup.Add(1)
+
+			go func() {
+				defer waitgroup.Done()
+
+				var store = mdl.FileIdempotencyStore{Dir:dir}
+
+				<-start
+				_, found, err := store.Reserve(id, "fingerprint-1", reserved, ttl)
+				if nil != err {
+					t.Errorf("Did not expect an error, but actually got one: (%T) %q", err, err)
+					return
+				}
+				if !found {
+					atomic.AddInt32(&reservations, 1)
+				}
+			}()
+		}
+		close(start)
+		waitgroup.Wait()
+
+		if expected, actual := int32(1), reservations; expected != actual {
+			t.Errorf("For round #%d, expected exactly %d reservation, but actually got %d.", round, expected, actual)
+			return
+		}
+	}
+}
@@ -0,0 +1,258 @@
+package mdl
+
+import (
+	"database/sql/driver"
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// Float64 is a float64 option type.
+//
+// It can have 2 kinds of value:
+//
+// • no float64 (i.e., ‘nothing’),
+//
+// • some float64 (i.e., ‘something’).
+//
+// Like with ‘mdl.String’, the value in this type is that it can differentiate between being assigned zero (0.0),
+// and a variable that hasn't been loaded.
+type Float64 struct {
+	datum  float64
+	loaded bool
+}
+
+// NoFloat64 returns a mdl.Float64 which has no value (i.e., ‘nothing’).
+//
+// Note that this is not the same thing as zero (0.0)!
+// That is mdl.SomeFloat64(0).
+//
+// Example
+//
+//	var datum mdl.Float64
+//	
+//	// ...
+//	
+//	if mdl.NoFloat64() == datum {
+//		//@TODO
+//	}
+func NoFloat64() Float64 {
+	return Float64{}
+}
+
+// SomeFloat64 returns a mdl.Float64 which has some value (i.e., ‘something’).
+//
+// Example
+//
+//	var datum mdl.Float64
+//	
+//	// ...
+//	
+//	datum = mdl.SomeFloat64(2.5)
+func SomeFloat64(datum float64) Float64 {
+	return Float64{
+		loaded: true,
+		datum:  datum,
+	}
+}
+
+func (receiver Float64) Else(datum float64) Float64 {
+	if NoFloat64() != receiver {
+		return receiver
+	}
+
+	return SomeFloat64(datum)
+}
+
+func (receiver Float64) ElseUnwrap(datum float64) float64 {
+	if NoFloat64() == receiver {
+		return datum
+	}
+
+	return receiver.datum
+}
+
+// Format makes ‘mdl.Float64’ fit the ‘fmt.Formatter’ interface.
+//
+// The %b, %e, %E, %f, %F, %g, %G, %x, %X, and %v verbs format the value the same way they would format a float64.
+// The %q verb formats its text form (see .MarshalText()) as a quoted string, and the %#v verb formats it with .GoString().
+func (receiver Float64) Format(f fmt.State, c rune) {
+	switch {
+	case 'v' == c && f.Flag('#'):
+		fmt.Fprint(f, receiver.GoString())
+	case NoFloat64() == receiver && 'q' == c:
+		fmt.Fprint(f, "«no-float64»")
+	case NoFloat64() == receiver:
+		fmt.Fprintf(f, "%%!%s(%s)", string(c), receiver.GoString())
+	case 'q' == c:
+		fmt.Fprintf(f, "%q", strconv.FormatFloat(receiver.datum, 'g', -1, 64))
+	case strings.ContainsRune("beEfFgGxXv", c):
+		fmt.Fprintf(f, optionFormatDirective(f, c), receiver.datum)
+	default:
+		fmt.Fprintf(f, "%%!%s(%s)", string(c), receiver.GoString())
+	}
+}
+
+// GoString makes mdl.Float64 fit the fmt.GoStringer interface.
+//
+// It gets used with the %#v verb with the printing family of functions
+// in the Go built-in "fmt" package.
+func (receiver Float64) GoString() string {
+	if NoFloat64() == receiver {
+		return "mdl.NoFloat64()"
+	}
+
+	return fmt.Sprintf("mdl.SomeFloat64(%s)", strconv.FormatFloat(receiver.datum, 'g', -1, 64))
+}
+
+// MarshalJSON makes mdl.Float64 fit the encoding/json.Marshaler interface.
+//
+// mdl.NoFloat64() is marshaled as JSON null, and mdl.SomeFloat64() is marshaled as a JSON number (NaN and infinity cannot be marshaled as JSON, and return an error).
+func (receiver Float64) MarshalJSON() ([]byte, error) {
+	if NoFloat64() == receiver {
+		return []byte("null"), nil
+	}
+
+	return json.Marshal(receiver.datum)
+}
+
+// MarshalText makes mdl.Float64 fit the encoding.TextMarshaler interface.
+//
+// mdl.SomeFloat64() is marshaled as the shortest decimal that is exactly the value (ex: 2.5, or 1e+21).
+// Since text has no way of saying ‘nothing’, mdl.NoFloat64() cannot be marshaled as text, and returns an error.
+func (receiver Float64) MarshalText() ([]byte, error) {
+	if NoFloat64() == receiver {
+		return nil, errNotLoaded
+	}
+
+	return []byte(strconv.FormatFloat(receiver.datum, 'g', -1, 64)), nil
+}
+
+func (receiver Float64) Map(fn func(float64)float64) Float64 {
+	if NoFloat64() == receiver {
+		return receiver
+	}
+
+	return SomeFloat64(fn(receiver.datum))
+}
+
+// Scan makes mdl.Float64 fit the database/sql.Scanner interface.
+//
+// A nil source (i.e., SQL NULL) scans as mdl.NoFloat64().
+// The source can be a float64, a float32, an int64, a string, a []byte, or an ‘mdl.Float64’.
+func (receiver *Float64) Scan(src interface{}) error {
+	if nil == receiver {
+		return errNilReceiver
+	}
+
+	switch casted := src.(type) {
+	case nil:
+		*receiver = NoFloat64()
+		return nil
+	case Float64:
+		*receiver = casted
+		return nil
+	case string:
+		return receiver.scanText(casted)
+	case []byte:
+		if nil == casted {
+			*receiver = NoFloat64()
+			return nil
+		}
+		return receiver.scanText(string(casted))
+	case float64:
+		*receiver = SomeFloat64(casted)
+		return nil
+	case float32:
+		*receiver = SomeFloat64(float64(casted))
+		return nil
+	case int64:
+		*receiver = SomeFloat64(float64(casted))
+		return nil
+	default:
+		return unsupportedSource(src)
+	}
+}
+
+// String returns the text form of the value (see .MarshalText()).
+func (receiver Float64) String() (string, error) {
+	if NoFloat64() == receiver {
+		return "", errNotLoaded
+	}
+
+	return strconv.FormatFloat(receiver.datum, 'g', -1, 64), nil
+}
+
+func (receiver Float64) Then(fn func(float64)Float64) Float64 {
+	if NoFloat64() == receiver {
+		return receiver
+	}
+
+	return fn(receiver.datum)
+}
+
+// UnmarshalJSON makes mdl.Float64 fit the encoding/json.Unmarshaler interface.
+//
+// It is the opposite of .MarshalJSON(); i.e., JSON null is unmarshaled as mdl.NoFloat64().
+func (receiver *Float64) UnmarshalJSON(data []byte) error {
+	if nil == receiver {
+		return errNilReceiver
+	}
+
+	if "null" == string(data) {
+		*receiver = NoFloat64()
+		return nil
+	}
+
+	var datum float64
+	if err := json.Unmarshal(data, &datum); nil != err {
+		return err
+	}
+
+	*receiver = SomeFloat64(datum)
+	return nil
+}
+
+// UnmarshalText makes mdl.Float64 fit the encoding.TextUnmarshaler interface.
+//
+// It is the opposite of .MarshalText(). (A nil text is unmarshaled as mdl.NoFloat64().)
+func (receiver *Float64) UnmarshalText(text []byte) error {
+	if nil == receiver {
+		return errNilReceiver
+	}
+
+	if nil == text {
+		*receiver = NoFloat64()
+		return nil
+	}
+
+	return receiver.scanText(string(text))
+}
+
+func (receiver Float64) Unwrap() (float64, bool) {
+	return receiver.datum, receiver.loaded
+}
+
+// Value makes mdl.Float64 fit the database/sql/driver.Valuer interface.
+func (receiver Float64) Value() (driver.Value, error) {
+	if NoFloat64() == receiver {
+		return nil, errNotLoaded
+	}
+
+	return receiver.datum, nil
+}
+
+func (receiver Float64) isNothing() bool {
+	return NoFloat64() == receiver
+}
+
+func (receiver *Float64) scanText(text string) error {
+	datum, err := strconv.ParseFloat(text, 64)
+	if nil != err {
+		return err
+	}
+
+	*receiver = SomeFloat64(datum)
+	return nil
+}
@@ -0,0 +1,49 @@
+package mdl_test
+
+import (
+	"github.com/reiver/go-mdl"
+
+	"testing"
+)
+
+func TestFloat64Else(t *testing.T) {
+
+	tests := []struct{
+		Value    mdl.Float64
+		Else     float64
+		Expected mdl.Float64
+	}{
+		{
+			Value:    mdl.NoFloat64(),
+			Else:                     0.5,
+			Expected: mdl.SomeFloat64(0.5),
+		},
+		{
+			Value:    mdl.SomeFloat64(2.5),
+			Else:                     0.5,
+			Expected: mdl.SomeFloat64(2.5),
+		},
+
+
+
+		{
+			Value:    mdl.SomeFloat64(0),
+			Else:                     0.5,
+			Expected: mdl.SomeFloat64(0),
+		},
+	}
+
+	for testNumber, test := range tests {
+
+		actual := test.Value.Else(test.Else)
+
+		if expected := test.Expected; expected != actual {
+			t.Errorf("For test #%d ...", testNumber)
+			t.Errorf("\tVALUE:    %#v", test.Value)
+			t.Errorf("\tELSE:                     %g", test.Else)
+			t.Errorf("\tEXPECTED: %#v", expected)
+			t.Errorf("\tACTUAL:   %#v", actual)
+			continue
+		}
+	}
+}
@@ -0,0 +1,46 @@
+package mdl_test
+
+import (
+	"github.com/reiver/go-mdl"
+
+	"testing"
+)
+
+func TestFloat64ElseUnwrap(t *testing.T) {
+
+	tests := []struct{
+		Value    mdl.Float64
+		Else     float64
+		Expected float64
+	}{
+		{
+			Value:    mdl.NoFloat64(),
+			Else:                     0.5,
+			Expected:                 0.5,
+		},
+		{
+			Value:    mdl.SomeFloat64(2.5),
+			Else:                     0.5,
+			Expected:                 2.5,
+		},
+		{
+			Value:    mdl.SomeFloat64(-0.125),
+			Else:                     0.5,
+			Expected:                 -0.125,
+		},
+	}
+
+	for testNumber, test := range tests {
+
+		actual := test.Value.ElseUnwrap(test.Else)
+
+		if expected := test.Expected; expected != actual {
+			t.Errorf("For test #%d ...", testNumber)
+			t.Errorf("\tVALUE: %#v", test.Value)
+			t.Errorf("\tELSE:                  %g", test.Else)
+			t.Errorf("\tEXPECTED:              %g", expected)
+			t.Errorf("\tACTUAL:                %g", actual)
+			continue
+		}
+	}
+}
@@ -0,0 +1,84 @@
+package mdl_test
+
+import (
+	"github.com/reiver/go-mdl"
+
+	"fmt"
+
+	"testing"
+)
+
+func TestFloat64Format(t *testing.T) {
+
+	tests := []struct{
+		Format   string
+		Float64  mdl.Float64
+		Expected string
+	}{
+		{
+			Format:  "%q",
+			Float64: mdl.NoFloat64(),
+			Expected:      `«no-float64»`,
+		},
+		{
+			Format:  "%q",
+			Float64: mdl.SomeFloat64(2.5),
+			Expected:      `"2.5"`,
+		},
+		{
+			Format:  "%q",
+			Float64: mdl.SomeFloat64(1e21),
+			Expected:      `"1e+21"`,
+		},
+
+
+
+		{
+			Format:  "%v",
+			Float64: mdl.SomeFloat64(2.5),
+			Expected:      `2.5`,
+		},
+		{
+			Format:  "%8.3f",
+			Float64: mdl.SomeFloat64(2.5),
+			Expected:      `   2.500`,
+		},
+		{
+			Format:  "%+.2e",
+			Float64: mdl.SomeFloat64(-1234.5),
+			Expected:      `-1.23e+03`,
+		},
+		{
+			Format:  "%G",
+			Float64: mdl.SomeFloat64(1e-7),
+			Expected:      `1E-07`,
+		},
+
+
+
+		{
+			Format:  "%f",
+			Float64: mdl.NoFloat64(),
+			Expected:      `%!f(mdl.NoFloat64())`,
+		},
+		{
+			Format:  "%d",
+			Float64: mdl.SomeFloat64(2.5),
+			Expected:      `%!d(mdl.SomeFloat64(2.5))`,
+		},
+	}
+
+	for testNumber, test := range tests {
+
+		actual := fmt.Sprintf(test.Format, test.Float64)
+		if expected := test.Expected; expected != actual {
+			t.Errorf("For test #%d, for print verb, did not actually get what was expected.", testNumber)
+			t.Logf("\tEXPECTED: %q", expected)
+			t.Logf("\tACTUAL:   %q", actual)
+			t.Logf("\tFormat: %q", test.Format)
+			t.Logf("\tFloat64: %#v", test.Float64)
+			continue
+		}
+
+	}
+}
@@ -0,0 +1,53 @@
+package mdl_test
+
+import (
+	"github.com/reiver/go-mdl"
+
+	"math"
+
+	"testing"
+)
+
+func TestFloat64GoString(t *testing.T) {
+
+	tests := []struct{
+		Value    mdl.Float64
+		Expected string
+	}{
+		{
+			Value:    mdl.NoFloat64(),
+			Expected: "mdl.NoFloat64()",
+		},
+
+
+
+		{
+			Value:    mdl.SomeFloat64(0),
+			Expected: "mdl.SomeFloat64(0)",
+		},
+		{
+			Value:    mdl.SomeFloat64(2.5),
+			Expected: "mdl.SomeFloat64(2.5)",
+		},
+		{
+			Value:    mdl.SomeFloat64(-1e21),
+			Expected: "mdl.SomeFloat64(-1e+21)",
+		},
+		{
+			Value:    mdl.SomeFloat64(math.Inf(1)),
+			Expected: "mdl.SomeFloat64(+Inf)",
+		},
+	}
+
+	for testNumber, test := range tests {
+
+		actual := test.Value.GoString()
+
+		if expected := test.Expected; expected != actual {
+			t.Errorf("For test #%d ...", testNumber)
+			t.Errorf("\tEXPECTED: %#v", expected)
+			t.Errorf("\tACTUAL:   %#v", actual)
+			continue
+		}
+	}
+}
@@ -0,0 +1,80 @@
+package mdl_test
+
+import (
+	"github.com/reiver/go-mdl"
+
+	"encoding/json"
+	"math"
+	"testing"
+)
+
+func TestFloat64JSON(t *testing.T) {
+
+	tests := []struct{
+		Datum    mdl.Float64
+		Expected string
+	}{
+		{
+			Datum:    mdl.NoFloat64(),
+			Expected: `null`,
+		},
+		{
+			Datum:    mdl.SomeFloat64(0),
+			Expected: `0`,
+		},
+		{
+			Datum:    mdl.SomeFloat64(2.5),
+			Expected: `2.5`,
+		},
+		{
+			Datum:    mdl.SomeFloat64(-1e21),
+			Expected: `-1e+21`,
+		},
+	}
+
+	for testNumber, test := range tests {
+
+		data, err := json.Marshal(test.Datum)
+		if nil != err {
+			t.Errorf("For test #%d, did not expect an error, but actually got one: (%T) %q", testNumber, err, err)
+			continue
+		}
+
+		if expected, actual := test.Expected, string(data); expected != actual {
+			t.Errorf("For test #%d, expected %s, but actually got %s.", testNumber, expected, actual)
+			continue
+		}
+
+		var actual mdl.Float64 = mdl.SomeFloat64(0.5)
+		if err := json.Unmarshal(data, &actual); nil != err {
+			t.Errorf("For test #%d, did not expect an error, but actually got one: (%T) %q", testNumber, err, err)
+			continue
+		}
+
+		if expected := test.Datum; expected != actual {
+			t.Errorf("For test #%d, expected %#v, but actually got %#v.", testNumber, expected, actual)
+			continue
+		}
+	}
+}
+
+func TestFloat64JSONError(t *testing.T) {
+
+	// JSON has no way of saying NaN or infinity.
+	for testNumber, datum := range []mdl.Float64{ mdl.SomeFloat64(math.NaN()), mdl.SomeFloat64(math.Inf(1)), mdl.SomeFloat64(math.Inf(-1)) } {
+
+		if _, err := json.Marshal(datum); nil == err {
+			t.Errorf("For test #%d, expected an error marshaling %#v, but did not actually get one.", testNumber, datum)
+			continue
+		}
+	}
+
+	for testNumber, data := range []string{ `"2.5"`, `true` } {
+
+		var actual mdl.Float64
+		if err := json.Unmarshal([]byte(data), &actual); nil == err {
+			t.Errorf("For test #%d, expected an error unmarshaling %s, but did not actually get one.", testNumber, data)
+			continue
+		}
+	}
+}
@@ -0,0 +1,54 @@
+package mdl_test
+
+import (
+	"github.com/reiver/go-mdl"
+
+	"math"
+
+	"testing"
+)
+
+func TestFloat64Map(t *testing.T) {
+
+	tests := []struct{
+		Value    mdl.Float64
+		Func     func(float64)float64
+		Expected mdl.Float64
+	}{
+		{
+			Value:    mdl.NoFloat64(),
+			Func:     math.Floor,
+			Expected: mdl.NoFloat64(),
+		},
+		{
+			Value:    mdl.SomeFloat64(2.5),
+			Func:     math.Floor,
+			Expected: mdl.SomeFloat64(2),
+		},
+
+
+
+		{
+			Value:    mdl.SomeFloat64(-2.5),
+			Func:     math.Abs,
+			Expected: mdl.SomeFloat64(2.5),
+		},
+		{
+			Value:    mdl.SomeFloat64(9),
+			Func:     math.Sqrt,
+			Expected: mdl.SomeFloat64(3),
+		},
+	}
+
+	for testNumber, test := range tests {
+
+		actual := test.Value.Map(test.Func)
+
+		if expected := test.Expected; expected != actual {
+			t.Errorf("For test #%d ...", testNumber)
+			t.Errorf("\tEXPECTED: %#v", expected)
+			t.Errorf("\tACTUAL:   %#v", actual)
+			continue
+		}
+	}
+}
@@ -0,0 +1,53 @@
+package mdl_test
+
+import (
+	"github.com/reiver/go-mdl"
+
+	"math"
+
+	"testing"
+)
+
+func TestFloat64MarshalText(t *testing.T) {
+
+	tests := []struct{
+		Value    mdl.Float64
+		Expected string
+	}{
+		{
+			Value:    mdl.SomeFloat64(0),
+			Expected: "0",
+		},
+		{
+			Value:    mdl.SomeFloat64(2.5),
+			Expected: "2.5",
+		},
+		{
+			Value:    mdl.SomeFloat64(1e21),
+			Expected: "1e+21",
+		},
+		{
+			// Unlike JSON, text can say infinity.
+			Value:    mdl.SomeFloat64(math.Inf(-1)),
+			Expected: "-Inf",
+		},
+	}
+
+	for testNumber, test := range tests {
+
+		text, err := test.Value.MarshalText()
+		if nil != err {
+			t.Errorf("For test #%d, did not expect an error, but actually got one: (%T) %q", testNumber, err, err)
+			continue
+		}
+
+		if expected, actual := test.Expected, string(text); expected != actual {
+			t.Errorf("For test #%d, expected %q, but actually got %q.", testNumber, expected, actual)
+			continue
+		}
+	}
+
+	if _, err := mdl.NoFloat64().MarshalText(); nil == err {
+		t.Errorf("Expected an error for mdl.NoFloat64(), but did not actually get one.")
+	}
+}
@@ -0,0 +1,110 @@
+package mdl_test
+
+import (
+	"github.com/reiver/go-mdl"
+
+	"testing"
+)
+
+func TestFloat64Scan(t *testing.T) {
+
+	tests := []struct{
+		Datum    interface{}
+		Expected mdl.Float64
+	}{
+		{
+			Datum:    nil,
+			Expected: mdl.NoFloat64(),
+		},
+		{
+			Datum:    []byte(nil),
+			Expected: mdl.NoFloat64(),
+		},
+
+
+
+		{
+			Datum:    mdl.NoFloat64(),
+			Expected: mdl.NoFloat64(),
+		},
+		{
+			Datum:    mdl.SomeFloat64(2.5),
+			Expected: mdl.SomeFloat64(2.5),
+		},
+
+
+
+		{
+			Datum:                    "2.5",
+			Expected: mdl.SomeFloat64(2.5),
+		},
+		{
+			Datum:             []byte("2.5"),
+			Expected: mdl.SomeFloat64(2.5),
+		},
+		{
+			Datum:                    "-1e21",
+			Expected: mdl.SomeFloat64(-1e21),
+		},
+
+
+
+		{
+			Datum:            float64(2.5),
+			Expected: mdl.SomeFloat64(2.5),
+		},
+		{
+			Datum:            float32(2.5),
+			Expected: mdl.SomeFloat64(2.5),
+		},
+		{
+			Datum:              int64(-3),
+			Expected: mdl.SomeFloat64(-3),
+		},
+	}
+
+	for testNumber, test := range tests {
+
+		var actual mdl.Float64 = mdl.SomeFloat64(0.5)
+
+		err := actual.Scan(test.Datum)
+
+		if expected := test.Expected; nil != err {
+			t.Errorf("For test #%d, did not expect an error, but actually got one: (%T) %q", testNumber, err, err)
+			t.Errorf("\tDATUM: (%T) %#v", test.Datum, test.Datum)
+			t.Errorf("\tEXPECTED: %#v", expected)
+			t.Errorf("\tACTUAL:   %#v", actual)
+			continue
+		}
+
+		if expected := test.Expected; expected != actual {
+			t.Errorf("For test #%d ...", testNumber)
+			t.Errorf("\tDATUM: (%T) %#v", test.Datum, test.Datum)
+			t.Errorf("\tEXPECTED: %#v", expected)
+			t.Errorf("\tACTUAL:   %#v", actual)
+			continue
+		}
+	}
+}
+
+func TestFloat64ScanError(t *testing.T) {
+
+	tests := []interface{}{
+		"two and a half",
+		"2,5",
+		true,
+		int32(2),
+	}
+
+	for testNumber, datum := range tests {
+
+		var actual mdl.Float64
+
+		if err := actual.Scan(datum); nil == err {
+			t.Errorf("For test #%d, expected an error, but did not actually get one.", testNumber)
+			t.Errorf("\tDATUM: (%T) %#v", datum, datum)
+			t.Errorf("\tACTUAL: %#v", actual)
+			continue
+		}
+	}
+}
@@ -0,0 +1,82 @@
+package mdl_test
+
+import (
+	"github.com/reiver/go-mdl"
+
+	"math"
+
+	"testing"
+)
+
+func TestFloat64String(t *testing.T) {
+
+	tests := []struct{
+		Value          mdl.Float64
+		ExpectedLoaded bool
+		Expected       string
+	}{
+		{
+			Value: mdl.NoFloat64(),
+			ExpectedLoaded: false,
+			Expected:                    "",
+		},
+
+
+
+		{
+			Value: mdl.SomeFloat64(0),
+			ExpectedLoaded: true,
+			Expected:                    "0",
+		},
+		{
+			Value: mdl.SomeFloat64(2.5),
+			ExpectedLoaded: true,
+			Expected:                    "2.5",
+		},
+		{
+			Value: mdl.SomeFloat64(0.1),
+			ExpectedLoaded: true,
+			Expected:                    "0.1",
+		},
+		{
+			Value: mdl.SomeFloat64(-1e21),
+			ExpectedLoaded: true,
+			Expected:                    "-1e+21",
+		},
+		{
+			Value: mdl.SomeFloat64(math.NaN()),
+			ExpectedLoaded: true,
+			Expected:                    "NaN",
+		},
+	}
+
+	for testNumber, test := range tests {
+
+		actual, err := test.Value.String()
+
+		if !test.ExpectedLoaded && nil == err {
+			t.Errorf("For test #%d, expected an error, but did not actually get one: %#v", testNumber, err)
+			t.Errorf("\tEXPECTED loaded: %t", test.ExpectedLoaded)
+			t.Errorf("\tEXPECTED: %#v", test.Expected)
+			t.Errorf("\tACTUAL:   %#v", actual)
+			continue
+		}
+
+		if test.ExpectedLoaded && nil != err {
+			t.Errorf("For test #%d, did not expect an error, but actually got one: (%T) %q", testNumber, err, err)
+			t.Errorf("\tEXPECTED loaded: %t", test.ExpectedLoaded)
+			t.Errorf("\tEXPECTED: %#v", test.Expected)
+			t.Errorf("\tACTUAL:   %#v", actual)
+			continue
+		}
+
+		if expected := test.Expected; expected != actual {
+			t.Errorf("For test #%d ...", testNumber)
+			t.Errorf("\tEXPECTED loaded: %t", test.ExpectedLoaded)
+			t.Errorf("\tACTUAL   err:   (%T) %q", err, err)
+			t.Errorf("\tEXPECTED: %#v", expected)
+			t.Errorf("\tACTUAL:   %#v", actual)
+			continue
+		}
+	}
+}
@@ -0,0 +1,55 @@
+package mdl_test
+
+import (
+	"github.com/reiver/go-mdl"
+
+	"math"
+
+	"testing"
+)
+
+func TestFloat64Then(t *testing.T) {
+
+	// A square root that is ‘nothing’ (rather than NaN) for a negative number.
+	sqrt := func(x float64) mdl.Float64 {
+		if x < 0 {
+			return mdl.NoFloat64()
+		}
+		return mdl.SomeFloat64(math.Sqrt(x))
+	}
+
+	tests := []struct{
+		Value    mdl.Float64
+		Func     func(float64)mdl.Float64
+		Expected mdl.Float64
+	}{
+		{
+			Value:    mdl.NoFloat64(),
+			Func:     sqrt,
+			Expected: mdl.NoFloat64(),
+		},
+		{
+			Value:    mdl.SomeFloat64(-4),
+			Func:     sqrt,
+			Expected: mdl.NoFloat64(),
+		},
+		{
+			Value:    mdl.SomeFloat64(6.25),
+			Func:     sqrt,
+			Expected: mdl.SomeFloat64(2.5),
+		},
+	}
+
+	for testNumber, test := range tests {
+
+		actual := test.Value.Then(test.Func)
+
+		if expected := test.Expected; expected != actual {
+			t.Errorf("For test #%d ...", testNumber)
+			t.Errorf("\tVALUE:    %#v", test.Value)
+			t.Errorf("\tEXPECTED: %#v", expected)
+			t.Errorf("\tACTUAL:   %#v", actual)
+			continue
+		}
+	}
+}
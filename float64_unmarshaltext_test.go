@@ -0,0 +1,73 @@
+package mdl_test
+
+import (
+	"github.com/reiver/go-mdl"
+
+	"math"
+
+	"testing"
+)
+
+func TestFloat64UnmarshalText(t *testing.T) {
+
+	tests := []struct{
+		Datum    []byte
+		Expected mdl.Float64
+	}{
+		{
+			Datum:         []byte(nil),
+			Expected: mdl.NoFloat64(),
+		},
+
+
+
+		{
+			Datum:         []byte("0"),
+			Expected: mdl.SomeFloat64(0),
+		},
+		{
+			Datum:         []byte("2.5"),
+			Expected: mdl.SomeFloat64(2.5),
+		},
+		{
+			Datum:         []byte("1e+21"),
+			Expected: mdl.SomeFloat64(1e21),
+		},
+		{
+			Datum:         []byte("-Inf"),
+			Expected: mdl.SomeFloat64(math.Inf(-1)),
+		},
+	}
+
+	for testNumber, test := range tests {
+
+		var actual mdl.Float64 = mdl.SomeFloat64(0.5)
+
+		err := actual.UnmarshalText(test.Datum)
+
+		if expected := test.Expected; nil != err {
+			t.Errorf("For test #%d, did not expect an error, but actually got one: (%T) %q", testNumber, err, err)
+			t.Errorf("\tDATUM: %q", test.Datum)
+			t.Errorf("\tEXPECTED: %#v", expected)
+			t.Errorf("\tACTUAL:   %#v", actual)
+			continue
+		}
+
+		if expected := test.Expected; expected != actual {
+			t.Errorf("For test #%d ...", testNumber)
+			t.Errorf("\tDATUM: %q", test.Datum)
+			t.Errorf("\tEXPECTED: %#v", expected)
+			t.Errorf("\tACTUAL:   %#v", actual)
+			continue
+		}
+	}
+
+	for testNumber, datum := range []string{"", "2,5", "two"} {
+
+		var actual mdl.Float64
+		if err := actual.UnmarshalText([]byte(datum)); nil == err {
+			t.Errorf("For bad text #%d (%q), expected an error, but did not actually get one.", testNumber, datum)
+			continue
+		}
+	}
+}
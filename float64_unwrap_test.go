@@ -0,0 +1,80 @@
+package mdl_test
+
+import (
+	"github.com/reiver/go-mdl"
+
+	"math"
+
+	"testing"
+)
+
+func TestFloat64Unwrap(t *testing.T) {
+
+	tests := []struct{
+		Value          mdl.Float64
+		ExpectedLoaded bool
+		Expected       float64
+	}{
+		{
+			Value: mdl.NoFloat64(),
+			ExpectedLoaded: false,
+			Expected:                    0,
+		},
+		{
+			Value: mdl.Float64{},
+			ExpectedLoaded: false,
+			Expected:                    0,
+		},
+
+
+
+		{
+			Value: mdl.SomeFloat64(0),
+			ExpectedLoaded: true,
+			Expected:                    0,
+		},
+		{
+			Value: mdl.SomeFloat64(2.5),
+			ExpectedLoaded: true,
+			Expected:                    2.5,
+		},
+		{
+			Value: mdl.SomeFloat64(-1e21),
+			ExpectedLoaded: true,
+			Expected:                    -1e21,
+		},
+		{
+			Value: mdl.SomeFloat64(math.Inf(-1)),
+			ExpectedLoaded: true,
+			Expected:                    math.Inf(-1),
+		},
+	}
+
+	for testNumber, test := range tests {
+
+		actual, actualLoaded := test.Value.Unwrap()
+
+		if expected, expectedLoaded := test.Expected, test.ExpectedLoaded; expectedLoaded != actualLoaded {
+			t.Errorf("For test #%d ...", testNumber)
+			t.Errorf("\tEXPECTED loaded: %t", expectedLoaded)
+			t.Errorf("\tACTUAL   loaded: %t", actualLoaded)
+			t.Errorf("\tEXPECTED: %g", expected)
+			t.Errorf("\tACTUAL:   %g", actual)
+			continue
+		}
+
+		if expected, expectedLoaded := test.Expected, test.ExpectedLoaded; expected != actual {
+			t.Errorf("For test #%d ...", testNumber)
+			t.Errorf("\tEXPECTED loaded: %t", expectedLoaded)
+			t.Errorf("\tACTUAL   loaded: %t", actualLoaded)
+			t.Errorf("\tEXPECTED: %g", expected)
+			t.Errorf("\tACTUAL:   %g", actual)
+			continue
+		}
+	}
+
+	// NaN is not equal to itself; so it cannot go in the table above.
+	if actual, loaded := mdl.SomeFloat64(math.NaN()).Unwrap(); !loaded || !math.IsNaN(actual) {
+		t.Errorf("Expected NaN to be loaded, but actually got: %g, %t", actual, loaded)
+	}
+}
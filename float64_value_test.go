@@ -0,0 +1,81 @@
+package mdl_test
+
+import (
+	"github.com/reiver/go-mdl"
+
+	"testing"
+)
+
+func TestFloat64Value(t *testing.T) {
+
+	tests := []struct{
+		Value          mdl.Float64
+		ExpectedLoaded bool
+		Expected       float64
+	}{
+		{
+			Value: mdl.NoFloat64(),
+			ExpectedLoaded: false,
+			Expected:                    0,
+		},
+
+
+
+		{
+			Value: mdl.SomeFloat64(0),
+			ExpectedLoaded: true,
+			Expected:                    0,
+		},
+		{
+			Value: mdl.SomeFloat64(2.5),
+			ExpectedLoaded: true,
+			Expected:                    2.5,
+		},
+		{
+			Value: mdl.SomeFloat64(-1e21),
+			ExpectedLoaded: true,
+			Expected:                    -1e21,
+		},
+	}
+
+	for testNumber, test := range tests {
+
+		actualInterface, err := test.Value.Value()
+
+		if !test.ExpectedLoaded && nil == err {
+			t.Errorf("For test #%d, expected an error, but did not actually get one: %#v", testNumber, err)
+			t.Errorf("\tEXPECTED loaded: %t", test.ExpectedLoaded)
+			t.Errorf("\tEXPECTED: %#v", test.Expected)
+			t.Errorf("\tACTUAL: %T %#v", actualInterface, actualInterface)
+			continue
+		}
+
+		if test.ExpectedLoaded && nil != err {
+			t.Errorf("For test #%d, did not expect an error, but actually got one: (%T) %q", testNumber, err, err)
+			t.Errorf("\tEXPECTED loaded: %t", test.ExpectedLoaded)
+			t.Errorf("\tEXPECTED: %#v", test.Expected)
+			t.Errorf("\tACTUAL: %T %#v", actualInterface, actualInterface)
+			continue
+		}
+
+		if !test.ExpectedLoaded {
+			continue
+		}
+		actual, casted := actualInterface.(float64)
+		if !casted {
+			t.Errorf("For test #%d, expected type ‘float64’, but actually got type ‘%T’.", testNumber, actualInterface)
+			t.Errorf("\tEXPECTED loaded: %t", test.ExpectedLoaded)
+			t.Errorf("\tEXPECTED: %#v", test.Expected)
+			t.Errorf("\tACTUAL: %T %#v", actualInterface, actualInterface)
+			continue
+		}
+
+		if expected := test.Expected; expected != actual {
+			t.Errorf("For test #%d ...", testNumber)
+			t.Errorf("\tEXPECTED loaded: %t", test.ExpectedLoaded)
+			t.Errorf("\tEXPECTED: %#v", expected)
+			t.Errorf("\tACTUAL:   %#v", actual)
+			continue
+		}
+	}
+}
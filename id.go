@@ -4,12 +4,12 @@ import (
 	"net/http"
 )
 
-func inferID(request *http.Request) (string, bool) {
+func inferID(request *http.Request, header string) (string, bool) {
 	if nil == request {
 		return "", false
 	}
 
-	if value := request.Header.Get("X-Idempotent-ID"); "" != value {
+	if value := request.Header.Get(header); "" != value {
 		return value, true
 	}
 
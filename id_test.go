@@ -273,7 +273,7 @@ apple=one&banana=two&cherry=three`,
 			request = r
 		}
 
-		actualID, actualOK := inferID(request)
+		actualID, actualOK := inferID(request, defaultIdempotentIDHeader)
 
 		if expected, actual := test.ExpectedOK, actualOK; expected != actual {
 			t.Errorf("For test #%d, did not get expected value for ‘ok’.", testNumber)
@@ -0,0 +1,258 @@
+package mdl
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"time"
+)
+
+const (
+	defaultIdempotencyTTL       time.Duration = 24 * time.Hour
+	idempotencyWaitPollInterval time.Duration = 25 * time.Millisecond
+)
+
+// IdempotencyHandler is HTTP middleware that makes it so an instruction is executed at most one time
+// for each Idempotent ID.
+//
+// The first HTTP request with a given Idempotent ID is passed on to Handler, and the HTTP response it
+// produces is recorded in Store. Any retry of that HTTP request (i.e., an HTTP request with the same
+// Idempotent ID, and the same payload) is not passed on to Handler, and instead gets the recorded
+// HTTP response replayed back to it.
+//
+// An HTTP request that reuses an Idempotent ID, but with a different payload, gets a
+// “422 Unprocessable Entity” HTTP response.
+//
+// An HTTP request that comes in while another HTTP request with the same Idempotent ID is still
+// being handled either waits for that other HTTP request to finish (if Wait is true), or gets a
+// “409 Conflict” HTTP response (if Wait is false).
+//
+// If Handler responds with a “5xx” HTTP status code, then the HTTP response is not recorded, and the
+// Idempotent ID can be used again; so that the HTTP client can retry.
+//
+// Example
+//
+//	var mux mdl.Mux
+//	
+//	// ...
+//	
+//	var store mdl.MemoryIdempotencyStore
+//	
+//	handler := mdl.IdempotencyHandler{
+//		Handler: &mux,
+//		Store: &store,
+//	}
+//	
+//	http.Handle("/v1/users", &handler)
+type IdempotencyHandler struct {
+
+	// Handler is the http.Handler HTTP requests are passed on to.
+	Handler http.Handler
+
+	// Store is where Idempotent IDs, and the HTTP responses produced for them, are recorded.
+	Store IdempotencyStore
+
+	// TTL is how long an Idempotent ID is recorded for.
+	//
+	// If zero, then 24 hours is used.
+	TTL time.Duration
+
+	// Wait controls what happens to an HTTP request that comes in while another HTTP request with the same Idempotent ID
+	// is still being handled. If true, it waits; if false, it gets a “409 Conflict” HTTP response.
+	Wait bool
+
+	// Decoder provides the name of the Idempotent ID HTTP request header, and the HTTP request body limit.
+	Decoder InstructionDecoder
+
+	now func() time.Time
+}
+
+// ServeHTTP makes ‘mdl.IdempotencyHandler’ fit the ‘http.Handler’ interface.
+func (receiver *IdempotencyHandler) ServeHTTP(responseWriter http.ResponseWriter, request *http.Request) {
+	if nil == responseWriter {
+		return
+	}
+	if nil == receiver || nil == receiver.Handler || nil == receiver.Store || nil == request {
+		http.Error(responseWriter, "Internal Server Error", http.StatusInternalServerError)
+		return
+	}
+
+	id, ok := inferID(request, receiver.Decoder.idempotentIDHeader())
+	if !ok {
+		http.Error(responseWriter, "Bad Request", http.StatusBadRequest)
+		return
+	}
+
+	fingerprint, err := receiver.fingerprint(request)
+	if nil != err {
+		switch casted := err.(type) {
+		case PayloadTooLarge:
+			http.Error(responseWriter, http.StatusText(casted.StatusCode()), casted.StatusCode())
+		case MalformedBody:
+			http.Error(responseWriter, http.StatusText(casted.StatusCode()), casted.StatusCode())
+		default:
+			http.Error(responseWriter, "Internal Server Error", http.StatusInternalServerError)
+		}
+		return
+	}
+
+	for {
+		record, found, err := receiver.Store.Reserve(id, fingerprint, receiver.currentTime(), receiver.ttl())
+		if nil != err {
+			http.Error(responseWriter, "Internal Server Error", http.StatusInternalServerError)
+			return
+		}
+
+		if !found {
+			break
+		}
+
+		if fingerprint != record.Fingerprint {
+			http.Error(responseWriter, "Unprocessable Entity: Idempotent ID already used with a different payload", http.StatusUnprocessableEntity)
+			return
+		}
+
+		if record.Completed {
+			idempotencyReplay(responseWriter, record.Response)
+			return
+		}
+
+		if !receiver.Wait {
+			http.Error(responseWriter, "Conflict: Idempotent ID is already being handled", http.StatusConflict)
+			return
+		}
+
+		select {
+		case <-request.Context().Done():
+			return
+		case <-time.After(idempotencyWaitPollInterval):
+		}
+	}
+
+	var recorder idempotencyResponseRecorder = idempotencyResponseRecorder{
+		responseWriter: responseWriter,
+	}
+
+	var completed bool
+	defer func() {
+		if !completed {
+			receiver.Store.Release(id)
+		}
+	}()
+
+	receiver.Handler.ServeHTTP(&recorder, request)
+
+	response := recorder.response()
+	if 500 <= response.StatusCode {
+		return
+	}
+
+	if err := receiver.Store.Complete(id, response); nil != err {
+		return
+	}
+	completed = true
+}
+
+func (receiver *IdempotencyHandler) currentTime() time.Time {
+	if nil == receiver.now {
+		return time.Now()
+	}
+
+	return receiver.now()
+}
+
+// fingerprint returns a hash of the HTTP request payload, and puts the HTTP request body back so it can be read again.
+func (receiver *IdempotencyHandler) fingerprint(request *http.Request) (string, error) {
+	verb, _ := inferVerb(request, receiver.Decoder.methodOverrideHeader())
+
+	hash := sha256.New()
+
+	io.WriteString(hash, verb)
+	hash.Write([]byte{0})
+	io.WriteString(hash, request.URL.RequestURI())
+	hash.Write([]byte{0})
+	io.WriteString(hash, request.Header.Get("Content-Type"))
+	hash.Write([]byte{0})
+
+	if nil != request.Body {
+		body, err := readBody(request, receiver.Decoder.bodyLimit())
+		if nil != err {
+			return "", err
+		}
+		request.Body = ioutil.NopCloser(bytes.NewReader([]byte(body)))
+
+		io.WriteString(hash, body)
+	}
+
+	return hex.EncodeToString(hash.Sum(nil)), nil
+}
+
+func (receiver *IdempotencyHandler) ttl() time.Duration {
+	if 0 >= receiver.TTL {
+		return defaultIdempotencyTTL
+	}
+
+	return receiver.TTL
+}
+
+func idempotencyReplay(responseWriter http.ResponseWriter, response IdempotencyResponse) {
+	header := responseWriter.Header()
+	for name, values := range response.Header {
+		header[name] = append([]string(nil), values...)
+	}
+
+	responseWriter.WriteHeader(response.StatusCode)
+	responseWriter.Write(response.Body)
+}
+
+// idempotencyResponseRecorder passes the HTTP response on to the real http.ResponseWriter, while also recording it.
+type idempotencyResponseRecorder struct {
+	responseWriter http.ResponseWriter
+	statusCode     int
+	header         http.Header
+	body           bytes.Buffer
+}
+
+func (receiver *idempotencyResponseRecorder) Header() http.Header {
+	return receiver.responseWriter.Header()
+}
+
+func (receiver *idempotencyResponseRecorder) Write(p []byte) (int, error) {
+	if 0 == receiver.statusCode {
+		receiver.WriteHeader(http.StatusOK)
+	}
+
+	receiver.body.Write(p)
+
+	return receiver.responseWriter.Write(p)
+}
+
+func (receiver *idempotencyResponseRecorder) WriteHeader(statusCode int) {
+	if 0 != receiver.statusCode {
+		return
+	}
+
+	receiver.statusCode = statusCode
+
+	receiver.header = http.Header{}
+	for name, values := range receiver.responseWriter.Header() {
+		receiver.header[name] = append([]string(nil), values...)
+	}
+
+	receiver.responseWriter.WriteHeader(statusCode)
+}
+
+func (receiver *idempotencyResponseRecorder) response() IdempotencyResponse {
+	if 0 == receiver.statusCode {
+		receiver.WriteHeader(http.StatusOK)
+	}
+
+	return IdempotencyResponse{
+		StatusCode: receiver.statusCode,
+		Header:     receiver.header,
+		Body:       receiver.body.Bytes(),
+	}
+}
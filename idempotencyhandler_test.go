@@ -0,0 +1,225 @@
+package mdl
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"time"
+
+	"testing"
+)
+
+func TestIdempotencyHandler(t *testing.T) {
+
+	var now time.Time = time.Date(2015, 5, 7, 10, 25, 9, 0, time.UTC)
+
+	var count int
+	var store MemoryIdempotencyStore
+
+	handler := IdempotencyHandler{
+		Handler: http.HandlerFunc(func(responseWriter http.ResponseWriter, request *http.Request) {
+			count++
+			if "fail" == request.URL.Query().Get("mode") {
+				http.Error(responseWriter, "Service Unavailable", http.StatusServiceUnavailable)
+				return
+			}
+			responseWriter.Header().Set("X-Count", fmt.Sprint(count))
+			responseWriter.WriteHeader(http.StatusCreated)
+			fmt.Fprintf(responseWriter, "count=%d", count)
+		}),
+		Store: &store,
+		TTL: time.Hour,
+		now: func() time.Time {
+			return now
+		},
+	}
+
+	tests := []struct{
+		ID      string
+		Target  string
+		Body    string
+		Advance time.Duration
+		ExpectedStatusCode int
+		ExpectedBody       string
+		ExpectedCount      int
+	}{
+		{
+			ID: "abc-123",
+			Target: "/v1/users",
+			Body: "email_address=joeblow@example.com",
+			ExpectedStatusCode: http.StatusCreated,
+			ExpectedBody: "count=1",
+			ExpectedCount: 1,
+		},
+		{
+			ID: "abc-123",
+			Target: "/v1/users",
+			Body: "email_address=joeblow@example.com",
+			ExpectedStatusCode: http.StatusCreated,
+			ExpectedBody: "count=1",
+			ExpectedCount: 1,
+		},
+		{
+			ID: "abc-123",
+			Target: "/v1/users",
+			Body: "email_address=someone.else@example.com",
+			ExpectedStatusCode: http.StatusUnprocessableEntity,
+			ExpectedCount: 1,
+		},
+		{
+			ID: "def-456",
+			Target: "/v1/users",
+			Body: "email_address=joeblow@example.com",
+			ExpectedStatusCode: http.StatusCreated,
+			ExpectedBody: "count=2",
+			ExpectedCount: 2,
+		},
+		{
+			ID: "",
+			Target: "/v1/users",
+			Body: "email_address=joeblow@example.com",
+			ExpectedStatusCode: http.StatusBadRequest,
+			ExpectedCount: 2,
+		},
+
+
+
+		{
+			ID: "ghi-789",
+			Target: "/v1/users?mode=fail",
+			Body: "email_address=joeblow@example.com",
+			ExpectedStatusCode: http.StatusServiceUnavailable,
+			ExpectedCount: 3,
+		},
+		{
+			ID: "ghi-789",
+			Target: "/v1/users?mode=fail",
+			Body: "email_address=joeblow@example.com",
+			ExpectedStatusCode: http.StatusServiceUnavailable,
+			ExpectedCount: 4,
+		},
+
+
+
+		{
+			ID: "abc-123",
+			Target: "/v1/users",
+			Body: "email_address=joeblow@example.com",
+			Advance: 2 * time.Hour,
+			ExpectedStatusCode: http.StatusCreated,
+			ExpectedBody: "count=5",
+			ExpectedCount: 5,
+		},
+	}
+
+	for testNumber, test := range tests {
+
+		now = now.Add(test.Advance)
+
+		request := httptest.NewRequest(http.MethodPost, test.Target, strings.NewReader(test.Body))
+		request.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+		if "" != test.ID {
+			request.Header.Set("X-Idempotent-ID", test.ID)
+		}
+
+		recorder := httptest.NewRecorder()
+
+		handler.ServeHTTP(recorder, request)
+
+		if expected, actual := test.ExpectedStatusCode, recorder.Code; expected != actual {
+			t.Errorf("For test #%d, expected HTTP status code %d, but actually got %d.", testNumber, expected, actual)
+			t.Logf("BODY: %q", recorder.Body.String())
+			continue
+		}
+		if "" != test.ExpectedBody {
+			if expected, actual := test.ExpectedBody, recorder.Body.String(); expected != actual {
+				t.Errorf("For test #%d, expected HTTP response body %q, but actually got %q.", testNumber, expected, actual)
+				continue
+			}
+		}
+		if expected, actual := test.ExpectedCount, count; expected != actual {
+			t.Errorf("For test #%d, expected the handler to have been called %d times, but actually was called %d times.", testNumber, expected, actual)
+			continue
+		}
+	}
+}
+
+func TestIdempotencyHandlerConcurrent(t *testing.T) {
+
+	for _, wait := range []bool{false, true} {
+
+		var store MemoryIdempotencyStore
+
+		started := make(chan struct{})
+		finish  := make(chan struct{})
+
+		var count int
+
+		handler := IdempotencyHandler{
+			Handler: http.HandlerFunc(func(responseWriter http.ResponseWriter, request *http.Request) {
+				count++
+				close(started)
+				<-finish
+				fmt.Fprint(responseWriter, "done")
+			}),
+			Store: &store,
+			Wait: wait,
+		}
+
+		newRequest := func() *http.Request {
+			request := httptest.NewRequest(http.MethodPost, "/v1/users", strings.NewReader("email_address=joeblow@example.com"))
+			request.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+			request.Header.Set("X-Idempotent-ID", "abc-123")
+			return request
+		}
+
+		var waitGroup sync.WaitGroup
+
+		first := httptest.NewRecorder()
+		waitGroup.Add(1)
+		go func() {
+			defer waitGroup.Done()
+			handler.ServeHTTP(first, newRequest())
+		}()
+
+		<-started
+
+		second := httptest.NewRecorder()
+		switch wait {
+		case false:
+			handler.ServeHTTP(second, newRequest())
+			close(finish)
+		case true:
+			waitGroup.Add(1)
+			go func() {
+				defer waitGroup.Done()
+				handler.ServeHTTP(second, newRequest())
+			}()
+			time.Sleep(2 * idempotencyWaitPollInterval)
+			close(finish)
+		}
+
+		waitGroup.Wait()
+
+		if expected, actual := http.StatusOK, first.Code; expected != actual {
+			t.Errorf("For wait=%t, expected HTTP status code %d for the first HTTP request, but actually got %d.", wait, expected, actual)
+			continue
+		}
+
+		expectedSecond := http.StatusConflict
+		if wait {
+			expectedSecond = http.StatusOK
+		}
+		if expected, actual := expectedSecond, second.Code; expected != actual {
+			t.Errorf("For wait=%t, expected HTTP status code %d for the second HTTP request, but actually got %d.", wait, expected, actual)
+			continue
+		}
+
+		if expected, actual := 1, count; expected != actual {
+			t.Errorf("For wait=%t, expected the handler to have been called %d times, but actually was called %d times.", wait, expected, actual)
+			continue
+		}
+	}
+}
@@ -0,0 +1,52 @@
+package mdl
+
+import (
+	"net/http"
+	"time"
+)
+
+// IdempotencyStore is where ‘mdl.IdempotencyHandler’ records each Idempotent ID, together with the
+// HTTP response that was produced for it.
+//
+// Package mdl comes with 2 implementations of ‘mdl.IdempotencyStore’: ‘mdl.MemoryIdempotencyStore’, and
+// ‘mdl.FileIdempotencyStore’. Other implementations (for example, ones backed by a database) can be plugged in.
+//
+// Implementations must be safe to use from multiple goroutines.
+type IdempotencyStore interface {
+
+	// Reserve atomically records ‘id’ as being in progress — unless there is already an (unexpired)
+	// record for ‘id’, in which case that record is returned with ‘found’ set to true.
+	//
+	// ‘now’ is the current time, and ‘ttl’ is how long after ‘now’ the record expires.
+	// An expired record is treated as if it does not exist.
+	Reserve(id string, fingerprint string, now time.Time, ttl time.Duration) (record IdempotencyRecord, found bool, err error)
+
+	// Complete records the HTTP response produced for ‘id’, which must have already been reserved.
+	Complete(id string, response IdempotencyResponse) error
+
+	// Release removes the record for ‘id’, so that it can be reserved again.
+	Release(id string) error
+}
+
+// IdempotencyRecord is what an ‘mdl.IdempotencyStore’ records for an Idempotent ID.
+type IdempotencyRecord struct {
+
+	// Fingerprint identifies the HTTP request payload that the Idempotent ID was first used with.
+	Fingerprint string
+
+	// Expires is when the record expires.
+	Expires time.Time
+
+	// Completed is false while the HTTP request is still being handled, and true once the HTTP response is recorded.
+	Completed bool
+
+	// Response is the HTTP response that was produced. It is only meaningful if Completed is true.
+	Response IdempotencyResponse
+}
+
+// IdempotencyResponse is a recorded HTTP response.
+type IdempotencyResponse struct {
+	StatusCode int
+	Header     http.Header
+	Body       []byte
+}
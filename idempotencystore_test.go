@@ -0,0 +1,121 @@
+package mdl_test
+
+import (
+	"github.com/reiver/go-mdl"
+
+	"net/http"
+	"reflect"
+	"time"
+
+	"testing"
+)
+
+// testIdempotencyStore checks that an ‘mdl.IdempotencyStore’ behaves the way ‘mdl.IdempotencyHandler’ expects it to.
+func testIdempotencyStore(t *testing.T, store mdl.IdempotencyStore) {
+
+	var now time.Time = time.Date(2015, 5, 7, 10, 25, 9, 0, time.UTC)
+	var ttl time.Duration = time.Hour
+
+	const id string = "z-2015-05-07T10:25:09Z_tleEiguQe67zJFYUa7pngSZT8HX7FMAcHb1Z4yOO2ANtltRPRwF5p9TWwf7m"
+
+	{
+		_, found, err := store.Reserve(id, "fingerprint-1", now, ttl)
+		if nil != err {
+			t.Errorf("Did not expect an error, but actually got one: (%T) %q", err, err)
+			return
+		}
+		if found {
+			t.Errorf("Did not expect the Idempotent ID to be found on the first reserve.")
+			return
+		}
+	}
+
+	{
+		record, found, err := store.Reserve(id, "fingerprint-2", now.Add(time.Minute), ttl)
+		if nil != err {
+			t.Errorf("Did not expect an error, but actually got one: (%T) %q", err, err)
+			return
+		}
+		if !found {
+			t.Errorf("Expected the Idempotent ID to be found on the second reserve.")
+			return
+		}
+		if expected, actual := "fingerprint-1", record.Fingerprint; expected != actual {
+			t.Errorf("Expected fingerprint %q, but actually got %q.", expected, actual)
+			return
+		}
+		if record.Completed {
+			t.Errorf("Did not expect the record to be completed yet.")
+			return
+		}
+	}
+
+	var response = mdl.IdempotencyResponse{
+		StatusCode: http.StatusCreated,
+		Header: http.Header{
+			"Content-Type": []string{"text/plain; charset=utf-8"},
+		},
+		Body: []byte("Hello world!"),
+	}
+
+	if err := store.Complete(id, response); nil != err {
+		t.Errorf("Did not expect an error, but actually got one: (%T) %q", err, err)
+		return
+	}
+
+	{
+		record, found, err := store.Reserve(id, "fingerprint-1", now.Add(time.Minute), ttl)
+		if nil != err {
+			t.Errorf("Did not expect an error, but actually got one: (%T) %q", err, err)
+			return
+		}
+		if !found {
+			t.Errorf("Expected the Idempotent ID to be found after it was completed.")
+			return
+		}
+		if !record.Completed {
+			t.Errorf("Expected the record to be completed.")
+			return
+		}
+		if expected, actual := response, record.Response; !reflect.DeepEqual(expected, actual) {
+			t.Errorf("The recorded response is not what was expected.")
+			t.Logf("EXPECTED: %#v", expected)
+			t.Logf("ACTUAL:   %#v", actual)
+			return
+		}
+	}
+
+	{
+		_, found, err := store.Reserve(id, "fingerprint-3", now.Add(ttl), ttl)
+		if nil != err {
+			t.Errorf("Did not expect an error, but actually got one: (%T) %q", err, err)
+			return
+		}
+		if found {
+			t.Errorf("Did not expect the Idempotent ID to be found after it expired.")
+			return
+		}
+	}
+
+	if err := store.Release(id); nil != err {
+		t.Errorf("Did not expect an error, but actually got one: (%T) %q", err, err)
+		return
+	}
+
+	{
+		_, found, err := store.Reserve(id, "fingerprint-4", now.Add(ttl), ttl)
+		if nil != err {
+			t.Errorf("Did not expect an error, but actually got one: (%T) %q", err, err)
+			return
+		}
+		if found {
+			t.Errorf("Did not expect the Idempotent ID to be found after it was released.")
+			return
+		}
+	}
+
+	if err := store.Complete("never-reserved", response); nil == err {
+		t.Errorf("Expected an error completing an Idempotent ID that was never reserved, but did not actually get one.")
+		return
+	}
+}
@@ -0,0 +1,121 @@
+package mdl
+
+import (
+	"crypto/rand"
+	"io"
+	"time"
+)
+
+const (
+	idempotentIDPrefix       = "z-"
+	idempotentIDTimeLayout   = "2006-01-02T15:04:05Z"
+	idempotentIDSeparator    = '_'
+	idempotentIDRandomLength = 60
+	idempotentIDAlphabet     = "ABCDEFGHIJKLMNOPQRSTUVWXYZabcdefghijklmnopqrstuvwxyz0123456789"
+)
+
+// IdempotentIDGenerator generates Idempotent IDs in the format recommended in the ‘mdl.Instruction’ documentation;
+// i.e., the current time followed by randomness, such as:
+//
+//	z-2015-05-07T10:25:09Z_tleEiguQe67zJFYUa7pngSZT8HX7FMAcHb1Z4yOO2ANtltRPRwF5p9TWwf7m
+//
+// Because the time comes first, the lexical order of the Idempotent IDs is also a time ordering (to the second).
+//
+// The zero value of ‘mdl.IdempotentIDGenerator’ uses the current time, and "crypto/rand" for the randomness.
+//
+// Example
+//
+//	id, err := mdl.IdempotentIDGenerator{}.Generate()
+type IdempotentIDGenerator struct {
+
+	// Now returns the current time. If nil, then time.Now is used.
+	Now func() time.Time
+
+	// Rand is the source of randomness. If nil, then crypto/rand.Reader is used.
+	Rand io.Reader
+}
+
+// Generate returns a new Idempotent ID.
+func (receiver IdempotentIDGenerator) Generate() (string, error) {
+	now := time.Now
+	if nil != receiver.Now {
+		now = receiver.Now
+	}
+
+	var random io.Reader = rand.Reader
+	if nil != receiver.Rand {
+		random = receiver.Rand
+	}
+
+	var buffer []byte = make([]byte, 0, len(idempotentIDPrefix)+len(idempotentIDTimeLayout)+1+idempotentIDRandomLength)
+
+	buffer = append(buffer, idempotentIDPrefix...)
+	buffer = now().UTC().AppendFormat(buffer, idempotentIDTimeLayout)
+	buffer = append(buffer, idempotentIDSeparator)
+
+	// Bytes that are 248 or more are skipped, so that each character of the alphabet is equally likely
+	// (since 248 is the largest multiple of 62 that fits in a byte).
+	const limit = 256 - (256 % len(idempotentIDAlphabet))
+
+	var entropy [idempotentIDRandomLength]byte
+	for remaining := idempotentIDRandomLength; 0 < remaining; {
+		if _, err := io.ReadFull(random, entropy[:remaining]); nil != err {
+			return "", err
+		}
+
+		for _, b := range entropy[:remaining] {
+			if limit <= int(b) {
+				continue
+			}
+			buffer = append(buffer, idempotentIDAlphabet[int(b)%len(idempotentIDAlphabet)])
+			remaining--
+		}
+	}
+
+	return string(buffer), nil
+}
+
+// ParseIdempotentID checks that ‘id’ has the shape of an Idempotent ID generated by ‘mdl.IdempotentIDGenerator’,
+// and returns the time that is part of it.
+//
+// If ‘id’ does not have that shape, then ParseIdempotentID returns an ‘mdl.MalformedIdempotentID’ error.
+//
+// Example
+//
+//	when, err := mdl.ParseIdempotentID("z-2015-05-07T10:25:09Z_tleEiguQe67zJFYUa7pngSZT8HX7FMAcHb1Z4yOO2ANtltRPRwF5p9TWwf7m")
+//	
+//	// when == time.Date(2015, 5, 7, 10, 25, 9, 0, time.UTC)
+func ParseIdempotentID(id string) (time.Time, error) {
+	const timeStart = len(idempotentIDPrefix)
+	const timeEnd = timeStart + len(idempotentIDTimeLayout)
+	const randomStart = timeEnd + 1
+
+	if len(id) != randomStart+idempotentIDRandomLength {
+		return time.Time{}, malformedIdempotentID(id, "wrong length")
+	}
+
+	if idempotentIDPrefix != id[:timeStart] {
+		return time.Time{}, malformedIdempotentID(id, "missing “z-” prefix")
+	}
+
+	when, err := time.Parse(idempotentIDTimeLayout, id[timeStart:timeEnd])
+	if nil != err {
+		return time.Time{}, malformedIdempotentID(id, "bad time")
+	}
+
+	if idempotentIDSeparator != id[timeEnd] {
+		return time.Time{}, malformedIdempotentID(id, "missing “_” separator")
+	}
+
+	for _, r := range id[randomStart:] {
+		switch {
+		case 'A' <= r && r <= 'Z':
+		case 'a' <= r && r <= 'z':
+		case '0' <= r && r <= '9':
+		default:
+			return time.Time{}, malformedIdempotentID(id, "bad character in random part")
+		}
+	}
+
+	return when, nil
+}
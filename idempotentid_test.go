@@ -0,0 +1,166 @@
+package mdl_test
+
+import (
+	"github.com/reiver/go-mdl"
+
+	"bytes"
+	"strings"
+	"time"
+
+	"testing"
+)
+
+func TestIdempotentIDGeneratorGenerate(t *testing.T) {
+
+	tests := []struct{
+		Now      time.Time
+		Entropy  []byte
+		Expected string
+	}{
+		{
+			Now: time.Date(2015, 5, 7, 10, 25, 9, 0, time.UTC),
+			Entropy: bytes.Repeat([]byte{0}, 60),
+			Expected: "z-2015-05-07T10:25:09Z_" + strings.Repeat("A", 60),
+		},
+		{
+			Now: time.Date(2015, 5, 7, 3, 25, 9, 123, time.FixedZone("PDT", -7*60*60)),
+			Entropy: bytes.Repeat([]byte{61}, 60),
+			Expected: "z-2015-05-07T10:25:09Z_" + strings.Repeat("9", 60),
+		},
+		{
+			// Bytes 248 and above are skipped.
+			Now: time.Date(2020, 1, 2, 3, 4, 5, 0, time.UTC),
+			Entropy: append(bytes.Repeat([]byte{255, 26}, 30), bytes.Repeat([]byte{62+27}, 30)...),
+			Expected: "z-2020-01-02T03:04:05Z_" + strings.Repeat("a", 30) + strings.Repeat("b", 30),
+		},
+	}
+
+	for testNumber, test := range tests {
+
+		generator := mdl.IdempotentIDGenerator{
+			Now: func() time.Time {
+				return test.Now
+			},
+			Rand: bytes.NewReader(test.Entropy),
+		}
+
+		actual, err := generator.Generate()
+		if nil != err {
+			t.Errorf("For test #%d, did not expect an error, but actually got one: (%T) %q", testNumber, err, err)
+			continue
+		}
+
+		if expected := test.Expected; expected != actual {
+			t.Errorf("For test #%d, the Idempotent ID that was actually generated was not what was expected.", testNumber)
+			t.Logf("EXPECTED: %q", expected)
+			t.Logf("ACTUAL:   %q", actual)
+			continue
+		}
+	}
+}
+
+func TestIdempotentIDGeneratorGenerateDefault(t *testing.T) {
+
+	before := time.Now().UTC().Truncate(time.Second)
+
+	id, err := mdl.IdempotentIDGenerator{}.Generate()
+	if nil != err {
+		t.Errorf("Did not expect an error, but actually got one: (%T) %q", err, err)
+		return
+	}
+
+	after := time.Now().UTC()
+
+	when, err := mdl.ParseIdempotentID(id)
+	if nil != err {
+		t.Errorf("Did not expect an error, but actually got one: (%T) %q", err, err)
+		t.Logf("ID: %q", id)
+		return
+	}
+
+	if when.Before(before) || when.After(after) {
+		t.Errorf("Expected the time in the Idempotent ID to be between %s and %s, but actually was %s.", before, after, when)
+		return
+	}
+}
+
+func TestParseIdempotentID(t *testing.T) {
+
+	tests := []struct{
+		ID       string
+		Expected time.Time
+	}{
+		{
+			ID: "z-2015-05-07T10:25:09Z_tleEiguQe67zJFYUa7pngSZT8HX7FMAcHb1Z4yOO2ANtltRPRwF5p9TWwf7m",
+			Expected: time.Date(2015, 5, 7, 10, 25, 9, 0, time.UTC),
+		},
+		{
+			ID: "z-2015-05-07T10:27:24Z_cjaLIpvS2MmsLqzPOJIFHwPxqgbdCmi749u9rfAiUu0wZHQ4Z714zSgjumgj",
+			Expected: time.Date(2015, 5, 7, 10, 27, 24, 0, time.UTC),
+		},
+	}
+
+	for testNumber, test := range tests {
+
+		actual, err := mdl.ParseIdempotentID(test.ID)
+		if nil != err {
+			t.Errorf("For test #%d, did not expect an error, but actually got one: (%T) %q", testNumber, err, err)
+			continue
+		}
+
+		if expected := test.Expected; !expected.Equal(actual) {
+			t.Errorf("For test #%d, expected %s, but actually got %s.", testNumber, expected, actual)
+			continue
+		}
+	}
+}
+
+func TestParseIdempotentIDMalformed(t *testing.T) {
+
+	tests := []struct{
+		ID string
+	}{
+		{
+			ID: "",
+		},
+		{
+			ID: "abc-123",
+		},
+		{
+			ID: "y-2015-05-07T10:25:09Z_tleEiguQe67zJFYUa7pngSZT8HX7FMAcHb1Z4yOO2ANtltRPRwF5p9TWwf7m",
+		},
+		{
+			ID: "z-2015-13-07T10:25:09Z_tleEiguQe67zJFYUa7pngSZT8HX7FMAcHb1Z4yOO2ANtltRPRwF5p9TWwf7m",
+		},
+		{
+			ID: "z-2015-05-07 10:25:09Z_tleEiguQe67zJFYUa7pngSZT8HX7FMAcHb1Z4yOO2ANtltRPRwF5p9TWwf7m",
+		},
+		{
+			ID: "z-2015-05-07T10:25:09Z-tleEiguQe67zJFYUa7pngSZT8HX7FMAcHb1Z4yOO2ANtltRPRwF5p9TWwf7m",
+		},
+		{
+			ID: "z-2015-05-07T10:25:09Z_tleEiguQe67zJFYUa7pngSZT8HX7FMAcHb1Z4yOO2ANtltRPRwF5p9TWwf7",
+		},
+		{
+			ID: "z-2015-05-07T10:25:09Z_tleEiguQe67zJFYUa7pngSZT8HX7FMAcHb1Z4yOO2ANtltRPRwF5p9TWwf7m7",
+		},
+		{
+			ID: "z-2015-05-07T10:25:09Z_tleEiguQe67zJFYUa7pngSZT8HX7FMAcHb1Z4yOO2ANtltRPRwF5p9TWw/7m",
+		},
+	}
+
+	for testNumber, test := range tests {
+
+		_, err := mdl.ParseIdempotentID(test.ID)
+		if nil == err {
+			t.Errorf("For test #%d, expected an error, but did not actually get one.", testNumber)
+			t.Logf("ID: %q", test.ID)
+			continue
+		}
+
+		if _, casted := err.(mdl.MalformedIdempotentID); !casted {
+			t.Errorf("For test #%d, expected error of type mdl.MalformedIdempotentID, but actually got (%T) %q.", testNumber, err, err)
+			continue
+		}
+	}
+}
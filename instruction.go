@@ -39,10 +39,21 @@ import (
 //		// ...
 //	
 //		err := instruction.Scan(request)
-//		switch err.(type) {
-//		case mdl.BadRequest:
+//		switch casted := err.(type) {
+//		case nil:
+//			// Nothing here.
+//		case mdl.BadVerb:
+//			http.Error(responseWriter, "Method Not Allowed", casted.StatusCode())
+//			return
+//		case mdl.MissingIdempotentID, mdl.MalformedBody:
 //			http.Error(responseWriter, "Bad Request", http.StatusBadRequest)
 //			return
+//		case mdl.PayloadTooLarge:
+//			http.Error(responseWriter, "Payload Too Large", casted.StatusCode())
+//			return
+//		case mdl.UnsupportedMediaType:
+//			http.Error(responseWriter, "Unsupported Media Type", casted.StatusCode())
+//			return
 //		default:
 //			http.Error(responseWriter, "Internal Server Error", http.StatusInternalServerError)
 //			return
@@ -93,6 +104,9 @@ import (
 //	httpRequest.setRequestHeader('Content-Type', 'application/x-www-form-urlencoded');
 //	httpRequest.send('email_address=joeblow@example.com');
 //
+// If the ‘client’ side is written in Go, then it can use ‘mdl.InstructionEncoder’ (to create the ‘http.Request’),
+// or ‘mdl.InstructionClient’ (to also send it, and retry it if it fails).
+//
 //
 // Idempotent ID
 //
@@ -100,6 +114,8 @@ import (
 //
 // It helps make it so an instruction is idempotent.
 //
+// For instructions received over HTTP, ‘mdl.IdempotencyHandler’ can be used to enforce this.
+//
 //
 // Idempotent ID From HTTP Request
 //
@@ -132,6 +148,8 @@ import (
 // This will make it so lexical order of the Idempotent IDs is often also a time ordering (for cases
 // when the time between 2 IdempotentIDs is not exactly the same).
 //
+// ‘mdl.IdempotentIDGenerator’ generates Idempotent IDs this way, and mdl.ParseIdempotentID() checks them.
+//
 //
 // Verb
 //
@@ -210,11 +228,71 @@ import (
 //	
 //	httpRequest.open("POST", url);
 //	httpRequest.setRequestHeader("X-HTTP-Method-Override", "ADD_EMAIL_ADDRESS")
+//
+//
+// Data From HTTP Request
+//
+// To provide the Data in an http.Request, have the HTTP client send it as the HTTP request body,
+// with the “Content-Type” HTTP header set to one of the supported media types:
+//
+// • “application/x-www-form-urlencoded”,
+//
+// • “application/json”, or
+//
+// • “multipart/form-data”.
+//
+// For example:
+//
+//	POST /v1/user/email HTTP/1.1
+//	Host: api.example.com
+//	X-Idempotent-ID: z-2015-05-07T10:25:09Z_tleEiguQe67zJFYUa7pngSZT8HX7FMAcHb1Z4yOO2ANtltRPRwF5p9TWwf7m
+//	X-HTTP-Method-Override: ADD_EMAIL_ADDRESS
+//	Content-Type: application/json
+//	
+//	{"email_address":"joeblow@email.com"}
+//
+// With “application/x-www-form-urlencoded”, a field that is sent more than once (ex: “tag=a&tag=b”) has every one of
+// its values stored, in order; use .LoadAll() or .FetchAll() on Data to get them all. (.Load() and .Fetch() return the first one.)
+//
+// By default, each form field name becomes an ‘mdl.Key’ with a single token. To have field names such as “address[street]”
+// or “address.street” become mdl.SomeKey("address", "street"), use an ‘mdl.InstructionDecoder’ with its KeySplitter set
+// (to mdl.KeySplitBrackets, mdl.KeySplitDots, or mdl.KeySplitCanonical).
+//
+// A JSON HTTP request body must be a JSON object.
+// Since the values in ‘mdl.KeyValues’ are strings, the JSON values are mapped as follows:
+//
+// • a JSON object is flattened, with each of its names becoming a token of the ‘mdl.Key’;
+// so {"database":{"password":"x"}} is stored under mdl.SomeKey("database", "password"),
+//
+// • a JSON array has each of its elements stored under its (zero-based) index;
+// so {"tags":["a","b"]} is stored under mdl.SomeKey("tags", "0") and mdl.SomeKey("tags", "1"),
+//
+// • a JSON string is stored as is,
+//
+// • a JSON number is stored as it was written in the JSON (ex: "5", "-2.5", "1e3"),
+//
+// • a JSON boolean is stored as "true" or "false", and
+//
+// • a JSON null is not stored at all; so loading its key returns ‘mdl.NoString()’.
+//
+//
+// Attachments From HTTP Request
+//
+// The HTTP request body can also be “multipart/form-data”, which is what web browsers send when an HTML form
+// has an <input type="file"> in it.
+//
+// With “multipart/form-data”, the (non-file) form fields are stored in Data (the same as with
+// “application/x-www-form-urlencoded”), and the files are stored in Attachments.
+//
+// The size of each part is limited to 10 MB, and the size of the whole HTTP request body is limited to 100 MB.
+//
+// Since large attachments are held in temporary files, one should call .RemoveAttachments() when done with them.
 type Instruction struct {
 
 	IdempotentID String
 	Verb String
 	Data KeyValues
+	Attachments []Attachment
 }
 
 // Scan makes ‘mdl.Instruction’ fit the ‘database/sql.Scanner’ interface.
@@ -229,7 +307,8 @@ type Instruction struct {
 //
 // The instruction ‘Idempotent ID’ is given by the value of the “X-Idempotent-ID” header.
 //
-// The instruction ‘data’ is given by the HTTP request body.
+// The instruction ‘data’ (and ‘attachments’) is given by the HTTP request body. The HTTP request body can be
+// “application/x-www-form-urlencoded”, “application/json”, or “multipart/form-data”.
 //
 // Example HTTP Request
 //
@@ -244,6 +323,16 @@ type Instruction struct {
 //		// ...
 //		
 //		err := cmd.Scan(request)
+//
+// Errors
+//
+// When the instruction cannot be received from the HTTP request, the error returned is one of:
+// ‘mdl.BadVerb’, ‘mdl.MissingIdempotentID’, ‘mdl.MalformedBody’, ‘mdl.PayloadTooLarge’, or ‘mdl.UnsupportedMediaType’.
+//
+// Each of these has a .StatusCode() method that returns the HTTP status code for the error, and an .Unwrap() method that returns what caused the error.
+//
+// Calling .Scan() with an HTTP request is a shortcut for using an ‘mdl.InstructionDecoder’ with its default configuration.
+// To use different HTTP request headers, limits, or media types, use ‘mdl.InstructionDecoder’ instead.
 func (receiver *Instruction) Scan(src interface{}) error {
 	if nil == receiver {
 		return errNilReceiver
@@ -251,28 +340,29 @@ func (receiver *Instruction) Scan(src interface{}) error {
 
 	switch casted := src.(type) {
 	case *http.Request:
+		return InstructionDecoder{}.decode(receiver, casted)
+	default:
+		return unsupportedSource(src)
+	}
+}
 
-		// verb
-		verb, ok := inferVerb(casted)
-		if !ok {
-			return errBadVerb
-		}
-		receiver.Verb = SomeString(verb)
+// RemoveAttachments removes any temporary files being used to hold the content of the Attachments.
+//
+// It should be called when one is done with the Attachments.
+func (receiver *Instruction) RemoveAttachments() error {
+	if nil == receiver {
+		return errNilReceiver
+	}
 
-		// id
-		id, ok := inferID(casted)
-		if !ok {
-			return errBadID
-		}
-		receiver.IdempotentID = SomeString(id)
+	var err error
 
-		// data
-		if err := inferData(&receiver.Data, casted); nil != err {
-			return errBadBody
+	for _, attachment := range receiver.Attachments {
+		if e := attachment.remove(); nil != e && nil == err {
+			err = e
 		}
-
-		return nil
-	default:
-		return unsupportedSource(src)
 	}
+
+	receiver.Attachments = nil
+
+	return err
 }
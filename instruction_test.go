@@ -0,0 +1,91 @@
+package mdl_test
+
+import (
+	"github.com/reiver/go-mdl"
+
+	"net/http"
+	"strings"
+
+	"testing"
+)
+
+func TestInstructionScanError(t *testing.T) {
+
+	tests := []struct{
+		HttpRequest *http.Request
+		ExpectedStatusCode int
+	}{
+		{
+			HttpRequest: func()*http.Request{
+				r, err := http.NewRequest(http.MethodPost, "/v1/users", strings.NewReader("email_address=joeblow@example.com"))
+				if nil != err {
+					panic(err)
+				}
+				r.Header.Add("Content-Type", "application/x-www-form-urlencoded")
+
+				return r
+			}(),
+			ExpectedStatusCode: http.StatusBadRequest,
+		},
+		{
+			HttpRequest: func()*http.Request{
+				r, err := http.NewRequest(http.MethodPost, "/v1/users", strings.NewReader("email_address=joeblow@example.com"))
+				if nil != err {
+					panic(err)
+				}
+				r.Header.Add("X-Idempotent-ID", "z-2015-05-07T10:25:09Z_tleEiguQe67zJFYUa7pngSZT8HX7FMAcHb1Z4yOO2ANtltRPRwF5p9TWwf7m")
+				r.Header.Add("Content-Type", "text/plain")
+
+				return r
+			}(),
+			ExpectedStatusCode: http.StatusUnsupportedMediaType,
+		},
+		{
+			HttpRequest: func()*http.Request{
+				r, err := http.NewRequest(http.MethodPost, "/v1/users", strings.NewReader(`{"email_address":`))
+				if nil != err {
+					panic(err)
+				}
+				r.Header.Add("X-Idempotent-ID", "z-2015-05-07T10:25:09Z_tleEiguQe67zJFYUa7pngSZT8HX7FMAcHb1Z4yOO2ANtltRPRwF5p9TWwf7m")
+				r.Header.Add("Content-Type", "application/json")
+
+				return r
+			}(),
+			ExpectedStatusCode: http.StatusBadRequest,
+		},
+	}
+
+	for testNumber, test := range tests {
+
+		var instruction mdl.Instruction
+
+		err := instruction.Scan(test.HttpRequest)
+		if nil == err {
+			t.Errorf("For test #%d, expected an error, but did not actually get one.", testNumber)
+			continue
+		}
+
+		var actualStatusCode int
+		switch casted := err.(type) {
+		case mdl.BadVerb:
+			actualStatusCode = casted.StatusCode()
+		case mdl.MissingIdempotentID:
+			actualStatusCode = casted.StatusCode()
+		case mdl.MalformedBody:
+			actualStatusCode = casted.StatusCode()
+		case mdl.PayloadTooLarge:
+			actualStatusCode = casted.StatusCode()
+		case mdl.UnsupportedMediaType:
+			actualStatusCode = casted.StatusCode()
+		default:
+			t.Errorf("For test #%d, did not expect error of type %T: %q", testNumber, err, err)
+			continue
+		}
+
+		if expected, actual := test.ExpectedStatusCode, actualStatusCode; expected != actual {
+			t.Errorf("For test #%d, expected HTTP status code %d, but actually got %d.", testNumber, expected, actual)
+			t.Logf("ERROR: (%T) %q", err, err)
+			continue
+		}
+	}
+}
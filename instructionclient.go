@@ -0,0 +1,126 @@
+package mdl
+
+import (
+	"context"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"time"
+)
+
+const (
+	defaultInstructionClientMaxAttempts int           = 3
+	defaultInstructionClientBackoff     time.Duration = 100 * time.Millisecond
+)
+
+// InstructionClient sends instructions over HTTP, retrying the ones that fail.
+//
+// Each retry of an instruction is sent with the same ‘Idempotent ID’, so that (with a server that
+// uses ‘mdl.IdempotencyHandler’, or similar) the instruction is still executed at most one time.
+//
+// An instruction is retried when sending it fails, or when the server responds with
+// “409 Conflict”, “429 Too Many Requests”, or any “5xx” HTTP status code.
+//
+// Example
+//
+//	var client mdl.InstructionClient
+//	
+//	// ...
+//	
+//	response, err := client.Send(ctx, "https://api.example.com/v1/users", &instruction)
+type InstructionClient struct {
+
+	// HTTPClient is used to send the HTTP requests. If nil, then http.DefaultClient is used.
+	HTTPClient *http.Client
+
+	// Encoder is used to encode the instruction into an HTTP request.
+	Encoder InstructionEncoder
+
+	// MaxAttempts is the maximum number of times an instruction is sent. If zero, then 3 is used.
+	MaxAttempts int
+
+	// Backoff is how long to wait before the first retry. It doubles for each retry after that.
+	// If zero, then 100 milliseconds is used.
+	Backoff time.Duration
+}
+
+// Send sends the instruction to ‘url’.
+//
+// If the instruction does not have an ‘Idempotent ID’, then one is generated (using ‘mdl.IdempotentIDGenerator’) and
+// set on the instruction before it is sent; so that, if the caller later sends the instruction again, it will have the same ‘Idempotent ID’.
+//
+// The HTTP response from the last attempt is returned. As with http.Client.Do(), the caller must close its body.
+func (receiver *InstructionClient) Send(ctx context.Context, url string, instruction *Instruction) (*http.Response, error) {
+	if nil == receiver {
+		return nil, errNilReceiver
+	}
+	if nil == instruction {
+		return nil, errNilInstruction
+	}
+	if nil == ctx {
+		ctx = context.Background()
+	}
+
+	if NoString() == instruction.IdempotentID {
+		id, err := IdempotentIDGenerator{}.Generate()
+		if nil != err {
+			return nil, err
+		}
+		instruction.IdempotentID = SomeString(id)
+	}
+
+	var httpClient *http.Client = receiver.HTTPClient
+	if nil == httpClient {
+		httpClient = http.DefaultClient
+	}
+
+	maxAttempts := receiver.MaxAttempts
+	if 0 >= maxAttempts {
+		maxAttempts = defaultInstructionClientMaxAttempts
+	}
+
+	backoff := receiver.Backoff
+	if 0 >= backoff {
+		backoff = defaultInstructionClientBackoff
+	}
+
+	for attempt := 1; ; attempt++ {
+		request, err := receiver.Encoder.Encode(url, instruction)
+		if nil != err {
+			return nil, err
+		}
+
+		response, err := httpClient.Do(request.WithContext(ctx))
+
+		if attempt >= maxAttempts || !instructionClientShouldRetry(response, err) {
+			return response, err
+		}
+
+		if nil != response {
+			io.Copy(ioutil.Discard, response.Body)
+			response.Body.Close()
+		}
+
+		select {
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		case <-time.After(backoff):
+		}
+		backoff *= 2
+	}
+}
+
+func instructionClientShouldRetry(response *http.Response, err error) bool {
+	if nil != err {
+		return true
+	}
+
+	switch code := response.StatusCode; {
+	case http.StatusConflict == code, http.StatusTooManyRequests == code:
+		return true
+	case 500 <= code:
+		return true
+	default:
+		return false
+	}
+}
@@ -0,0 +1,123 @@
+package mdl_test
+
+import (
+	"github.com/reiver/go-mdl"
+
+	"context"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"time"
+
+	"testing"
+)
+
+func TestInstructionClientSend(t *testing.T) {
+
+	var mutex sync.Mutex
+	var ids []string
+
+	server := httptest.NewServer(http.HandlerFunc(func(responseWriter http.ResponseWriter, request *http.Request) {
+		mutex.Lock()
+		ids = append(ids, request.Header.Get("X-Idempotent-ID"))
+		attempt := len(ids)
+		mutex.Unlock()
+
+		if attempt < 3 {
+			http.Error(responseWriter, "Service Unavailable", http.StatusServiceUnavailable)
+			return
+		}
+
+		responseWriter.Write([]byte("ok"))
+	}))
+	defer server.Close()
+
+	client := mdl.InstructionClient{
+		Backoff: time.Millisecond,
+	}
+
+	var instruction mdl.Instruction
+	instruction.Verb = mdl.SomeString("RECORD_EMAIL")
+	instruction.Data.ShallowStore("email_address", "joeblow@example.com")
+
+	response, err := client.Send(context.Background(), server.URL, &instruction)
+	if nil != err {
+		t.Errorf("Did not expect an error, but actually got one: (%T) %q", err, err)
+		return
+	}
+	defer response.Body.Close()
+
+	if expected, actual := http.StatusOK, response.StatusCode; expected != actual {
+		t.Errorf("Expected HTTP status code %d, but actually got %d.", expected, actual)
+		return
+	}
+
+	body, _ := ioutil.ReadAll(response.Body)
+	if expected, actual := "ok", string(body); expected != actual {
+		t.Errorf("Expected HTTP response body %q, but actually got %q.", expected, actual)
+		return
+	}
+
+	if expected, actual := 3, len(ids); expected != actual {
+		t.Errorf("Expected %d attempts, but actually got %d.", expected, actual)
+		return
+	}
+
+	id, err := instruction.IdempotentID.String()
+	if nil != err {
+		t.Errorf("Expected an idempotent ID to have been generated, but one was not: (%T) %q", err, err)
+		return
+	}
+	if _, err := mdl.ParseIdempotentID(id); nil != err {
+		t.Errorf("Did not expect an error, but actually got one: (%T) %q", err, err)
+		return
+	}
+
+	for i, actual := range ids {
+		if expected := id; expected != actual {
+			t.Errorf("For attempt #%d, expected idempotent ID %q, but actually got %q.", i, expected, actual)
+		}
+	}
+}
+
+func TestInstructionClientSendGivesUp(t *testing.T) {
+
+	var mutex sync.Mutex
+	var attempts int
+
+	server := httptest.NewServer(http.HandlerFunc(func(responseWriter http.ResponseWriter, request *http.Request) {
+		mutex.Lock()
+		attempts++
+		mutex.Unlock()
+
+		http.Error(responseWriter, "Conflict", http.StatusConflict)
+	}))
+	defer server.Close()
+
+	client := mdl.InstructionClient{
+		MaxAttempts: 2,
+		Backoff: time.Millisecond,
+	}
+
+	var instruction mdl.Instruction
+	instruction.IdempotentID = mdl.SomeString("abc-123")
+	instruction.Verb = mdl.SomeString("RECORD_EMAIL")
+
+	response, err := client.Send(context.Background(), server.URL, &instruction)
+	if nil != err {
+		t.Errorf("Did not expect an error, but actually got one: (%T) %q", err, err)
+		return
+	}
+	defer response.Body.Close()
+
+	if expected, actual := http.StatusConflict, response.StatusCode; expected != actual {
+		t.Errorf("Expected HTTP status code %d, but actually got %d.", expected, actual)
+		return
+	}
+
+	if expected, actual := 2, attempts; expected != actual {
+		t.Errorf("Expected %d attempts, but actually got %d.", expected, actual)
+		return
+	}
+}
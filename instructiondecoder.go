@@ -0,0 +1,182 @@
+package mdl
+
+import (
+	"net/http"
+)
+
+const (
+	defaultBodyLimit            int64  = 100 << 20 // 100 MB
+	defaultIdempotentIDHeader   string = "X-Idempotent-ID"
+	defaultMethodOverrideHeader string = "X-HTTP-Method-Override"
+	defaultMultipartPartLimit   int64  = 10 << 20 // 10 MB
+)
+
+var defaultMediaTypes = []string{
+	"application/x-www-form-urlencoded",
+	"application/json",
+	"multipart/form-data",
+}
+
+// InstructionDecoder decodes an ‘mdl.Instruction’ from an HTTP request, using the conventions it is configured with.
+//
+// The zero value of ‘mdl.InstructionDecoder’ uses the same conventions as mdl.Instruction.Scan() does.
+// Any field left as its zero value uses the default for that field.
+//
+// Example
+//
+// Here is an example of using a different HTTP request header for the ‘Idempotent ID’, and a smaller limit
+// on the size of the HTTP request body:
+//
+//	var decoder = mdl.InstructionDecoder{
+//		IdempotentIDHeader: "Idempotency-Key",
+//		BodyLimit: 1 << 20, // 1 MB
+//	}
+//	
+//	// ...
+//	
+//	func (receiver *MyHandler) ServeHTTP(responseWriter ResponseWriter, request *Request) {
+//	
+//		// ...
+//	
+//		instruction, err := decoder.Decode(request)
+type InstructionDecoder struct {
+
+	// IdempotentIDHeader is the name of the HTTP request header the ‘Idempotent ID’ is received from.
+	//
+	// If empty, then “X-Idempotent-ID” is used.
+	IdempotentIDHeader string
+
+	// MethodOverrideHeader is the name of the HTTP request header that can override the HTTP request method
+	// (for “POST” and “PATCH” HTTP requests) as the ‘Verb’.
+	//
+	// If empty, then “X-HTTP-Method-Override” is used.
+	MethodOverrideHeader string
+
+	// BodyLimit is the maximum size, in bytes, of the HTTP request body.
+	//
+	// If zero, then 100 MB is used.
+	BodyLimit int64
+
+	// MultipartPartLimit is the maximum size, in bytes, of any one part of a “multipart/form-data” HTTP request body.
+	//
+	// If zero, then 10 MB is used.
+	MultipartPartLimit int64
+
+	// MediaTypes are the media types of HTTP request body that are accepted.
+	//
+	// If nil, then all the media types that are supported are accepted; i.e.,
+	// “application/x-www-form-urlencoded”, “application/json”, and “multipart/form-data”.
+	//
+	// Media types which are not supported are never accepted, even if they are in MediaTypes.
+	MediaTypes []string
+
+	// KeySplitter turns the field names of “application/x-www-form-urlencoded” and “multipart/form-data”
+	// HTTP request bodies into keys. (Ex: mdl.KeySplitBrackets turns “address[street]” into mdl.SomeKey("address", "street").)
+	//
+	// If nil, then mdl.KeySplitShallow is used; i.e., each field name becomes a key with a single token.
+	KeySplitter KeySplitter
+}
+
+// Decode returns the ‘mdl.Instruction’ received in the HTTP request.
+//
+// When the instruction cannot be received from the HTTP request, the error returned is one of:
+// ‘mdl.BadVerb’, ‘mdl.MissingIdempotentID’, ‘mdl.MalformedBody’, ‘mdl.PayloadTooLarge’, or ‘mdl.UnsupportedMediaType’.
+//
+// Decode returns a *mdl.Instruction rather than an ‘mdl.Instruction’, because its Data is an ‘mdl.KeyValues’, which holds a
+// sync.RWMutex. Returning (or otherwise copying) an ‘mdl.Instruction’ by value would copy that lock; which “go vet” reports,
+// and which is not safe. So an ‘mdl.Instruction’ should always be passed around by pointer.
+func (receiver InstructionDecoder) Decode(request *http.Request) (*Instruction, error) {
+	var instruction Instruction
+
+	if err := receiver.decode(&instruction, request); nil != err {
+		return nil, err
+	}
+
+	return &instruction, nil
+}
+
+func (receiver InstructionDecoder) decode(instruction *Instruction, request *http.Request) error {
+	if nil == instruction {
+		return errNilReceiver
+	}
+
+	if nil == request {
+		return errNilHttpRequest
+	}
+
+	// verb
+	verb, ok := inferVerb(request, receiver.methodOverrideHeader())
+	if !ok {
+		return badVerb(errNoVerb)
+	}
+	instruction.Verb = SomeString(verb)
+
+	// id
+	id, ok := inferID(request, receiver.idempotentIDHeader())
+	if !ok {
+		return missingIdempotentID(errNoIdempotentID)
+	}
+	instruction.IdempotentID = SomeString(id)
+
+	// data
+	if err := receiver.inferData(&instruction.Data, &instruction.Attachments, request); nil != err {
+		return err
+	}
+
+	return nil
+}
+
+func (receiver InstructionDecoder) accepts(mediaType string) bool {
+	mediaTypes := receiver.MediaTypes
+	if nil == mediaTypes {
+		mediaTypes = defaultMediaTypes
+	}
+
+	for _, accepted := range mediaTypes {
+		if accepted == mediaType {
+			return true
+		}
+	}
+
+	return false
+}
+
+func (receiver InstructionDecoder) bodyLimit() int64 {
+	if 0 >= receiver.BodyLimit {
+		return defaultBodyLimit
+	}
+
+	return receiver.BodyLimit
+}
+
+func (receiver InstructionDecoder) idempotentIDHeader() string {
+	if "" == receiver.IdempotentIDHeader {
+		return defaultIdempotentIDHeader
+	}
+
+	return receiver.IdempotentIDHeader
+}
+
+func (receiver InstructionDecoder) keySplitter() KeySplitter {
+	if nil == receiver.KeySplitter {
+		return KeySplitShallow
+	}
+
+	return receiver.KeySplitter
+}
+
+func (receiver InstructionDecoder) methodOverrideHeader() string {
+	if "" == receiver.MethodOverrideHeader {
+		return defaultMethodOverrideHeader
+	}
+
+	return receiver.MethodOverrideHeader
+}
+
+func (receiver InstructionDecoder) multipartPartLimit() int64 {
+	if 0 >= receiver.MultipartPartLimit {
+		return defaultMultipartPartLimit
+	}
+
+	return receiver.MultipartPartLimit
+}
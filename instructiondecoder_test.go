@@ -0,0 +1,182 @@
+package mdl_test
+
+import (
+	"github.com/reiver/go-mdl"
+
+	"net/http"
+	"strings"
+
+	"testing"
+)
+
+func TestInstructionDecoderDecode(t *testing.T) {
+
+	decoder := mdl.InstructionDecoder{
+		IdempotentIDHeader: "Idempotency-Key",
+		MethodOverrideHeader: "X-Verb",
+		BodyLimit: 64,
+		MediaTypes: []string{"application/json"},
+	}
+
+	tests := []struct{
+		Method  string
+		Header  map[string]string
+		Body    string
+		ExpectedVerb string
+		ExpectedID   string
+		ExpectedData map[string]string
+		ExpectedStatusCode int
+	}{
+		{
+			Method: http.MethodPost,
+			Header: map[string]string{
+				"Idempotency-Key": "abc-123",
+				"X-Verb": "RECORD_EMAIL",
+				"Content-Type": "application/json",
+			},
+			Body: `{"email_address":"joeblow@example.com"}`,
+			ExpectedVerb: "RECORD_EMAIL",
+			ExpectedID: "abc-123",
+			ExpectedData: map[string]string{
+				"email_address": "joeblow@example.com",
+			},
+		},
+
+
+
+		{
+			Method: http.MethodPost,
+			Header: map[string]string{
+				"X-Idempotent-ID": "abc-123",
+				"Content-Type": "application/json",
+			},
+			Body: `{"email_address":"joeblow@example.com"}`,
+			ExpectedStatusCode: http.StatusBadRequest,
+		},
+		{
+			Method: http.MethodPost,
+			Header: map[string]string{
+				"Idempotency-Key": "abc-123",
+				"Content-Type": "application/x-www-form-urlencoded",
+			},
+			Body: `email_address=joeblow@example.com`,
+			ExpectedStatusCode: http.StatusUnsupportedMediaType,
+		},
+		{
+			Method: http.MethodPost,
+			Header: map[string]string{
+				"Idempotency-Key": "abc-123",
+				"Content-Type": "application/json",
+			},
+			Body: `{"email_address":"joeblow@example.com","given_name":"Joe","family_name":"Blow"}`,
+			ExpectedStatusCode: http.StatusRequestEntityTooLarge,
+		},
+	}
+
+	for testNumber, test := range tests {
+
+		request, err := http.NewRequest(test.Method, "/v1/users", strings.NewReader(test.Body))
+		if nil != err {
+			t.Errorf("For test #%d, did not expect an error, but actually got one: (%T) %q", testNumber, err, err)
+			continue
+		}
+		for name, value := range test.Header {
+			request.Header.Set(name, value)
+		}
+
+		instruction, err := decoder.Decode(request)
+
+		if 0 != test.ExpectedStatusCode {
+			statusCoder, casted := err.(interface{StatusCode()int})
+			if !casted {
+				t.Errorf("For test #%d, expected an error with a status code, but actually got: (%T) %v", testNumber, err, err)
+				continue
+			}
+
+			if expected, actual := test.ExpectedStatusCode, statusCoder.StatusCode(); expected != actual {
+				t.Errorf("For test #%d, expected HTTP status code %d, but actually got %d.", testNumber, expected, actual)
+				t.Logf("ERROR: (%T) %q", err, err)
+				continue
+			}
+			continue
+		}
+
+		if nil != err {
+			t.Errorf("For test #%d, did not expect an error, but actually got one: (%T) %q", testNumber, err, err)
+			continue
+		}
+
+		if expected, actual := mdl.SomeString(test.ExpectedVerb), instruction.Verb; expected != actual {
+			t.Errorf("For test #%d, expected verb %#v, but actually got %#v.", testNumber, expected, actual)
+			continue
+		}
+		if expected, actual := mdl.SomeString(test.ExpectedID), instruction.IdempotentID; expected != actual {
+			t.Errorf("For test #%d, expected idempotent ID %#v, but actually got %#v.", testNumber, expected, actual)
+			continue
+		}
+		if expected, actual := len(test.ExpectedData), instruction.Data.Len(); expected != actual {
+			t.Errorf("For test #%d, expected %d key-values, but actually got %d.", testNumber, expected, actual)
+			continue
+		}
+		for key, value := range test.ExpectedData {
+			if expected, actual := mdl.SomeString(value), instruction.Data.Fetch(key); expected != actual {
+				t.Errorf("For test #%d, expected value %#v for key %q, but actually got %#v.", testNumber, expected, key, actual)
+			}
+		}
+	}
+}
+
+func TestInstructionDecoderDecodeKeySplitter(t *testing.T) {
+
+	decoder := mdl.InstructionDecoder{
+		KeySplitter: mdl.KeySplitBrackets,
+	}
+
+	var body string = "address[street]=123+Main+St&address[city]=Vancouver&tags[]=a&tags[]=b&name=joe"
+
+	request, err := http.NewRequest("RECORD_ADDRESS", "/v1/users", strings.NewReader(body))
+	if nil != err {
+		t.Errorf("Did not expect an error, but actually got one: (%T) %q", err, err)
+		return
+	}
+	request.Header.Set("X-Idempotent-ID", "abc-123")
+	request.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+
+	instruction, err := decoder.Decode(request)
+	if nil != err {
+		t.Errorf("Did not expect an error, but actually got one: (%T) %q", err, err)
+		return
+	}
+
+	var expected mdl.KeyValues
+	expected.Store(mdl.SomeKey("address", "street"), "123 Main St")
+	expected.Store(mdl.SomeKey("address", "city"), "Vancouver")
+	expected.Append(mdl.SomeKey("tags"), "a")
+	expected.Append(mdl.SomeKey("tags"), "b")
+	expected.Store(mdl.SomeKey("name"), "joe")
+
+	if expected, actual := expected.CanonicalForm(), instruction.Data.CanonicalForm(); expected != actual {
+		t.Errorf("The data that was actually decoded was not what was expected.")
+		t.Logf("EXPECTED:...")
+		t.Log(expected)
+		t.Logf("ACTUAL:...")
+		t.Log(actual)
+		return
+	}
+
+	{
+		request, err := http.NewRequest("RECORD_ADDRESS", "/v1/users", strings.NewReader("address[street=123+Main+St"))
+		if nil != err {
+			t.Errorf("Did not expect an error, but actually got one: (%T) %q", err, err)
+			return
+		}
+		request.Header.Set("X-Idempotent-ID", "abc-123")
+		request.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+
+		_, err = decoder.Decode(request)
+		if _, casted := err.(mdl.MalformedBody); !casted {
+			t.Errorf("Expected a mdl.MalformedBody error, but actually got: (%T) %v", err, err)
+			return
+		}
+	}
+}
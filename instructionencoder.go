@@ -0,0 +1,208 @@
+package mdl
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+)
+
+// InstructionEncoder encodes an ‘mdl.Instruction’ into an HTTP request, using the same conventions
+// that ‘mdl.InstructionDecoder’ decodes with.
+//
+// I.e., it is the ‘client’ side counterpart of ‘mdl.InstructionDecoder’.
+//
+// The zero value of ‘mdl.InstructionEncoder’ uses the same conventions as mdl.Instruction.Scan() does,
+// sends the ‘Verb’ as the HTTP request method, and sends the ‘Data’ as “application/x-www-form-urlencoded”.
+//
+// Example
+//
+//	var instruction mdl.Instruction
+//	
+//	instruction.IdempotentID = mdl.SomeString("z-2015-05-07T10:25:09Z_tleEiguQe67zJFYUa7pngSZT8HX7FMAcHb1Z4yOO2ANtltRPRwF5p9TWwf7m")
+//	instruction.Verb = mdl.SomeString("RECORD_EMAIL")
+//	instruction.Data.ShallowStore("email_address", "joeblow@example.com")
+//	
+//	request, err := mdl.InstructionEncoder{}.Encode("https://api.example.com/v1/users", &instruction)
+type InstructionEncoder struct {
+
+	// IdempotentIDHeader is the name of the HTTP request header the ‘Idempotent ID’ is sent in.
+	//
+	// If empty, then “X-Idempotent-ID” is used.
+	IdempotentIDHeader string
+
+	// MethodOverrideHeader is the name of the HTTP request header used to send the ‘Verb’, when UseMethodOverride is true.
+	//
+	// If empty, then “X-HTTP-Method-Override” is used.
+	MethodOverrideHeader string
+
+	// UseMethodOverride controls how a ‘Verb’ that is not a regular HTTP request method is sent.
+	//
+	// If false, then the ‘Verb’ is sent as the HTTP request method.
+	// If true, then the HTTP request method is “POST”, and the ‘Verb’ is sent in the MethodOverrideHeader HTTP request header.
+	// (This is useful when something between the client and the server does not allow non-regular HTTP request methods.)
+	UseMethodOverride bool
+
+	// MediaType is the media type the ‘Data’ is sent as; either “application/x-www-form-urlencoded”, or “application/json”.
+	//
+	// If empty, then “application/x-www-form-urlencoded” is used.
+	//
+	// With “application/x-www-form-urlencoded”, each key must have exactly one token, and a key with more than one value is sent once for each value.
+	// With “application/json”, keys with more than one token are sent as nested JSON objects, all values are sent as JSON strings,
+	// and a key with more than one value cannot be sent.
+	MediaType string
+}
+
+// Encode returns an HTTP request for the ‘mdl.Instruction’, to be sent to ‘url’.
+func (receiver InstructionEncoder) Encode(url string, instruction *Instruction) (*http.Request, error) {
+	if nil == instruction {
+		return nil, errNilInstruction
+	}
+
+	verb, err := instruction.Verb.String()
+	if nil != err || "" == verb {
+		return nil, errInstructionNoVerb
+	}
+
+	id, err := instruction.IdempotentID.String()
+	if nil != err || "" == id {
+		return nil, errInstructionNoIdempotentID
+	}
+
+	var mediaType string = receiver.MediaType
+	if "" == mediaType {
+		mediaType = "application/x-www-form-urlencoded"
+	}
+
+	var body []byte
+	switch mediaType {
+	case "application/x-www-form-urlencoded":
+		body, err = encodeDataURLEncoded(&instruction.Data)
+	case "application/json":
+		body, err = encodeDataJSON(&instruction.Data)
+	default:
+		return nil, fmt.Errorf("mdl: cannot encode data as %q", mediaType)
+	}
+	if nil != err {
+		return nil, err
+	}
+
+	var method string = verb
+	if receiver.UseMethodOverride && !isHTTPMethod(verb) {
+		method = http.MethodPost
+	}
+
+	request, err := http.NewRequest(method, url, bytes.NewReader(body))
+	if nil != err {
+		return nil, err
+	}
+
+	request.Header.Set(receiver.idempotentIDHeader(), id)
+	if method != verb {
+		request.Header.Set(receiver.methodOverrideHeader(), verb)
+	}
+	request.Header.Set("Content-Type", mediaType)
+
+	return request, nil
+}
+
+func (receiver InstructionEncoder) idempotentIDHeader() string {
+	if "" == receiver.IdempotentIDHeader {
+		return defaultIdempotentIDHeader
+	}
+
+	return receiver.IdempotentIDHeader
+}
+
+func (receiver InstructionEncoder) methodOverrideHeader() string {
+	if "" == receiver.MethodOverrideHeader {
+		return defaultMethodOverrideHeader
+	}
+
+	return receiver.MethodOverrideHeader
+}
+
+func encodeDataURLEncoded(keyvalues *KeyValues) ([]byte, error) {
+	var values url.Values = url.Values{}
+
+	var err error
+	keyvalues.For(func(key Key, value string) {
+		if nil != err {
+			return
+		}
+
+		tokens := key.ElseUnwrap()
+		if 1 != len(tokens) {
+			err = fmt.Errorf("mdl: key %#v does not have exactly one token, so cannot be sent as “application/x-www-form-urlencoded”", key)
+			return
+		}
+
+		values.Add(tokens[0], value)
+	})
+	if nil != err {
+		return nil, err
+	}
+
+	return []byte(values.Encode()), nil
+}
+
+func encodeDataJSON(keyvalues *KeyValues) ([]byte, error) {
+	var object map[string]interface{} = map[string]interface{}{}
+	var seen map[Key]struct{} = map[Key]struct{}{}
+
+	var err error
+	keyvalues.For(func(key Key, value string) {
+		if nil != err {
+			return
+		}
+
+		if _, found := seen[key]; found {
+			err = fmt.Errorf("mdl: key %#v has more than one value, so cannot be sent as “application/json”", key)
+			return
+		}
+		seen[key] = struct{}{}
+
+		tokens := key.ElseUnwrap()
+		if 0 == len(tokens) {
+			return
+		}
+
+		var current map[string]interface{} = object
+		for _, token := range tokens[:len(tokens)-1] {
+			switch casted := current[token].(type) {
+			case nil:
+				next := map[string]interface{}{}
+				current[token] = next
+				current = next
+			case map[string]interface{}:
+				current = casted
+			default:
+				err = fmt.Errorf("mdl: key %#v conflicts with another key, so cannot be sent as “application/json”", key)
+				return
+			}
+		}
+
+		last := tokens[len(tokens)-1]
+		if _, found := current[last]; found {
+			err = fmt.Errorf("mdl: key %#v conflicts with another key, so cannot be sent as “application/json”", key)
+			return
+		}
+		current[last] = value
+	})
+	if nil != err {
+		return nil, err
+	}
+
+	return json.Marshal(object)
+}
+
+func isHTTPMethod(method string) bool {
+	switch method {
+	case http.MethodGet, http.MethodHead, http.MethodPost, http.MethodPut, http.MethodPatch,
+		http.MethodDelete, http.MethodConnect, http.MethodOptions, http.MethodTrace:
+		return true
+	default:
+		return false
+	}
+}
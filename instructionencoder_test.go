@@ -0,0 +1,181 @@
+package mdl_test
+
+import (
+	"github.com/reiver/go-mdl"
+
+	"net/http"
+
+	"testing"
+)
+
+func TestInstructionEncoderEncode(t *testing.T) {
+
+	tests := []struct{
+		Encoder mdl.InstructionEncoder
+		Verb    string
+		Data    map[string][]string
+		ExpectedMethod   string
+		ExpectedOverride string
+		ExpectedContentType string
+	}{
+		{
+			Encoder: mdl.InstructionEncoder{},
+			Verb: "RECORD_EMAIL",
+			Data: map[string][]string{
+				"joeblow@example.com": []string{"email_address"},
+			},
+			ExpectedMethod: "RECORD_EMAIL",
+			ExpectedContentType: "application/x-www-form-urlencoded",
+		},
+		{
+			Encoder: mdl.InstructionEncoder{
+				UseMethodOverride: true,
+			},
+			Verb: "RECORD_EMAIL",
+			Data: map[string][]string{
+				"joeblow@example.com": []string{"email_address"},
+			},
+			ExpectedMethod: http.MethodPost,
+			ExpectedOverride: "RECORD_EMAIL",
+			ExpectedContentType: "application/x-www-form-urlencoded",
+		},
+		{
+			Encoder: mdl.InstructionEncoder{},
+			Verb: "TAG_POST",
+			Data: map[string][]string{
+				"apple":  []string{"tag"},
+				"banana": []string{"tag"},
+				"cherry": []string{"tag"},
+				"123":    []string{"post_id"},
+			},
+			ExpectedMethod: "TAG_POST",
+			ExpectedContentType: "application/x-www-form-urlencoded",
+		},
+		{
+			Encoder: mdl.InstructionEncoder{
+				UseMethodOverride: true,
+				MediaType: "application/json",
+			},
+			Verb: http.MethodPatch,
+			Data: map[string][]string{
+				"joeblow":     []string{"database", "username"},
+				"password123": []string{"database", "password"},
+				"5432":        []string{"database", "port"},
+				"i/o":         []string{"a/b"},
+			},
+			ExpectedMethod: http.MethodPatch,
+			ExpectedContentType: "application/json",
+		},
+	}
+
+	for testNumber, test := range tests {
+
+		var instruction mdl.Instruction
+		instruction.IdempotentID = mdl.SomeString("z-2015-05-07T10:25:09Z_tleEiguQe67zJFYUa7pngSZT8HX7FMAcHb1Z4yOO2ANtltRPRwF5p9TWwf7m")
+		instruction.Verb = mdl.SomeString(test.Verb)
+		for value, key := range test.Data {
+			if err := instruction.Data.Append(mdl.SomeKey(key...), value); nil != err {
+				t.Errorf("For test #%d, did not expect an error, but actually got one: (%T) %q", testNumber, err, err)
+				continue
+			}
+		}
+
+		request, err := test.Encoder.Encode("https://api.example.com/v1/users", &instruction)
+		if nil != err {
+			t.Errorf("For test #%d, did not expect an error, but actually got one: (%T) %q", testNumber, err, err)
+			continue
+		}
+
+		if expected, actual := test.ExpectedMethod, request.Method; expected != actual {
+			t.Errorf("For test #%d, expected HTTP request method %q, but actually got %q.", testNumber, expected, actual)
+			continue
+		}
+		if expected, actual := test.ExpectedOverride, request.Header.Get("X-HTTP-Method-Override"); expected != actual {
+			t.Errorf("For test #%d, expected “X-HTTP-Method-Override” %q, but actually got %q.", testNumber, expected, actual)
+			continue
+		}
+		if expected, actual := test.ExpectedContentType, request.Header.Get("Content-Type"); expected != actual {
+			t.Errorf("For test #%d, expected “Content-Type” %q, but actually got %q.", testNumber, expected, actual)
+			continue
+		}
+
+		var decoded mdl.Instruction
+		if err := decoded.Scan(request); nil != err {
+			t.Errorf("For test #%d, did not expect an error, but actually got one: (%T) %q", testNumber, err, err)
+			continue
+		}
+
+		if expected, actual := instruction.IdempotentID, decoded.IdempotentID; expected != actual {
+			t.Errorf("For test #%d, expected idempotent ID %#v, but actually got %#v.", testNumber, expected, actual)
+			continue
+		}
+		if expected, actual := instruction.Verb, decoded.Verb; expected != actual {
+			t.Errorf("For test #%d, expected verb %#v, but actually got %#v.", testNumber, expected, actual)
+			continue
+		}
+		if expected, actual := instruction.Data.CanonicalForm(), decoded.Data.CanonicalForm(); expected != actual {
+			t.Errorf("For test #%d, the data that was actually decoded was not what was expected.", testNumber)
+			t.Logf("EXPECTED:...")
+			t.Log(expected)
+			t.Logf("ACTUAL:...")
+			t.Log(actual)
+			continue
+		}
+	}
+}
+
+func TestInstructionEncoderEncodeError(t *testing.T) {
+
+	{
+		var instruction mdl.Instruction
+		instruction.IdempotentID = mdl.SomeString("abc-123")
+
+		if _, err := (mdl.InstructionEncoder{}).Encode("https://api.example.com/v1/users", &instruction); nil == err {
+			t.Errorf("Expected an error for an instruction without a verb, but did not actually get one.")
+		}
+	}
+
+	{
+		var instruction mdl.Instruction
+		instruction.Verb = mdl.SomeString("RECORD_EMAIL")
+
+		if _, err := (mdl.InstructionEncoder{}).Encode("https://api.example.com/v1/users", &instruction); nil == err {
+			t.Errorf("Expected an error for an instruction without an idempotent ID, but did not actually get one.")
+		}
+	}
+
+	{
+		var instruction mdl.Instruction
+		instruction.IdempotentID = mdl.SomeString("abc-123")
+		instruction.Verb = mdl.SomeString("CONFIGURE")
+		instruction.Data.Store(mdl.SomeKey("database", "password"), "password123")
+
+		if _, err := (mdl.InstructionEncoder{}).Encode("https://api.example.com/v1/config", &instruction); nil == err {
+			t.Errorf("Expected an error for a multi-token key sent as “application/x-www-form-urlencoded”, but did not actually get one.")
+		}
+	}
+
+	{
+		var instruction mdl.Instruction
+		instruction.IdempotentID = mdl.SomeString("abc-123")
+		instruction.Verb = mdl.SomeString("CONFIGURE")
+		instruction.Data.Store(mdl.SomeKey("database"), "postgres")
+		instruction.Data.Store(mdl.SomeKey("database", "password"), "password123")
+
+		if _, err := (mdl.InstructionEncoder{MediaType:"application/json"}).Encode("https://api.example.com/v1/config", &instruction); nil == err {
+			t.Errorf("Expected an error for conflicting keys sent as “application/json”, but did not actually get one.")
+		}
+	}
+
+	{
+		var instruction mdl.Instruction
+		instruction.IdempotentID = mdl.SomeString("abc-123")
+		instruction.Verb = mdl.SomeString("TAG_POST")
+		instruction.Data.Append(mdl.SomeKey("tag"), "apple")
+		instruction.Data.Append(mdl.SomeKey("tag"), "banana")
+
+		if _, err := (mdl.InstructionEncoder{MediaType:"application/json"}).Encode("https://api.example.com/v1/posts", &instruction); nil == err {
+			t.Errorf("Expected an error for a key with more than one value sent as “application/json”, but did not actually get one.")
+		}
+	}
+}
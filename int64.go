@@ -0,0 +1,286 @@
+package mdl
+
+import (
+	"database/sql/driver"
+	"encoding/json"
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+)
+
+// Int64 is an int64 option type.
+//
+// It can have 2 kinds of value:
+//
+// • no int64 (i.e., ‘nothing’),
+//
+// • some int64 (i.e., ‘something’).
+//
+// Like with ‘mdl.String’, the value in this type is that it can differentiate between being assigned zero (0),
+// and a variable that hasn't been loaded.
+type Int64 struct {
+	datum  int64
+	loaded bool
+}
+
+// NoInt64 returns a mdl.Int64 which has no value (i.e., ‘nothing’).
+//
+// Note that this is not the same thing as zero (0)!
+// That is mdl.SomeInt64(0).
+//
+// Example
+//
+//	var datum mdl.Int64
+//	
+//	// ...
+//	
+//	if mdl.NoInt64() == datum {
+//		//@TODO
+//	}
+func NoInt64() Int64 {
+	return Int64{}
+}
+
+// SomeInt64 returns a mdl.Int64 which has some value (i.e., ‘something’).
+//
+// Example
+//
+//	var datum mdl.Int64
+//	
+//	// ...
+//	
+//	datum = mdl.SomeInt64(-5)
+func SomeInt64(datum int64) Int64 {
+	return Int64{
+		loaded: true,
+		datum:  datum,
+	}
+}
+
+func (receiver Int64) Else(datum int64) Int64 {
+	if NoInt64() != receiver {
+		return receiver
+	}
+
+	return SomeInt64(datum)
+}
+
+func (receiver Int64) ElseUnwrap(datum int64) int64 {
+	if NoInt64() == receiver {
+		return datum
+	}
+
+	return receiver.datum
+}
+
+// Format makes ‘mdl.Int64’ fit the ‘fmt.Formatter’ interface.
+//
+// The %b, %d, %o, %x, %X, and %v verbs format the value the same way they would format an int64.
+// The %q verb formats its text form (see .MarshalText()) as a quoted string, and the %#v verb formats it with .GoString().
+func (receiver Int64) Format(f fmt.State, c rune) {
+	switch {
+	case 'v' == c && f.Flag('#'):
+		fmt.Fprint(f, receiver.GoString())
+	case NoInt64() == receiver && 'q' == c:
+		fmt.Fprint(f, "«no-int64»")
+	case NoInt64() == receiver:
+		fmt.Fprintf(f, "%%!%s(%s)", string(c), receiver.GoString())
+	case 'q' == c:
+		fmt.Fprintf(f, "%q", strconv.FormatInt(receiver.datum, 10))
+	case strings.ContainsRune("bdoxXv", c):
+		fmt.Fprintf(f, optionFormatDirective(f, c), receiver.datum)
+	default:
+		fmt.Fprintf(f, "%%!%s(%s)", string(c), receiver.GoString())
+	}
+}
+
+// GoString makes mdl.Int64 fit the fmt.GoStringer interface.
+//
+// It gets used with the %#v verb with the printing family of functions
+// in the Go built-in "fmt" package.
+func (receiver Int64) GoString() string {
+	if NoInt64() == receiver {
+		return "mdl.NoInt64()"
+	}
+
+	return fmt.Sprintf("mdl.SomeInt64(%d)", receiver.datum)
+}
+
+// MarshalJSON makes mdl.Int64 fit the encoding/json.Marshaler interface.
+//
+// mdl.NoInt64() is marshaled as JSON null, and mdl.SomeInt64() is marshaled as a JSON number.
+func (receiver Int64) MarshalJSON() ([]byte, error) {
+	if NoInt64() == receiver {
+		return []byte("null"), nil
+	}
+
+	return json.Marshal(receiver.datum)
+}
+
+// MarshalText makes mdl.Int64 fit the encoding.TextMarshaler interface.
+//
+// mdl.SomeInt64() is marshaled as a base 10 integer (ex: -5).
+// Since text has no way of saying ‘nothing’, mdl.NoInt64() cannot be marshaled as text, and returns an error.
+func (receiver Int64) MarshalText() ([]byte, error) {
+	if NoInt64() == receiver {
+		return nil, errNotLoaded
+	}
+
+	return []byte(strconv.FormatInt(receiver.datum, 10)), nil
+}
+
+func (receiver Int64) Map(fn func(int64)int64) Int64 {
+	if NoInt64() == receiver {
+		return receiver
+	}
+
+	return SomeInt64(fn(receiver.datum))
+}
+
+// Scan makes mdl.Int64 fit the database/sql.Scanner interface.
+//
+// A nil source (i.e., SQL NULL) scans as mdl.NoInt64().
+// The source can be any of the Go integer types (that the value fits in), a string, a []byte, or an ‘mdl.Int64’.
+func (receiver *Int64) Scan(src interface{}) error {
+	if nil == receiver {
+		return errNilReceiver
+	}
+
+	switch casted := src.(type) {
+	case nil:
+		*receiver = NoInt64()
+		return nil
+	case Int64:
+		*receiver = casted
+		return nil
+	case string:
+		return receiver.scanText(casted)
+	case []byte:
+		if nil == casted {
+			*receiver = NoInt64()
+			return nil
+		}
+		return receiver.scanText(string(casted))
+	case int64:
+		*receiver = SomeInt64(casted)
+		return nil
+	case int:
+		*receiver = SomeInt64(int64(casted))
+		return nil
+	case int32:
+		*receiver = SomeInt64(int64(casted))
+		return nil
+	case int16:
+		*receiver = SomeInt64(int64(casted))
+		return nil
+	case int8:
+		*receiver = SomeInt64(int64(casted))
+		return nil
+	case uint32:
+		*receiver = SomeInt64(int64(casted))
+		return nil
+	case uint16:
+		*receiver = SomeInt64(int64(casted))
+		return nil
+	case uint8:
+		*receiver = SomeInt64(int64(casted))
+		return nil
+	case uint64:
+		if math.MaxInt64 < casted {
+			return fmt.Errorf("mdl: %d overflows int64", casted)
+		}
+		*receiver = SomeInt64(int64(casted))
+		return nil
+	case uint:
+		if math.MaxInt64 < uint64(casted) {
+			return fmt.Errorf("mdl: %d overflows int64", casted)
+		}
+		*receiver = SomeInt64(int64(casted))
+		return nil
+	default:
+		return unsupportedSource(src)
+	}
+}
+
+// String returns the text form of the value (see .MarshalText()).
+func (receiver Int64) String() (string, error) {
+	if NoInt64() == receiver {
+		return "", errNotLoaded
+	}
+
+	return strconv.FormatInt(receiver.datum, 10), nil
+}
+
+func (receiver Int64) Then(fn func(int64)Int64) Int64 {
+	if NoInt64() == receiver {
+		return receiver
+	}
+
+	return fn(receiver.datum)
+}
+
+// UnmarshalJSON makes mdl.Int64 fit the encoding/json.Unmarshaler interface.
+//
+// It is the opposite of .MarshalJSON(); i.e., JSON null is unmarshaled as mdl.NoInt64().
+func (receiver *Int64) UnmarshalJSON(data []byte) error {
+	if nil == receiver {
+		return errNilReceiver
+	}
+
+	if "null" == string(data) {
+		*receiver = NoInt64()
+		return nil
+	}
+
+	var datum int64
+	if err := json.Unmarshal(data, &datum); nil != err {
+		return err
+	}
+
+	*receiver = SomeInt64(datum)
+	return nil
+}
+
+// UnmarshalText makes mdl.Int64 fit the encoding.TextUnmarshaler interface.
+//
+// It is the opposite of .MarshalText(). (A nil text is unmarshaled as mdl.NoInt64().)
+func (receiver *Int64) UnmarshalText(text []byte) error {
+	if nil == receiver {
+		return errNilReceiver
+	}
+
+	if nil == text {
+		*receiver = NoInt64()
+		return nil
+	}
+
+	return receiver.scanText(string(text))
+}
+
+func (receiver Int64) Unwrap() (int64, bool) {
+	return receiver.datum, receiver.loaded
+}
+
+// Value makes mdl.Int64 fit the database/sql/driver.Valuer interface.
+func (receiver Int64) Value() (driver.Value, error) {
+	if NoInt64() == receiver {
+		return nil, errNotLoaded
+	}
+
+	return receiver.datum, nil
+}
+
+func (receiver Int64) isNothing() bool {
+	return NoInt64() == receiver
+}
+
+func (receiver *Int64) scanText(text string) error {
+	datum, err := strconv.ParseInt(text, 10, 64)
+	if nil != err {
+		return err
+	}
+
+	*receiver = SomeInt64(datum)
+	return nil
+}
@@ -0,0 +1,54 @@
+package mdl_test
+
+import (
+	"github.com/reiver/go-mdl"
+
+	"testing"
+)
+
+func TestInt64Else(t *testing.T) {
+
+	tests := []struct{
+		Value    mdl.Int64
+		Else     int64
+		Expected mdl.Int64
+	}{
+		{
+			Value:    mdl.NoInt64(),
+			Else:                   42,
+			Expected: mdl.SomeInt64(42),
+		},
+		{
+			Value:    mdl.SomeInt64(-5),
+			Else:                   42,
+			Expected: mdl.SomeInt64(-5),
+		},
+
+
+
+		{
+			Value:    mdl.NoInt64(),
+			Else:                   0,
+			Expected: mdl.SomeInt64(0),
+		},
+		{
+			Value:    mdl.SomeInt64(0),
+			Else:                   42,
+			Expected: mdl.SomeInt64(0),
+		},
+	}
+
+	for testNumber, test := range tests {
+
+		actual := test.Value.Else(test.Else)
+
+		if expected := test.Expected; expected != actual {
+			t.Errorf("For test #%d ...", testNumber)
+			t.Errorf("\tVALUE:    %#v", test.Value)
+			t.Errorf("\tELSE:                   %d", test.Else)
+			t.Errorf("\tEXPECTED: %#v", expected)
+			t.Errorf("\tACTUAL:   %#v", actual)
+			continue
+		}
+	}
+}
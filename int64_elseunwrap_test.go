@@ -0,0 +1,54 @@
+package mdl_test
+
+import (
+	"github.com/reiver/go-mdl"
+
+	"testing"
+)
+
+func TestInt64ElseUnwrap(t *testing.T) {
+
+	tests := []struct{
+		Value    mdl.Int64
+		Else     int64
+		Expected int64
+	}{
+		{
+			Value:    mdl.NoInt64(),
+			Else:                   42,
+			Expected:               42,
+		},
+		{
+			Value:    mdl.SomeInt64(-5),
+			Else:                   42,
+			Expected:               -5,
+		},
+
+
+
+		{
+			Value:    mdl.NoInt64(),
+			Else:                   -1,
+			Expected:               -1,
+		},
+		{
+			Value:    mdl.SomeInt64(0),
+			Else:                   -1,
+			Expected:                0,
+		},
+	}
+
+	for testNumber, test := range tests {
+
+		actual := test.Value.ElseUnwrap(test.Else)
+
+		if expected := test.Expected; expected != actual {
+			t.Errorf("For test #%d ...", testNumber)
+			t.Errorf("\tVALUE: %#v", test.Value)
+			t.Errorf("\tELSE:                %d", test.Else)
+			t.Errorf("\tEXPECTED:            %d", expected)
+			t.Errorf("\tACTUAL:              %d", actual)
+			continue
+		}
+	}
+}
@@ -0,0 +1,84 @@
+package mdl_test
+
+import (
+	"github.com/reiver/go-mdl"
+
+	"fmt"
+
+	"testing"
+)
+
+func TestInt64Format(t *testing.T) {
+
+	tests := []struct{
+		Format   string
+		Int64    mdl.Int64
+		Expected string
+	}{
+		{
+			Format: "%q",
+			Int64:  mdl.NoInt64(),
+			Expected:      `«no-int64»`,
+		},
+		{
+			Format: "%q",
+			Int64:  mdl.SomeInt64(-5),
+			Expected:      `"-5"`,
+		},
+
+
+
+		{
+			Format: "%#v",
+			Int64:  mdl.SomeInt64(-5),
+			Expected:      `mdl.SomeInt64(-5)`,
+		},
+		{
+			Format: "%v",
+			Int64:  mdl.SomeInt64(-5),
+			Expected:      `-5`,
+		},
+		{
+			Format: "%05d",
+			Int64:  mdl.SomeInt64(-5),
+			Expected:      `-0005`,
+		},
+		{
+			Format: "%x",
+			Int64:  mdl.SomeInt64(255),
+			Expected:      `ff`,
+		},
+		{
+			Format: "%b",
+			Int64:  mdl.SomeInt64(5),
+			Expected:      `101`,
+		},
+
+
+
+		{
+			Format: "%d",
+			Int64:  mdl.NoInt64(),
+			Expected:      `%!d(mdl.NoInt64())`,
+		},
+		{
+			Format: "%s",
+			Int64:  mdl.SomeInt64(-5),
+			Expected:      `%!s(mdl.SomeInt64(-5))`,
+		},
+	}
+
+	for testNumber, test := range tests {
+
+		actual := fmt.Sprintf(test.Format, test.Int64)
+		if expected := test.Expected; expected != actual {
+			t.Errorf("For test #%d, for print verb, did not actually get what was expected.", testNumber)
+			t.Logf("\tEXPECTED: %q", expected)
+			t.Logf("\tACTUAL:   %q", actual)
+			t.Logf("\tFormat: %q", test.Format)
+			t.Logf("\tInt64: %#v", test.Int64)
+			continue
+		}
+
+	}
+}
@@ -0,0 +1,49 @@
+package mdl_test
+
+import (
+	"github.com/reiver/go-mdl"
+
+	"math"
+
+	"testing"
+)
+
+func TestInt64GoString(t *testing.T) {
+
+	tests := []struct{
+		Value    mdl.Int64
+		Expected string
+	}{
+		{
+			Value:    mdl.NoInt64(),
+			Expected: "mdl.NoInt64()",
+		},
+
+
+
+		{
+			Value:    mdl.SomeInt64(0),
+			Expected: "mdl.SomeInt64(0)",
+		},
+		{
+			Value:    mdl.SomeInt64(-5),
+			Expected: "mdl.SomeInt64(-5)",
+		},
+		{
+			Value:    mdl.SomeInt64(math.MinInt64),
+			Expected: "mdl.SomeInt64(-9223372036854775808)",
+		},
+	}
+
+	for testNumber, test := range tests {
+
+		actual := test.Value.GoString()
+
+		if expected := test.Expected; expected != actual {
+			t.Errorf("For test #%d ...", testNumber)
+			t.Errorf("\tEXPECTED: %#v", expected)
+			t.Errorf("\tACTUAL:   %#v", actual)
+			continue
+		}
+	}
+}
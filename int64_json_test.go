@@ -0,0 +1,77 @@
+package mdl_test
+
+import (
+	"github.com/reiver/go-mdl"
+
+	"encoding/json"
+	"testing"
+)
+
+func TestInt64JSON(t *testing.T) {
+
+	tests := []struct{
+		Datum    mdl.Int64
+		Expected string
+	}{
+		{
+			Datum:    mdl.NoInt64(),
+			Expected: `null`,
+		},
+		{
+			Datum:    mdl.SomeInt64(0),
+			Expected: `0`,
+		},
+		{
+			Datum:    mdl.SomeInt64(-5),
+			Expected: `-5`,
+		},
+		{
+			Datum:    mdl.SomeInt64(1431001509),
+			Expected: `1431001509`,
+		},
+	}
+
+	for testNumber, test := range tests {
+
+		data, err := json.Marshal(test.Datum)
+		if nil != err {
+			t.Errorf("For test #%d, did not expect an error, but actually got one: (%T) %q", testNumber, err, err)
+			continue
+		}
+
+		if expected, actual := test.Expected, string(data); expected != actual {
+			t.Errorf("For test #%d, expected %s, but actually got %s.", testNumber, expected, actual)
+			continue
+		}
+
+		var actual mdl.Int64 = mdl.SomeInt64(42)
+		if err := json.Unmarshal(data, &actual); nil != err {
+			t.Errorf("For test #%d, did not expect an error, but actually got one: (%T) %q", testNumber, err, err)
+			continue
+		}
+
+		if expected := test.Datum; expected != actual {
+			t.Errorf("For test #%d, expected %#v, but actually got %#v.", testNumber, expected, actual)
+			continue
+		}
+	}
+}
+
+func TestInt64JSONError(t *testing.T) {
+
+	tests := []string{
+		`"-5"`,
+		`2.5`,
+		`true`,
+		`9223372036854775808`,
+	}
+
+	for testNumber, data := range tests {
+
+		var actual mdl.Int64
+		if err := json.Unmarshal([]byte(data), &actual); nil == err {
+			t.Errorf("For test #%d, expected an error for %s, but did not actually get one.", testNumber, data)
+			continue
+		}
+	}
+}
@@ -0,0 +1,60 @@
+package mdl_test
+
+import (
+	"github.com/reiver/go-mdl"
+
+	"testing"
+)
+
+func TestInt64Map(t *testing.T) {
+
+	tests := []struct{
+		Value    mdl.Int64
+		Func     func(int64)int64
+		Expected mdl.Int64
+	}{
+		{
+			Value: mdl.NoInt64(),
+			Func:  func(int64)int64{
+				return 42
+			},
+			Expected: mdl.NoInt64(),
+		},
+		{
+			Value: mdl.SomeInt64(-5),
+			Func:  func(int64)int64{
+				return 42
+			},
+			Expected: mdl.SomeInt64(42),
+		},
+
+
+
+		{
+			Value: mdl.NoInt64(),
+			Func:  func(n int64)int64{
+				return -n
+			},
+			Expected: mdl.NoInt64(),
+		},
+		{
+			Value: mdl.SomeInt64(-5),
+			Func:  func(n int64)int64{
+				return -n
+			},
+			Expected: mdl.SomeInt64(5),
+		},
+	}
+
+	for testNumber, test := range tests {
+
+		actual := test.Value.Map(test.Func)
+
+		if expected := test.Expected; expected != actual {
+			t.Errorf("For test #%d ...", testNumber)
+			t.Errorf("\tEXPECTED: %#v", expected)
+			t.Errorf("\tACTUAL:   %#v", actual)
+			continue
+		}
+	}
+}
@@ -0,0 +1,46 @@
+package mdl_test
+
+import (
+	"github.com/reiver/go-mdl"
+
+	"testing"
+)
+
+func TestInt64MarshalText(t *testing.T) {
+
+	tests := []struct{
+		Value    mdl.Int64
+		Expected string
+	}{
+		{
+			Value:    mdl.SomeInt64(0),
+			Expected: "0",
+		},
+		{
+			Value:    mdl.SomeInt64(-5),
+			Expected: "-5",
+		},
+		{
+			Value:    mdl.SomeInt64(1431001509),
+			Expected: "1431001509",
+		},
+	}
+
+	for testNumber, test := range tests {
+
+		text, err := test.Value.MarshalText()
+		if nil != err {
+			t.Errorf("For test #%d, did not expect an error, but actually got one: (%T) %q", testNumber, err, err)
+			continue
+		}
+
+		if expected, actual := test.Expected, string(text); expected != actual {
+			t.Errorf("For test #%d, expected %q, but actually got %q.", testNumber, expected, actual)
+			continue
+		}
+	}
+
+	if _, err := mdl.NoInt64().MarshalText(); nil == err {
+		t.Errorf("Expected an error for mdl.NoInt64(), but did not actually get one.")
+	}
+}
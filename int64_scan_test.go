@@ -0,0 +1,121 @@
+package mdl_test
+
+import (
+	"github.com/reiver/go-mdl"
+
+	"math"
+
+	"testing"
+)
+
+func TestInt64Scan(t *testing.T) {
+
+	tests := []struct{
+		Datum    interface{}
+		Expected mdl.Int64
+	}{
+		{
+			Datum:    nil,
+			Expected: mdl.NoInt64(),
+		},
+		{
+			Datum:    []byte(nil),
+			Expected: mdl.NoInt64(),
+		},
+
+
+
+		{
+			Datum:    mdl.NoInt64(),
+			Expected: mdl.NoInt64(),
+		},
+		{
+			Datum:    mdl.SomeInt64(-5),
+			Expected: mdl.SomeInt64(-5),
+		},
+
+
+
+		{
+			Datum:                  "-5",
+			Expected: mdl.SomeInt64(-5),
+		},
+		{
+			Datum:           []byte("-5"),
+			Expected: mdl.SomeInt64(-5),
+		},
+
+
+
+		{
+			Datum:            int64(-5),
+			Expected: mdl.SomeInt64(-5),
+		},
+		{
+			Datum:              int(-5),
+			Expected: mdl.SomeInt64(-5),
+		},
+		{
+			Datum:             int8(-5),
+			Expected: mdl.SomeInt64(-5),
+		},
+		{
+			Datum:             uint8(5),
+			Expected: mdl.SomeInt64(5),
+		},
+		{
+			Datum:            uint32(math.MaxUint32),
+			Expected: mdl.SomeInt64(math.MaxUint32),
+		},
+		{
+			Datum:            uint64(math.MaxInt64),
+			Expected: mdl.SomeInt64(math.MaxInt64),
+		},
+	}
+
+	for testNumber, test := range tests {
+
+		var actual mdl.Int64 = mdl.SomeInt64(42)
+
+		err := actual.Scan(test.Datum)
+
+		if expected := test.Expected; nil != err {
+			t.Errorf("For test #%d, did not expect an error, but actually got one: (%T) %q", testNumber, err, err)
+			t.Errorf("\tDATUM: (%T) %#v", test.Datum, test.Datum)
+			t.Errorf("\tEXPECTED: %#v", expected)
+			t.Errorf("\tACTUAL:   %#v", actual)
+			continue
+		}
+
+		if expected := test.Expected; expected != actual {
+			t.Errorf("For test #%d ...", testNumber)
+			t.Errorf("\tDATUM: (%T) %#v", test.Datum, test.Datum)
+			t.Errorf("\tEXPECTED: %#v", expected)
+			t.Errorf("\tACTUAL:   %#v", actual)
+			continue
+		}
+	}
+}
+
+func TestInt64ScanError(t *testing.T) {
+
+	tests := []interface{}{
+		uint64(math.MaxInt64) + 1,
+		"five",
+		"2.5",
+		2.5,
+		true,
+	}
+
+	for testNumber, datum := range tests {
+
+		var actual mdl.Int64
+
+		if err := actual.Scan(datum); nil == err {
+			t.Errorf("For test #%d, expected an error, but did not actually get one.", testNumber)
+			t.Errorf("\tDATUM: (%T) %#v", datum, datum)
+			t.Errorf("\tACTUAL: %#v", actual)
+			continue
+		}
+	}
+}
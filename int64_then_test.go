@@ -0,0 +1,83 @@
+package mdl_test
+
+import (
+	"github.com/reiver/go-mdl"
+
+	"testing"
+)
+
+func TestInt64Then(t *testing.T) {
+
+	tests := []struct{
+		Value    mdl.Int64
+		Func     func(int64)mdl.Int64
+		Expected mdl.Int64
+	}{
+		{
+			Value: mdl.NoInt64(),
+			Func:  func(int64)mdl.Int64{
+				return mdl.NoInt64()
+			},
+			Expected: mdl.NoInt64(),
+		},
+		{
+			Value: mdl.SomeInt64(-5),
+			Func:  func(int64)mdl.Int64{
+				return mdl.NoInt64()
+			},
+			Expected: mdl.NoInt64(),
+		},
+
+
+
+		{
+			Value: mdl.NoInt64(),
+			Func:  func(int64)mdl.Int64{
+				return mdl.SomeInt64(42)
+			},
+			Expected: mdl.NoInt64(),
+		},
+		{
+			Value: mdl.SomeInt64(-5),
+			Func:  func(int64)mdl.Int64{
+				return mdl.SomeInt64(42)
+			},
+			Expected: mdl.SomeInt64(42),
+		},
+
+
+
+		{
+			Value: mdl.SomeInt64(-5),
+			Func:  func(n int64)mdl.Int64{
+				if n < 0 {
+					return mdl.NoInt64()
+				}
+				return mdl.SomeInt64(n)
+			},
+			Expected: mdl.NoInt64(),
+		},
+		{
+			Value: mdl.SomeInt64(5),
+			Func:  func(n int64)mdl.Int64{
+				if n < 0 {
+					return mdl.NoInt64()
+				}
+				return mdl.SomeInt64(n)
+			},
+			Expected: mdl.SomeInt64(5),
+		},
+	}
+
+	for testNumber, test := range tests {
+
+		actual := test.Value.Then(test.Func)
+
+		if expected := test.Expected; expected != actual {
+			t.Errorf("For test #%d ...", testNumber)
+			t.Errorf("\tEXPECTED: %#v", expected)
+			t.Errorf("\tACTUAL:   %#v", actual)
+			continue
+		}
+	}
+}
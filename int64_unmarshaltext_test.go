@@ -0,0 +1,67 @@
+package mdl_test
+
+import (
+	"github.com/reiver/go-mdl"
+
+	"testing"
+)
+
+func TestInt64UnmarshalText(t *testing.T) {
+
+	tests := []struct{
+		Datum    []byte
+		Expected mdl.Int64
+	}{
+		{
+			Datum:       []byte(nil),
+			Expected: mdl.NoInt64(),
+		},
+
+
+
+		{
+			Datum:       []byte("0"),
+			Expected: mdl.SomeInt64(0),
+		},
+		{
+			Datum:       []byte("-5"),
+			Expected: mdl.SomeInt64(-5),
+		},
+		{
+			Datum:       []byte("+5"),
+			Expected: mdl.SomeInt64(5),
+		},
+	}
+
+	for testNumber, test := range tests {
+
+		var actual mdl.Int64 = mdl.SomeInt64(42)
+
+		err := actual.UnmarshalText(test.Datum)
+
+		if expected := test.Expected; nil != err {
+			t.Errorf("For test #%d, did not expect an error, but actually got one: (%T) %q", testNumber, err, err)
+			t.Errorf("\tDATUM: %q", test.Datum)
+			t.Errorf("\tEXPECTED: %#v", expected)
+			t.Errorf("\tACTUAL:   %#v", actual)
+			continue
+		}
+
+		if expected := test.Expected; expected != actual {
+			t.Errorf("For test #%d ...", testNumber)
+			t.Errorf("\tDATUM: %q", test.Datum)
+			t.Errorf("\tEXPECTED: %#v", expected)
+			t.Errorf("\tACTUAL:   %#v", actual)
+			continue
+		}
+	}
+
+	for testNumber, datum := range []string{"", "five", "0x10", "9223372036854775808"} {
+
+		var actual mdl.Int64
+		if err := actual.UnmarshalText([]byte(datum)); nil == err {
+			t.Errorf("For bad text #%d (%q), expected an error, but did not actually get one.", testNumber, datum)
+			continue
+		}
+	}
+}
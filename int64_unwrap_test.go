@@ -0,0 +1,83 @@
+package mdl_test
+
+import (
+	"github.com/reiver/go-mdl"
+
+	"math"
+
+	"testing"
+)
+
+func TestInt64Unwrap(t *testing.T) {
+
+	tests := []struct{
+		Value          mdl.Int64
+		ExpectedLoaded bool
+		Expected       int64
+	}{
+		{
+			Value: mdl.NoInt64(),
+			ExpectedLoaded: false,
+			Expected:                  0,
+		},
+		{
+			Value: mdl.Int64{},
+			ExpectedLoaded: false,
+			Expected:                  0,
+		},
+
+
+
+		{
+			Value: mdl.SomeInt64(0),
+			ExpectedLoaded: true,
+			Expected:                  0,
+		},
+		{
+			Value: mdl.SomeInt64(-5),
+			ExpectedLoaded: true,
+			Expected:                  -5,
+		},
+		{
+			Value: mdl.SomeInt64(1431001509),
+			ExpectedLoaded: true,
+			Expected:                  1431001509,
+		},
+
+
+
+		{
+			Value: mdl.SomeInt64(math.MinInt64),
+			ExpectedLoaded: true,
+			Expected:                  math.MinInt64,
+		},
+		{
+			Value: mdl.SomeInt64(math.MaxInt64),
+			ExpectedLoaded: true,
+			Expected:                  math.MaxInt64,
+		},
+	}
+
+	for testNumber, test := range tests {
+
+		actual, actualLoaded := test.Value.Unwrap()
+
+		if expected, expectedLoaded := test.Expected, test.ExpectedLoaded; expectedLoaded != actualLoaded {
+			t.Errorf("For test #%d ...", testNumber)
+			t.Errorf("\tEXPECTED loaded: %t", expectedLoaded)
+			t.Errorf("\tACTUAL   loaded: %t", actualLoaded)
+			t.Errorf("\tEXPECTED: %d", expected)
+			t.Errorf("\tACTUAL:   %d", actual)
+			continue
+		}
+
+		if expected, expectedLoaded := test.Expected, test.ExpectedLoaded; expected != actual {
+			t.Errorf("For test #%d ...", testNumber)
+			t.Errorf("\tEXPECTED loaded: %t", expectedLoaded)
+			t.Errorf("\tACTUAL   loaded: %t", actualLoaded)
+			t.Errorf("\tEXPECTED: %d", expected)
+			t.Errorf("\tACTUAL:   %d", actual)
+			continue
+		}
+	}
+}
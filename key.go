@@ -2,6 +2,7 @@ package mdl
 
 import (
 	"database/sql/driver"
+	"encoding/json"
 	"fmt"
 	"strings"
 )
@@ -12,6 +13,9 @@ import (
 // ‘mdl.Key’ exists to abstract that complexity, and make the API for this package a bit
 // easier to use.
 //
+// The tokens of a key can be worked with using: .Len(), .Token(), .Base(), .Parent(), .Append(), .Join(),
+// .HasPrefix(), .TrimPrefix(), and .Compare(). (These work on the serialized form of the key, rather than deserializing it.)
+//
 // Example
 //
 // Here is an example of setting the value of ‘mdl.Key’ using a non-serialized format:
@@ -20,7 +24,7 @@ import (
 //	
 //	// ...
 //	
-//	key = mdl.SomKey("database", "password")
+//	key = mdl.SomeKey("database", "password")
 //
 // Example
 //
@@ -220,6 +224,32 @@ func (receiver Key) GoString() string {
 	return builder.String()
 }
 
+// MarshalJSON makes ‘mdl.Key’ fit the encoding/json.Marshaler interface.
+//
+// A key is marshaled as a JSON string of its canonical form (ex: "database/password"),
+// and ‘mdl.NoKey()’ is marshaled as JSON null.
+func (receiver Key) MarshalJSON() ([]byte, error) {
+	if NoKey() == receiver {
+		return []byte("null"), nil
+	}
+
+	return json.Marshal(receiver.CanonicalForm())
+}
+
+// MarshalText makes ‘mdl.Key’ fit the encoding.TextMarshaler interface.
+//
+// A key is marshaled as its canonical form (ex: database/password). (So ‘mdl.Key’ can be used as the key of
+// a Go map that is marshaled as a JSON object.)
+//
+// ‘mdl.NoKey()’ cannot be marshaled as text, and returns an error.
+func (receiver Key) MarshalText() ([]byte, error) {
+	if NoKey() == receiver {
+		return nil, errNotLoaded
+	}
+
+	return []byte(receiver.CanonicalForm()), nil
+}
+
 func (receiver Key) Map(fn func(...string)[]string) Key {
 	if NoKey() == receiver {
 		return receiver
@@ -233,6 +263,65 @@ func (receiver Key) Map(fn func(...string)[]string) Key {
 	return SomeKey(fn(receiver.ElseUnwrap()...)...)
 }
 
+// Scan makes mdl.Key fit the database/sql.Scanner interface.
+//
+// The source is a key in its serialized form (ex: "database/password"), and can be a string, a []byte, an ‘mdl.String’, or an ‘mdl.Key’.
+// A nil source (or a nil []byte, or mdl.NoString()) scans as mdl.NoKey().
+//
+// If the serialized key is not valid (i.e., it ends with a lone backslash, or is not valid UTF-8), then Scan returns an ‘mdl.MalformedKey’ error.
+//
+// Example
+//
+//	var key mdl.Key
+//	
+//	err := key.Scan("database/password") // key == mdl.SomeKey("database", "password")
+func (receiver *Key) Scan(src interface{}) error {
+	if nil == receiver {
+		return errNilReceiver
+	}
+
+	switch casted := src.(type) {
+	case nil:
+		*receiver = NoKey()
+		return nil
+	case Key:
+		*receiver = casted
+		return nil
+	case string:
+		return receiver.scanSerialized(casted)
+	case []byte:
+		if nil == casted {
+			*receiver = NoKey()
+			return nil
+		}
+		return receiver.scanSerialized(string(casted))
+	case String:
+		serialized, loaded := casted.Unwrap()
+		if !loaded {
+			*receiver = NoKey()
+			return nil
+		}
+		return receiver.scanSerialized(serialized)
+	default:
+		return unsupportedSource(src)
+	}
+}
+
+func (receiver *Key) scanSerialized(serialized string) error {
+	if "" == serialized {
+		*receiver = SomeKey("")
+		return nil
+	}
+
+	tokens, err := KeyDeserialize(serialized)
+	if nil != err {
+		return err
+	}
+
+	*receiver = SomeKey(tokens...)
+	return nil
+}
+
 func (receiver Key) String() (string, error) {
 	return receiver.encoded.String()
 }
@@ -245,6 +334,39 @@ func (receiver Key) Then(fn func(...string)Key) Key {
 	return fn(receiver.ElseUnwrap()...)
 }
 
+// UnmarshalJSON makes ‘mdl.Key’ fit the encoding/json.Unmarshaler interface.
+//
+// It is the opposite of .MarshalJSON(); i.e., JSON null unmarshals as mdl.NoKey(), and a JSON string is
+// unmarshaled the same way as .Scan() does.
+func (receiver *Key) UnmarshalJSON(data []byte) error {
+	if nil == receiver {
+		return errNilReceiver
+	}
+
+	if "null" == string(data) {
+		*receiver = NoKey()
+		return nil
+	}
+
+	var serialized string
+	if err := json.Unmarshal(data, &serialized); nil != err {
+		return err
+	}
+
+	return receiver.scanSerialized(serialized)
+}
+
+// UnmarshalText makes ‘mdl.Key’ fit the encoding.TextUnmarshaler interface.
+//
+// It is the opposite of .MarshalText().
+func (receiver *Key) UnmarshalText(text []byte) error {
+	if nil == receiver {
+		return errNilReceiver
+	}
+
+	return receiver.scanSerialized(string(text))
+}
+
 // Value makes mdl.Key fit the database/sql/driver.Valuer interface.
 func (receiver Key) Value() (driver.Value, error) {
 	if NoKey() == receiver {
@@ -0,0 +1,107 @@
+package mdl_test
+
+import (
+	"github.com/reiver/go-mdl"
+
+	"encoding/json"
+	"testing"
+)
+
+func TestKeyMarshalJSON(t *testing.T) {
+
+	tests := []struct{
+		Key      mdl.Key
+		Expected string
+	}{
+		{
+			Key:      mdl.NoKey(),
+			Expected: `null`,
+		},
+		{
+			Key:      mdl.SomeKey("database", "password"),
+			Expected: `"database/password"`,
+		},
+		{
+			Key:      mdl.SomeKey("ac/dc", "a b"),
+			Expected: `"ac\\/dc/a\\ b"`,
+		},
+	}
+
+	for testNumber, test := range tests {
+
+		data, err := json.Marshal(test.Key)
+		if nil != err {
+			t.Errorf("For test #%d, did not expect an error, but actually got one: (%T) %q", testNumber, err, err)
+			continue
+		}
+
+		if expected, actual := test.Expected, string(data); expected != actual {
+			t.Errorf("For test #%d, expected %s, but actually got %s.", testNumber, expected, actual)
+			continue
+		}
+
+		var key mdl.Key = mdl.SomeKey("something", "else")
+		if err := json.Unmarshal(data, &key); nil != err {
+			t.Errorf("For test #%d, did not expect an error, but actually got one: (%T) %q", testNumber, err, err)
+			continue
+		}
+
+		if expected, actual := test.Key, key; expected != actual {
+			t.Errorf("For test #%d, expected %#v, but actually got %#v.", testNumber, expected, actual)
+			continue
+		}
+	}
+}
+
+func TestKeyMarshalText(t *testing.T) {
+
+	{
+		if _, err := mdl.NoKey().MarshalText(); nil == err {
+			t.Errorf("Expected an error, but did not actually get one.")
+		}
+	}
+
+	{
+		var expected map[mdl.Key]string = map[mdl.Key]string{
+			mdl.SomeKey("database", "username"): "joeblow",
+			mdl.SomeKey("database", "password"): "password123",
+			mdl.SomeKey("i/o"):                  "yes",
+		}
+
+		data, err := json.Marshal(expected)
+		if nil != err {
+			t.Errorf("Did not expect an error, but actually got one: (%T) %q", err, err)
+			return
+		}
+
+		if expected, actual := `{"database/password":"password123","database/username":"joeblow","i\\/o":"yes"}`, string(data); expected != actual {
+			t.Errorf("Expected %s, but actually got %s.", expected, actual)
+			return
+		}
+
+		var actual map[mdl.Key]string
+		if err := json.Unmarshal(data, &actual); nil != err {
+			t.Errorf("Did not expect an error, but actually got one: (%T) %q", err, err)
+			return
+		}
+
+		if len(expected) != len(actual) {
+			t.Errorf("Expected %d entries, but actually got %d.", len(expected), len(actual))
+			return
+		}
+		for key, value := range expected {
+			if actualValue, found := actual[key]; !found || value != actualValue {
+				t.Errorf("For key %#v, expected %q, but actually got %q.", key, value, actualValue)
+			}
+		}
+	}
+
+	{
+		var key mdl.Key
+		if err := json.Unmarshal([]byte(`"database\\"`), &key); nil == err {
+			t.Errorf("Expected an error, but did not actually get one.")
+		} else if _, casted := err.(mdl.MalformedKey); !casted {
+			t.Errorf("Expected a mdl.MalformedKey error, but actually got: (%T) %q", err, err)
+		}
+	}
+}
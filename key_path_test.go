@@ -0,0 +1,351 @@
+package mdl_test
+
+import (
+	"github.com/reiver/go-mdl"
+
+	"sort"
+	"testing"
+)
+
+func TestKeyLenTokenBaseParent(t *testing.T) {
+
+	tests := []struct{
+		Key            mdl.Key
+		ExpectedTokens []string
+		ExpectedParent mdl.Key
+	}{
+		{
+			Key: mdl.NoKey(),
+			ExpectedTokens: nil,
+			ExpectedParent: mdl.NoKey(),
+		},
+		{
+			Key: mdl.SomeKey(""),
+			ExpectedTokens: []string{""},
+			ExpectedParent: mdl.NoKey(),
+		},
+		{
+			Key: mdl.SomeKey("database"),
+			ExpectedTokens: []string{"database"},
+			ExpectedParent: mdl.NoKey(),
+		},
+		{
+			Key: mdl.SomeKey("database", "username"),
+			ExpectedTokens: []string{"database", "username"},
+			ExpectedParent: mdl.SomeKey("database"),
+		},
+		{
+			Key: mdl.SomeKey("a", "", "c"),
+			ExpectedTokens: []string{"a", "", "c"},
+			ExpectedParent: mdl.SomeKey("a", ""),
+		},
+		{
+			Key: mdl.SomeKey("i/o", "x\\y", "has space", "{}"),
+			ExpectedTokens: []string{"i/o", "x\\y", "has space", "{}"},
+			ExpectedParent: mdl.SomeKey("i/o", "x\\y", "has space"),
+		},
+		{
+			Key: mdl.SomeKey("/", "\\", "/\\/"),
+			ExpectedTokens: []string{"/", "\\", "/\\/"},
+			ExpectedParent: mdl.SomeKey("/", "\\"),
+		},
+		{
+			Key: mdl.SomeKey("۰۱۲", " x", "😀/😀"),
+			ExpectedTokens: []string{"۰۱۲", " x", "😀/😀"},
+			ExpectedParent: mdl.SomeKey("۰۱۲", " x"),
+		},
+	}
+
+	for testNumber, test := range tests {
+
+		if expected, actual := len(test.ExpectedTokens), test.Key.Len(); expected != actual {
+			t.Errorf("For test #%d, expected length %d, but actually got %d.", testNumber, expected, actual)
+			t.Logf("KEY: %#v", test.Key)
+			continue
+		}
+
+		for i, token := range test.ExpectedTokens {
+			if expected, actual := mdl.SomeString(token), test.Key.Token(i); expected != actual {
+				t.Errorf("For test #%d and token #%d, expected %#v, but actually got %#v.", testNumber, i, expected, actual)
+				t.Logf("KEY: %#v", test.Key)
+				continue
+			}
+		}
+
+		if expected, actual := mdl.NoString(), test.Key.Token(len(test.ExpectedTokens)); expected != actual {
+			t.Errorf("For test #%d, expected the token past the end to be %#v, but actually got %#v.", testNumber, expected, actual)
+			continue
+		}
+		if expected, actual := mdl.NoString(), test.Key.Token(-1); expected != actual {
+			t.Errorf("For test #%d, expected token #-1 to be %#v, but actually got %#v.", testNumber, expected, actual)
+			continue
+		}
+
+		{
+			var expected mdl.String = mdl.NoString()
+			if 0 < len(test.ExpectedTokens) {
+				expected = mdl.SomeString(test.ExpectedTokens[len(test.ExpectedTokens)-1])
+			}
+
+			if actual := test.Key.Base(); expected != actual {
+				t.Errorf("For test #%d, expected base %#v, but actually got %#v.", testNumber, expected, actual)
+				continue
+			}
+		}
+
+		if expected, actual := test.ExpectedParent, test.Key.Parent(); expected != actual {
+			t.Errorf("For test #%d, expected parent %#v, but actually got %#v.", testNumber, expected, actual)
+			continue
+		}
+	}
+}
+
+func TestKeyAppendJoin(t *testing.T) {
+
+	tests := []struct{
+		Key      mdl.Key
+		Tokens   []string
+		Expected mdl.Key
+	}{
+		{
+			Key: mdl.NoKey(),
+			Tokens: nil,
+			Expected: mdl.NoKey(),
+		},
+		{
+			Key: mdl.NoKey(),
+			Tokens: []string{"database"},
+			Expected: mdl.SomeKey("database"),
+		},
+		{
+			Key: mdl.SomeKey("database"),
+			Tokens: nil,
+			Expected: mdl.SomeKey("database"),
+		},
+		{
+			Key: mdl.SomeKey("database"),
+			Tokens: []string{"primary", "username"},
+			Expected: mdl.SomeKey("database", "primary", "username"),
+		},
+		{
+			Key: mdl.SomeKey("i/o"),
+			Tokens: []string{"a\\b", ""},
+			Expected: mdl.SomeKey("i/o", "a\\b", ""),
+		},
+		{
+			Key: mdl.SomeKey(""),
+			Tokens: []string{""},
+			Expected: mdl.SomeKey("", ""),
+		},
+	}
+
+	for testNumber, test := range tests {
+
+		if expected, actual := test.Expected, test.Key.Append(test.Tokens...); expected != actual {
+			t.Errorf("For test #%d, expected .Append() to return %#v, but actually got %#v.", testNumber, expected, actual)
+			continue
+		}
+
+		if expected, actual := test.Expected, test.Key.Join(mdl.SomeKey(test.Tokens...)); expected != actual {
+			t.Errorf("For test #%d, expected .Join() to return %#v, but actually got %#v.", testNumber, expected, actual)
+			continue
+		}
+	}
+}
+
+func TestKeyHasPrefixTrimPrefix(t *testing.T) {
+
+	tests := []struct{
+		Key             mdl.Key
+		Prefix          mdl.Key
+		ExpectedHas     bool
+		ExpectedTrimmed mdl.Key
+	}{
+		{
+			Key: mdl.SomeKey("database", "username"),
+			Prefix: mdl.SomeKey("database"),
+			ExpectedHas: true,
+			ExpectedTrimmed: mdl.SomeKey("username"),
+		},
+		{
+			Key: mdl.SomeKey("database", "primary", "username"),
+			Prefix: mdl.SomeKey("database", "primary"),
+			ExpectedHas: true,
+			ExpectedTrimmed: mdl.SomeKey("username"),
+		},
+		{
+			Key: mdl.SomeKey("database", "username"),
+			Prefix: mdl.SomeKey("database", "username"),
+			ExpectedHas: true,
+			ExpectedTrimmed: mdl.NoKey(),
+		},
+		{
+			Key: mdl.SomeKey("database", "username"),
+			Prefix: mdl.NoKey(),
+			ExpectedHas: true,
+			ExpectedTrimmed: mdl.SomeKey("database", "username"),
+		},
+		{
+			Key: mdl.NoKey(),
+			Prefix: mdl.NoKey(),
+			ExpectedHas: true,
+			ExpectedTrimmed: mdl.NoKey(),
+		},
+		{
+			Key: mdl.SomeKey("database", "username"),
+			Prefix: mdl.SomeKey("data"),
+			ExpectedHas: false,
+			ExpectedTrimmed: mdl.SomeKey("database", "username"),
+		},
+		{
+			Key: mdl.SomeKey("database"),
+			Prefix: mdl.SomeKey("database", "username"),
+			ExpectedHas: false,
+			ExpectedTrimmed: mdl.SomeKey("database"),
+		},
+		{
+			Key: mdl.SomeKey("i/o"),
+			Prefix: mdl.SomeKey("i"),
+			ExpectedHas: false,
+			ExpectedTrimmed: mdl.SomeKey("i/o"),
+		},
+		{
+			Key: mdl.SomeKey("i/", "o"),
+			Prefix: mdl.SomeKey("i/"),
+			ExpectedHas: true,
+			ExpectedTrimmed: mdl.SomeKey("o"),
+		},
+		{
+			Key: mdl.SomeKey("a\\", "b"),
+			Prefix: mdl.SomeKey("a\\"),
+			ExpectedHas: true,
+			ExpectedTrimmed: mdl.SomeKey("b"),
+		},
+		{
+			Key: mdl.SomeKey("", "b"),
+			Prefix: mdl.SomeKey(""),
+			ExpectedHas: true,
+			ExpectedTrimmed: mdl.SomeKey("b"),
+		},
+		{
+			Key: mdl.SomeKey("b"),
+			Prefix: mdl.SomeKey(""),
+			ExpectedHas: false,
+			ExpectedTrimmed: mdl.SomeKey("b"),
+		},
+		{
+			Key: mdl.NoKey(),
+			Prefix: mdl.SomeKey(""),
+			ExpectedHas: false,
+			ExpectedTrimmed: mdl.NoKey(),
+		},
+	}
+
+	for testNumber, test := range tests {
+
+		if expected, actual := test.ExpectedHas, test.Key.HasPrefix(test.Prefix); expected != actual {
+			t.Errorf("For test #%d, expected %#v.HasPrefix(%#v) to be %t, but actually got %t.", testNumber, test.Key, test.Prefix, expected, actual)
+			continue
+		}
+
+		if expected, actual := test.ExpectedTrimmed, test.Key.TrimPrefix(test.Prefix); expected != actual {
+			t.Errorf("For test #%d, expected %#v.TrimPrefix(%#v) to be %#v, but actually got %#v.", testNumber, test.Key, test.Prefix, expected, actual)
+			continue
+		}
+	}
+}
+
+func TestKeyCompare(t *testing.T) {
+
+	// These are in sorted order.
+	var keys []mdl.Key = []mdl.Key{
+		mdl.NoKey(),
+		mdl.SomeKey(""),
+		mdl.SomeKey("", ""),
+		mdl.SomeKey("", "a"),
+		mdl.SomeKey(" "),
+		mdl.SomeKey("!"),
+		mdl.SomeKey("/"),
+		mdl.SomeKey("a"),
+		mdl.SomeKey("a", ""),
+		mdl.SomeKey("a", "b"),
+		mdl.SomeKey("a", "b", "c"),
+		mdl.SomeKey("a", "c"),
+		mdl.SomeKey("a", "😀"),
+		mdl.SomeKey("a "),
+		mdl.SomeKey("a!"),
+		mdl.SomeKey("a/b"),
+		mdl.SomeKey("a\\"),
+		mdl.SomeKey("ab"),
+		mdl.SomeKey("b"),
+		mdl.SomeKey("{"),
+		mdl.SomeKey(" "),
+		mdl.SomeKey("😀"),
+	}
+
+	for i, a := range keys {
+		for j, b := range keys {
+			var expected int
+			switch {
+			case i < j:
+				expected = -1
+			case i > j:
+				expected = 1
+			}
+
+			if actual := a.Compare(b); expected != actual {
+				t.Errorf("Expected %#v.Compare(%#v) to be %d, but actually got %d.", a, b, expected, actual)
+				continue
+			}
+		}
+	}
+
+	var shuffled []mdl.Key = make([]mdl.Key, len(keys))
+	for i, key := range keys {
+		shuffled[(i*7)%len(keys)] = key
+	}
+
+	sort.Slice(shuffled, func(i, j int) bool {
+		return shuffled[i].Compare(shuffled[j]) < 0
+	})
+
+	for i := range keys {
+		if expected, actual := keys[i], shuffled[i]; expected != actual {
+			t.Errorf("For #%d, expected %#v, but actually got %#v.", i, expected, actual)
+			continue
+		}
+	}
+}
+
+func TestKeyPathDoesNotAllocate(t *testing.T) {
+
+	var key mdl.Key = mdl.SomeKey("database", "primary", "username")
+	var other mdl.Key = mdl.SomeKey("database", "replica")
+	var prefix mdl.Key = mdl.SomeKey("database")
+
+	var sink interface{}
+
+	allocs := testing.AllocsPerRun(100, func() {
+		var length int = key.Len()
+		var token mdl.String = key.Token(1)
+		var base mdl.String = key.Base()
+		var parent mdl.Key = key.Parent()
+		var has bool = key.HasPrefix(prefix)
+		var trimmed mdl.Key = key.TrimPrefix(prefix)
+		var compared int = key.Compare(other)
+
+		if 0 == length || mdl.NoString() == token || mdl.NoString() == base || mdl.NoKey() == parent || !has || mdl.NoKey() == trimmed || 0 == compared {
+			sink = key
+		}
+	})
+
+	if nil != sink {
+		t.Errorf("This should never happen.")
+		return
+	}
+
+	if 0 != allocs {
+		t.Errorf("Expected no allocations, but actually got %v.", allocs)
+		return
+	}
+}
@@ -0,0 +1,162 @@
+package mdl_test
+
+import (
+	"github.com/reiver/go-mdl"
+
+	"testing"
+)
+
+func TestKeyScan(t *testing.T) {
+
+	tests := []struct{
+		Src      interface{}
+		Expected mdl.Key
+	}{
+		{
+			Src:      nil,
+			Expected: mdl.NoKey(),
+		},
+		{
+			Src:      []byte(nil),
+			Expected: mdl.NoKey(),
+		},
+		{
+			Src:      mdl.NoString(),
+			Expected: mdl.NoKey(),
+		},
+		{
+			Src:      mdl.NoKey(),
+			Expected: mdl.NoKey(),
+		},
+
+
+
+		{
+			Src:      "",
+			Expected: mdl.SomeKey(""),
+		},
+		{
+			Src:      "database",
+			Expected: mdl.SomeKey("database"),
+		},
+		{
+			Src:      "database/password",
+			Expected: mdl.SomeKey("database", "password"),
+		},
+		{
+			Src:      []byte("database/password"),
+			Expected: mdl.SomeKey("database", "password"),
+		},
+		{
+			Src:      mdl.SomeString("database/password"),
+			Expected: mdl.SomeKey("database", "password"),
+		},
+		{
+			Src:      mdl.SomeKey("database", "password"),
+			Expected: mdl.SomeKey("database", "password"),
+		},
+		{
+			Src:      `ac\/dc/slightly\ smiling\ face/🙂`,
+			Expected: mdl.SomeKey("ac/dc", "slightly smiling face", "🙂"),
+		},
+		{
+			Src:      `a\b/c`,
+			Expected: mdl.SomeKey("ab", "c"),
+		},
+		{
+			Src:      "a//b",
+			Expected: mdl.SomeKey("a", "", "b"),
+		},
+	}
+
+	for testNumber, test := range tests {
+
+		var key mdl.Key
+		if err := key.Scan(test.Src); nil != err {
+			t.Errorf("For test #%d, did not expect an error, but actually got one: (%T) %q", testNumber, err, err)
+			t.Logf("SRC: %#v", test.Src)
+			continue
+		}
+
+		if expected, actual := test.Expected, key; expected != actual {
+			t.Errorf("For test #%d, expected %#v, but actually got %#v.", testNumber, expected, actual)
+			t.Logf("SRC: %#v", test.Src)
+			continue
+		}
+	}
+}
+
+func TestKeyScanValue(t *testing.T) {
+
+	keys := []mdl.Key{
+		mdl.SomeKey(""),
+		mdl.SomeKey("database", "password"),
+		mdl.SomeKey("ac/dc", `back\slash`, "{", "slightly smiling face"),
+	}
+
+	for testNumber, expected := range keys {
+
+		value, err := expected.Value()
+		if nil != err {
+			t.Errorf("For test #%d, did not expect an error, but actually got one: (%T) %q", testNumber, err, err)
+			continue
+		}
+
+		var actual mdl.Key
+		if err := actual.Scan(value); nil != err {
+			t.Errorf("For test #%d, did not expect an error, but actually got one: (%T) %q", testNumber, err, err)
+			continue
+		}
+
+		if expected != actual {
+			t.Errorf("For test #%d, expected %#v, but actually got %#v.", testNumber, expected, actual)
+			continue
+		}
+	}
+}
+
+func TestKeyScanMalformedKey(t *testing.T) {
+
+	tests := []string{
+		`database\`,
+		`database/password\`,
+		"database/\xff",
+		"\xc3",
+	}
+
+	for testNumber, src := range tests {
+
+		var key mdl.Key
+		err := key.Scan(src)
+		if nil == err {
+			t.Errorf("For test #%d, expected an error, but did not actually get one.", testNumber)
+			t.Logf("SRC: %q", src)
+			continue
+		}
+
+		casted, ok := err.(mdl.MalformedKey)
+		if !ok {
+			t.Errorf("For test #%d, expected a mdl.MalformedKey error, but actually got: (%T) %q", testNumber, err, err)
+			continue
+		}
+
+		if expected, actual := src, casted.Serialized(); expected != actual {
+			t.Errorf("For test #%d, expected serialized %q, but actually got %q.", testNumber, expected, actual)
+			continue
+		}
+
+		if err := key.UnmarshalText([]byte(src)); nil == err {
+			t.Errorf("For test #%d, expected an error from UnmarshalText, but did not actually get one.", testNumber)
+			continue
+		}
+	}
+
+	{
+		var key mdl.Key
+		if err := key.Scan(5); nil == err {
+			t.Errorf("Expected an error, but did not actually get one.")
+		} else if _, casted := err.(mdl.UnsupportedSource); !casted {
+			t.Errorf("Expected a mdl.UnsupportedSource error, but actually got: (%T) %q", err, err)
+		}
+	}
+}
@@ -3,6 +3,7 @@ package mdl_test
 import (
 	"github.com/reiver/go-mdl"
 
+	"fmt"
 	"reflect"
 	"strings"
 
@@ -17,6 +17,8 @@ import (
 //
 //	key := mdl.KeyDeserialize("database/password") // == []string{"database", "password"}
 //
+// If ‘serialized’ is not valid (i.e., it ends with a lone backslash, or is not valid UTF-8), then KeyDeserialize returns an ‘mdl.MalformedKey’ error.
+//
 // This is a more low-level function. Usually you will probably want to use the type ‘mdl.Key’.
 func KeyDeserialize(serialized string) ([]string, error) {
 	if "" == serialized {
@@ -32,8 +34,8 @@ func KeyDeserialize(serialized string) ([]string, error) {
 	var s string = serialized
 	for 0 < len(s) {
 		r, size := utf8.DecodeRuneInString(s)
-		if utf8.RuneError == r {
-			return result, errRuneError
+		if utf8.RuneError == r && 1 == size {
+			return result, malformedKey(serialized, errRuneError)
 		}
 		if 0 >= size {
 			return result, errInternalError
@@ -54,6 +56,9 @@ func KeyDeserialize(serialized string) ([]string, error) {
 		}
 
 		if 0 == len(s) {
+			if escaped {
+				return result, malformedKey(serialized, errKeyTrailingBackslash)
+			}
 			result = append(result, builder.String())
 		}
 	}
@@ -0,0 +1,343 @@
+package mdl
+
+import (
+	"strings"
+)
+
+// Append returns the ‘mdl.Key’ with ‘tokens’ added to the end of it.
+//
+// Example
+//
+//	key := mdl.SomeKey("database").Append("username") // == mdl.SomeKey("database", "username")
+//
+// mdl.NoKey().Append(tokens...) is the same as mdl.SomeKey(tokens...).
+func (receiver Key) Append(tokens ...string) Key {
+	if 0 == len(tokens) {
+		return receiver
+	}
+	if NoKey() == receiver {
+		return SomeKey(tokens...)
+	}
+
+	return Key{
+		encoded: SomeString(receiver.encoded.datum + "/" + KeySerialize(tokens...)),
+	}
+}
+
+// Base returns the last token of the ‘mdl.Key’.
+//
+// Example
+//
+//	base := mdl.SomeKey("database", "username").Base() // == mdl.SomeString("username")
+//
+// mdl.NoKey().Base() returns mdl.NoString().
+func (receiver Key) Base() String {
+	if NoKey() == receiver {
+		return NoString()
+	}
+
+	encoded := receiver.encoded.datum
+
+	var begin int
+	for index := keyNextSeparator(encoded, 0); 0 <= index; index = keyNextSeparator(encoded, begin) {
+		begin = index + 1
+	}
+
+	return SomeString(keyTokenDecode(encoded[begin:]))
+}
+
+// Compare returns an integer comparing 2 ‘mdl.Key’.
+// The result is 0 if receiver == other, -1 if receiver < other, and +1 if receiver > other.
+//
+// Keys are compared token by token (and each token byte by byte); so a key comes right before the keys that start with it.
+// mdl.NoKey() comes before every other key.
+//
+// Example
+//
+//	sort.Slice(keys, func(i, j int) bool {
+//		return keys[i].Compare(keys[j]) < 0
+//	})
+//
+// For example, these are in sorted order:
+//
+//	mdl.SomeKey("a")
+//	mdl.SomeKey("a", "b")
+//	mdl.SomeKey("a", "c")
+//	mdl.SomeKey("a!")
+//	mdl.SomeKey("b")
+func (receiver Key) Compare(other Key) int {
+	switch {
+	case NoKey() == receiver && NoKey() == other:
+		return 0
+	case NoKey() == receiver:
+		return -1
+	case NoKey() == other:
+		return 1
+	}
+
+	a := receiver.encoded.datum
+	b := other.encoded.datum
+
+	for {
+		var unitA, unitB int
+		unitA, a = keyNextUnit(a)
+		unitB, b = keyNextUnit(b)
+
+		switch {
+		case unitA < unitB:
+			return -1
+		case unitA > unitB:
+			return 1
+		case keyUnitEnd == unitA:
+			return 0
+		}
+	}
+}
+
+// HasPrefix returns whether the ‘mdl.Key’ starts with the tokens of ‘prefix’.
+//
+// Example
+//
+//	key := mdl.SomeKey("database", "username")
+//
+//	key.HasPrefix(mdl.SomeKey("database"))             // == true
+//	key.HasPrefix(mdl.SomeKey("database", "username")) // == true
+//	key.HasPrefix(mdl.SomeKey("data"))                 // == false
+//
+// Every key has mdl.NoKey() as a prefix.
+func (receiver Key) HasPrefix(prefix Key) bool {
+	_, has := receiver.trimPrefix(prefix)
+	return has
+}
+
+// Join returns the ‘mdl.Key’ with the tokens of ‘other’ added to the end of it.
+//
+// Example
+//
+//	key := mdl.SomeKey("database").Join(mdl.SomeKey("primary", "username")) // == mdl.SomeKey("database", "primary", "username")
+//
+// Joining with mdl.NoKey() (on either side) returns the other key.
+func (receiver Key) Join(other Key) Key {
+	switch {
+	case NoKey() == other:
+		return receiver
+	case NoKey() == receiver:
+		return other
+	}
+
+	return Key{
+		encoded: SomeString(receiver.encoded.datum + "/" + other.encoded.datum),
+	}
+}
+
+// Len returns the number of tokens in the ‘mdl.Key’.
+//
+// Example
+//
+//	length := mdl.SomeKey("database", "username").Len() // == 2
+//
+// mdl.NoKey().Len() returns 0.
+func (receiver Key) Len() int {
+	if NoKey() == receiver {
+		return 0
+	}
+
+	encoded := receiver.encoded.datum
+
+	var length int = 1
+	for index := keyNextSeparator(encoded, 0); 0 <= index; index = keyNextSeparator(encoded, index+1) {
+		length++
+	}
+
+	return length
+}
+
+// Parent returns the ‘mdl.Key’ without its last token.
+//
+// Example
+//
+//	parent := mdl.SomeKey("database", "username").Parent() // == mdl.SomeKey("database")
+//
+// A key with only 1 token (and mdl.NoKey()) has mdl.NoKey() as its parent.
+func (receiver Key) Parent() Key {
+	if NoKey() == receiver {
+		return NoKey()
+	}
+
+	encoded := receiver.encoded.datum
+
+	var last int = -1
+	for index := keyNextSeparator(encoded, 0); 0 <= index; index = keyNextSeparator(encoded, index+1) {
+		last = index
+	}
+	if last < 0 {
+		return NoKey()
+	}
+
+	return Key{
+		encoded: SomeString(encoded[:last]),
+	}
+}
+
+// Token returns the token at (zero-based) index ‘i’ of the ‘mdl.Key’.
+//
+// Example
+//
+//	key := mdl.SomeKey("database", "username")
+//
+//	token := key.Token(0) // == mdl.SomeString("database")
+//	token := key.Token(1) // == mdl.SomeString("username")
+//	token := key.Token(2) // == mdl.NoString()
+//
+// If ‘i’ is out of range, then Token returns mdl.NoString().
+func (receiver Key) Token(i int) String {
+	if NoKey() == receiver || i < 0 {
+		return NoString()
+	}
+
+	encoded := receiver.encoded.datum
+
+	var begin int
+	for ; 0 < i; i-- {
+		index := keyNextSeparator(encoded, begin)
+		if index < 0 {
+			return NoString()
+		}
+		begin = index + 1
+	}
+
+	end := keyNextSeparator(encoded, begin)
+	if end < 0 {
+		end = len(encoded)
+	}
+
+	return SomeString(keyTokenDecode(encoded[begin:end]))
+}
+
+// TrimPrefix returns the ‘mdl.Key’ without the tokens of ‘prefix’ at the start of it.
+//
+// Example
+//
+//	key := mdl.SomeKey("database", "primary", "username").TrimPrefix(mdl.SomeKey("database")) // == mdl.SomeKey("primary", "username")
+//
+// If the key does not start with ‘prefix’ (see .HasPrefix()), then TrimPrefix returns the key unchanged.
+// If the key is the same as ‘prefix’, then TrimPrefix returns mdl.NoKey().
+func (receiver Key) TrimPrefix(prefix Key) Key {
+	trimmed, has := receiver.trimPrefix(prefix)
+	if !has {
+		return receiver
+	}
+
+	return trimmed
+}
+
+// tokens returns the tokens of the ‘mdl.Key’.
+//
+// Unlike .ElseUnwrap(), mdl.SomeKey("").tokens() returns 1 (empty) token, rather than none.
+func (receiver Key) tokens() []string {
+	if NoKey() == receiver {
+		return nil
+	}
+
+	encoded := receiver.encoded.datum
+
+	var tokens []string = make([]string, 0, receiver.Len())
+
+	var begin int
+	for {
+		end := keyNextSeparator(encoded, begin)
+		if end < 0 {
+			return append(tokens, keyTokenDecode(encoded[begin:]))
+		}
+		tokens = append(tokens, keyTokenDecode(encoded[begin:end]))
+		begin = end + 1
+	}
+}
+
+func (receiver Key) trimPrefix(prefix Key) (Key, bool) {
+	if NoKey() == prefix {
+		return receiver, true
+	}
+	if NoKey() == receiver {
+		return NoKey(), false
+	}
+
+	encoded := receiver.encoded.datum
+	encodedPrefix := prefix.encoded.datum
+
+	if !strings.HasPrefix(encoded, encodedPrefix) {
+		return NoKey(), false
+	}
+
+	rest := encoded[len(encodedPrefix):]
+	switch {
+	case "" == rest:
+		return NoKey(), true
+	// Because a key in canonical form never ends with a lone backslash, this "/" cannot be an escaped one.
+	case '/' == rest[0]:
+		return Key{encoded: SomeString(rest[1:])}, true
+	default:
+		return NoKey(), false
+	}
+}
+
+const (
+	keyUnitEnd       = -2
+	keyUnitSeparator = -1
+)
+
+// keyNextSeparator returns the index of the first unescaped "/" in ‘encoded’, at or after ‘from’; or -1 if there is none.
+//
+// Since an escaped rune is always a backslash followed by an ASCII character, or by a whole (multi-byte) rune, this can work byte by byte.
+func keyNextSeparator(encoded string, from int) int {
+	for i := from; i < len(encoded); i++ {
+		switch encoded[i] {
+		case '\\':
+			i++
+		case '/':
+			return i
+		}
+	}
+
+	return -1
+}
+
+// keyNextUnit returns the next byte of ‘encoded’ (unescaped), keyUnitSeparator for an unescaped "/", or keyUnitEnd at the end;
+// along with what comes after it.
+func keyNextUnit(encoded string) (int, string) {
+	if "" == encoded {
+		return keyUnitEnd, encoded
+	}
+
+	switch b := encoded[0]; {
+	case '/' == b:
+		return keyUnitSeparator, encoded[1:]
+	case '\\' == b && 2 <= len(encoded):
+		return int(encoded[1]), encoded[2:]
+	default:
+		return int(b), encoded[1:]
+	}
+}
+
+// keyTokenDecode returns a single encoded token with its escaping removed.
+// (It only allocates if there is escaping to remove.)
+func keyTokenDecode(encoded string) string {
+	if strings.IndexByte(encoded, '\\') < 0 {
+		return encoded
+	}
+
+	var builder strings.Builder
+	builder.Grow(len(encoded))
+
+	for i := 0; i < len(encoded); i++ {
+		if '\\' == encoded[i] {
+			i++
+			if len(encoded) <= i {
+				break
+			}
+		}
+		builder.WriteByte(encoded[i])
+	}
+
+	return builder.String()
+}
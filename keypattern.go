@@ -0,0 +1,256 @@
+package mdl
+
+import (
+	"strconv"
+	"unicode/utf8"
+)
+
+// KeyPattern represents a compiled pattern that an ‘mdl.Key’ can be matched against.
+//
+// A key pattern is written like a key in its canonical form (ex: “address/street”), except that a token can also be a wildcard:
+//
+// • “*” matches exactly 1 token, and
+//
+// • “**” matches 0 or more tokens.
+//
+// A wildcard must be a whole token; so, for example, “addr*” is not valid. To match a literal asterisk, escape it with a backslash (ex: `addr\*`),
+// the same way that “/” and “\” are escaped in the canonical form of a key.
+//
+// The tokens that each wildcard matched can be gotten with .Captures().
+//
+// Example
+//
+//	pattern, err := mdl.CompileKeyPattern("items/**/price")
+//	if nil != err {
+//		return err
+//	}
+//
+//	pattern.Match(mdl.SomeKey("items", "price"))                 // == true
+//	pattern.Match(mdl.SomeKey("items", "3", "options", "price")) // == true
+//	pattern.Match(mdl.SomeKey("items", "3", "name"))             // == false
+//
+// The zero value of ‘mdl.KeyPattern’ does not match any key.
+type KeyPattern struct {
+	pattern string
+	tokens []keyPatternToken
+}
+
+type keyPatternToken struct {
+	kind    int
+	literal string
+}
+
+const (
+	keyPatternLiteral = iota
+	keyPatternOne
+	keyPatternMany
+)
+
+// CompileKeyPattern compiles ‘pattern’ into an ‘mdl.KeyPattern’.
+//
+// If ‘pattern’ is not valid (i.e., it ends with a lone backslash, is not valid UTF-8, or has an unescaped “*” that is not a whole token),
+// then CompileKeyPattern returns an ‘mdl.MalformedKey’ error.
+func CompileKeyPattern(pattern string) (KeyPattern, error) {
+	if !utf8.ValidString(pattern) {
+		return KeyPattern{}, malformedKey(pattern, errRuneError)
+	}
+
+	var tokens []keyPatternToken
+
+	var begin int
+	for {
+		end := keyNextSeparator(pattern, begin)
+		if end < 0 {
+			end = len(pattern)
+		}
+
+		token, err := keyPatternCompileToken(pattern[begin:end])
+		if nil != err {
+			return KeyPattern{}, malformedKey(pattern, err)
+		}
+		tokens = append(tokens, token)
+
+		if len(pattern) <= end {
+			break
+		}
+		begin = end + 1
+	}
+
+	return KeyPattern{
+		pattern: pattern,
+		tokens: tokens,
+	}, nil
+}
+
+// MustCompileKeyPattern is like mdl.CompileKeyPattern(), except that it panics if ‘pattern’ is not valid.
+//
+// It is meant for initializing package-level variables.
+//
+// Example
+//
+//	var pricePattern = mdl.MustCompileKeyPattern("items/*/price")
+func MustCompileKeyPattern(pattern string) KeyPattern {
+	compiled, err := CompileKeyPattern(pattern)
+	if nil != err {
+		panic(err)
+	}
+
+	return compiled
+}
+
+// Captures returns the tokens matched by each of the wildcards in the ‘mdl.KeyPattern’, in order, if ‘key’ matches it.
+//
+// Each capture is returned as an ‘mdl.Key’. A “*” always captures a key with 1 token; and a “**” captures a key with
+// however many tokens it matched (which is mdl.NoKey() if it matched 0 tokens).
+//
+// If a “**” could match more than one way (ex: “**/b/**” matched against “a/b/c/b/d”), then the earlier “**” matches as few tokens as it can.
+//
+// Example
+//
+//	pattern := mdl.MustCompileKeyPattern("users/*/addresses/**")
+//
+//	captures, matched := pattern.Captures(mdl.SomeKey("users", "joeblow", "addresses", "home", "street"))
+//	// matched     == true
+//	// captures[0] == mdl.SomeKey("joeblow")
+//	// captures[1] == mdl.SomeKey("home", "street")
+//
+// If ‘key’ does not match, then Captures returns nil and false.
+func (receiver KeyPattern) Captures(key Key) ([]Key, bool) {
+	if NoKey() == key || 0 == len(receiver.tokens) {
+		return nil, false
+	}
+
+	var matcher keyPatternMatcher = keyPatternMatcher{
+		pattern: receiver.tokens,
+		tokens: key.tokens(),
+		failed: map[[2]int]struct{}{},
+		capture: true,
+	}
+
+	if !matcher.match(0, 0) {
+		return nil, false
+	}
+
+	return matcher.captures, true
+}
+
+// GoString makes ‘mdl.KeyPattern’ fit the fmt.GoStringer interface.
+//
+// It gets used with the %#v verb with the printing family of functions
+// in the Go built-in "fmt" package.
+func (receiver KeyPattern) GoString() string {
+	if 0 == len(receiver.tokens) {
+		return "mdl.KeyPattern{}"
+	}
+
+	return "mdl.MustCompileKeyPattern(" + strconv.Quote(receiver.pattern) + ")"
+}
+
+// Match returns whether ‘key’ matches the ‘mdl.KeyPattern’.
+//
+// mdl.NoKey() does not match any key pattern.
+func (receiver KeyPattern) Match(key Key) bool {
+	if NoKey() == key || 0 == len(receiver.tokens) {
+		return false
+	}
+
+	var matcher keyPatternMatcher = keyPatternMatcher{
+		pattern: receiver.tokens,
+		tokens: key.tokens(),
+		failed: map[[2]int]struct{}{},
+	}
+
+	return matcher.match(0, 0)
+}
+
+// Pattern returns the pattern that the ‘mdl.KeyPattern’ was compiled from.
+func (receiver KeyPattern) Pattern() string {
+	return receiver.pattern
+}
+
+type keyPatternMatcher struct {
+	pattern  []keyPatternToken
+	tokens   []string
+	failed   map[[2]int]struct{}
+	capture  bool
+	captures []Key
+}
+
+// match returns whether the pattern tokens from ‘p’ on match the key tokens from ‘k’ on.
+//
+// Which (p, k) pairs have already failed to match is remembered; so that patterns with many “**” do not take exponential time.
+func (receiver *keyPatternMatcher) match(p int, k int) bool {
+	if len(receiver.pattern) <= p {
+		return len(receiver.tokens) <= k
+	}
+
+	state := [2]int{p, k}
+	if _, failed := receiver.failed[state]; failed {
+		return false
+	}
+
+	token := receiver.pattern[p]
+
+	switch token.kind {
+	case keyPatternMany:
+		for end := k; end <= len(receiver.tokens); end++ {
+			mark := len(receiver.captures)
+			receiver.push(receiver.tokens[k:end]...)
+			if receiver.match(p+1, end) {
+				return true
+			}
+			receiver.captures = receiver.captures[:mark]
+		}
+
+	case keyPatternOne:
+		if k < len(receiver.tokens) {
+			mark := len(receiver.captures)
+			receiver.push(receiver.tokens[k])
+			if receiver.match(p+1, k+1) {
+				return true
+			}
+			receiver.captures = receiver.captures[:mark]
+		}
+
+	default:
+		if k < len(receiver.tokens) && token.literal == receiver.tokens[k] {
+			if receiver.match(p+1, k+1) {
+				return true
+			}
+		}
+	}
+
+	receiver.failed[state] = struct{}{}
+	return false
+}
+
+func (receiver *keyPatternMatcher) push(tokens ...string) {
+	if !receiver.capture {
+		return
+	}
+
+	receiver.captures = append(receiver.captures, SomeKey(tokens...))
+}
+
+func keyPatternCompileToken(raw string) (keyPatternToken, error) {
+	switch raw {
+	case "*":
+		return keyPatternToken{kind:keyPatternOne}, nil
+	case "**":
+		return keyPatternToken{kind:keyPatternMany}, nil
+	}
+
+	for i := 0; i < len(raw); i++ {
+		switch raw[i] {
+		case '\\':
+			i++
+			if len(raw) <= i {
+				return keyPatternToken{}, errKeyTrailingBackslash
+			}
+		case '*':
+			return keyPatternToken{}, errKeyPatternPartialWildcard
+		}
+	}
+
+	return keyPatternToken{kind:keyPatternLiteral, literal:keyTokenDecode(raw)}, nil
+}
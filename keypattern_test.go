@@ -0,0 +1,240 @@
+package mdl_test
+
+import (
+	"github.com/reiver/go-mdl"
+
+	"fmt"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestKeyPatternCaptures(t *testing.T) {
+
+	tests := []struct{
+		Pattern          string
+		Key              mdl.Key
+		ExpectedMatched  bool
+		ExpectedCaptures []mdl.Key
+	}{
+		{
+			Pattern: "address/street",
+			Key: mdl.SomeKey("address", "street"),
+			ExpectedMatched: true,
+			ExpectedCaptures: nil,
+		},
+		{
+			Pattern: "address/street",
+			Key: mdl.SomeKey("address", "city"),
+			ExpectedMatched: false,
+		},
+		{
+			Pattern: "address/*",
+			Key: mdl.SomeKey("address", "city"),
+			ExpectedMatched: true,
+			ExpectedCaptures: []mdl.Key{mdl.SomeKey("city")},
+		},
+		{
+			Pattern: "address/*",
+			Key: mdl.SomeKey("address"),
+			ExpectedMatched: false,
+		},
+		{
+			Pattern: "address/*",
+			Key: mdl.SomeKey("address", "home", "city"),
+			ExpectedMatched: false,
+		},
+		{
+			Pattern: "address/**",
+			Key: mdl.SomeKey("address"),
+			ExpectedMatched: true,
+			ExpectedCaptures: []mdl.Key{mdl.NoKey()},
+		},
+		{
+			Pattern: "address/**",
+			Key: mdl.SomeKey("address", "home", "city"),
+			ExpectedMatched: true,
+			ExpectedCaptures: []mdl.Key{mdl.SomeKey("home", "city")},
+		},
+		{
+			Pattern: "items/**/price",
+			Key: mdl.SomeKey("items", "price"),
+			ExpectedMatched: true,
+			ExpectedCaptures: []mdl.Key{mdl.NoKey()},
+		},
+		{
+			Pattern: "items/**/price",
+			Key: mdl.SomeKey("items", "3", "options", "price"),
+			ExpectedMatched: true,
+			ExpectedCaptures: []mdl.Key{mdl.SomeKey("3", "options")},
+		},
+		{
+			Pattern: "items/**/price",
+			Key: mdl.SomeKey("items", "3", "name"),
+			ExpectedMatched: false,
+		},
+		{
+			Pattern: "users/*/addresses/**",
+			Key: mdl.SomeKey("users", "joeblow", "addresses", "home", "street"),
+			ExpectedMatched: true,
+			ExpectedCaptures: []mdl.Key{mdl.SomeKey("joeblow"), mdl.SomeKey("home", "street")},
+		},
+		{
+			Pattern: "**/b/**",
+			Key: mdl.SomeKey("a", "b", "c", "b", "d"),
+			ExpectedMatched: true,
+			ExpectedCaptures: []mdl.Key{mdl.SomeKey("a"), mdl.SomeKey("c", "b", "d")},
+		},
+		{
+			Pattern: "**",
+			Key: mdl.SomeKey(""),
+			ExpectedMatched: true,
+			ExpectedCaptures: []mdl.Key{mdl.SomeKey("")},
+		},
+		{
+			Pattern: "**",
+			Key: mdl.NoKey(),
+			ExpectedMatched: false,
+		},
+		{
+			Pattern: "*/*",
+			Key: mdl.SomeKey("i/o", "x"),
+			ExpectedMatched: true,
+			ExpectedCaptures: []mdl.Key{mdl.SomeKey("i/o"), mdl.SomeKey("x")},
+		},
+		{
+			Pattern: `i\/o/*`,
+			Key: mdl.SomeKey("i/o", "x"),
+			ExpectedMatched: true,
+			ExpectedCaptures: []mdl.Key{mdl.SomeKey("x")},
+		},
+		{
+			Pattern: `i\/o/*`,
+			Key: mdl.SomeKey("i", "o", "x"),
+			ExpectedMatched: false,
+		},
+		{
+			Pattern: `prices/\*`,
+			Key: mdl.SomeKey("prices", "*"),
+			ExpectedMatched: true,
+			ExpectedCaptures: nil,
+		},
+		{
+			Pattern: `prices/\*`,
+			Key: mdl.SomeKey("prices", "usd"),
+			ExpectedMatched: false,
+		},
+		{
+			Pattern: `prices/\*\*`,
+			Key: mdl.SomeKey("prices", "**"),
+			ExpectedMatched: true,
+			ExpectedCaptures: nil,
+		},
+		{
+			Pattern: `a\*b/*`,
+			Key: mdl.SomeKey("a*b", "c"),
+			ExpectedMatched: true,
+			ExpectedCaptures: []mdl.Key{mdl.SomeKey("c")},
+		},
+		{
+			Pattern: `has\ space`,
+			Key: mdl.SomeKey("has space"),
+			ExpectedMatched: true,
+			ExpectedCaptures: nil,
+		},
+	}
+
+	for testNumber, test := range tests {
+
+		pattern, err := mdl.CompileKeyPattern(test.Pattern)
+		if nil != err {
+			t.Errorf("For test #%d, did not expect an error, but actually got one: (%T) %q", testNumber, err, err)
+			continue
+		}
+
+		if expected, actual := test.ExpectedMatched, pattern.Match(test.Key); expected != actual {
+			t.Errorf("For test #%d, expected %q .Match(%#v) to be %t, but actually got %t.", testNumber, test.Pattern, test.Key, expected, actual)
+			continue
+		}
+
+		captures, matched := pattern.Captures(test.Key)
+		if expected, actual := test.ExpectedMatched, matched; expected != actual {
+			t.Errorf("For test #%d, expected %q .Captures(%#v) to have matched %t, but actually got %t.", testNumber, test.Pattern, test.Key, expected, actual)
+			continue
+		}
+
+		if expected, actual := test.ExpectedCaptures, captures; !reflect.DeepEqual(expected, actual) {
+			t.Errorf("For test #%d, expected %q .Captures(%#v) to be %#v, but actually got %#v.", testNumber, test.Pattern, test.Key, expected, actual)
+			continue
+		}
+	}
+}
+
+func TestKeyPatternMalformed(t *testing.T) {
+
+	tests := []string{
+		`address/street\`,
+		"address/*street",
+		"address/street*",
+		"***",
+		"a/**b",
+		"\xff",
+	}
+
+	for testNumber, test := range tests {
+
+		_, err := mdl.CompileKeyPattern(test)
+		if nil == err {
+			t.Errorf("For test #%d, expected an error, but did not actually get one.", testNumber)
+			t.Logf("PATTERN: %q", test)
+			continue
+		}
+
+		casted, ok := err.(mdl.MalformedKey)
+		if !ok {
+			t.Errorf("For test #%d, expected a mdl.MalformedKey error, but actually got: (%T) %q", testNumber, err, err)
+			continue
+		}
+
+		if expected, actual := test, casted.Serialized(); expected != actual {
+			t.Errorf("For test #%d, expected %q, but actually got %q.", testNumber, expected, actual)
+			continue
+		}
+	}
+}
+
+func TestKeyPatternZero(t *testing.T) {
+
+	var pattern mdl.KeyPattern
+
+	if pattern.Match(mdl.SomeKey("")) {
+		t.Errorf("Expected the zero value of mdl.KeyPattern not to match, but it actually did.")
+		return
+	}
+
+	if expected, actual := "mdl.KeyPattern{}", fmt.Sprintf("%#v", pattern); expected != actual {
+		t.Errorf("Expected %q, but actually got %q.", expected, actual)
+		return
+	}
+}
+
+func TestKeyPatternManyWildcards(t *testing.T) {
+
+	// Without remembering which states have already failed, this would take exponential time.
+	pattern := mdl.MustCompileKeyPattern(strings.Repeat("**/", 20) + "z")
+
+	var tokens []string
+	for i := 0; i < 40; i++ {
+		tokens = append(tokens, "a")
+	}
+
+	if pattern.Match(mdl.SomeKey(tokens...)) {
+		t.Errorf("Did not expect a match, but actually got one.")
+		return
+	}
+
+	if !pattern.Match(mdl.SomeKey(append(tokens, "z")...)) {
+		t.Errorf("Expected a match, but did not actually get one.")
+		return
+	}
+}
@@ -0,0 +1,132 @@
+package mdl
+
+import (
+	"fmt"
+	"strings"
+)
+
+// KeySplitter turns the name of an HTML form field into an ‘mdl.Key’.
+//
+// It is used by ‘mdl.InstructionDecoder’ for the field names of “application/x-www-form-urlencoded” and
+// “multipart/form-data” HTTP request bodies. (A JSON HTTP request body already has its own hierarchy.)
+//
+// This package provides: mdl.KeySplitShallow, mdl.KeySplitBrackets, mdl.KeySplitDots, and mdl.KeySplitCanonical.
+//
+// Example
+//
+//	var decoder = mdl.InstructionDecoder{
+//		KeySplitter: mdl.KeySplitBrackets,
+//	}
+type KeySplitter func(name string) (Key, error)
+
+// KeySplitShallow is an ‘mdl.KeySplitter’ that does not split the name at all.
+//
+// Example
+//
+//	key, err := mdl.KeySplitShallow("address[street]") // == mdl.SomeKey("address[street]")
+//
+// This is what ‘mdl.InstructionDecoder’ uses if its KeySplitter is nil.
+func KeySplitShallow(name string) (Key, error) {
+	return SomeKey(name), nil
+}
+
+// KeySplitBrackets is an ‘mdl.KeySplitter’ that splits names written in the style used by PHP and Ruby on Rails.
+//
+// Example
+//
+//	key, err := mdl.KeySplitBrackets("address[street]")     // == mdl.SomeKey("address", "street")
+//	key, err := mdl.KeySplitBrackets("user[address][city]") // == mdl.SomeKey("user", "address", "city")
+//	key, err := mdl.KeySplitBrackets("email_address")       // == mdl.SomeKey("email_address")
+//
+// A trailing “[]” (ex: “tags[]”) is dropped; since each of the values sent for “tags[]” is kept
+// (see .LoadAll() on ‘mdl.KeyValues’), “tags[]=a&tags[]=b” is stored as 2 values under mdl.SomeKey("tags").
+//
+// A name with unbalanced brackets, an empty “[]” that is not at the end, or anything between a “]” and the next “[”,
+// is an error.
+func KeySplitBrackets(name string) (Key, error) {
+	index := strings.IndexByte(name, '[')
+	if index < 0 {
+		return SomeKey(name), nil
+	}
+	if 0 == index {
+		return NoKey(), fmt.Errorf("mdl: field name %q has nothing before its first “[”", name)
+	}
+
+	var tokens []string = []string{name[:index]}
+
+	var s string = name[index:]
+	for 0 < len(s) {
+		if '[' != s[0] {
+			return NoKey(), fmt.Errorf("mdl: field name %q has something other than “[” after a “]”", name)
+		}
+
+		end := strings.IndexByte(s, ']')
+		if end < 0 {
+			return NoKey(), fmt.Errorf("mdl: field name %q has a “[” without a matching “]”", name)
+		}
+
+		token := s[1:end]
+		s = s[end+1:]
+
+		if strings.IndexByte(token, '[') >= 0 {
+			return NoKey(), fmt.Errorf("mdl: field name %q has a “[” inside of brackets", name)
+		}
+
+		if "" == token {
+			if 0 != len(s) {
+				return NoKey(), fmt.Errorf("mdl: field name %q has a “[]” that is not at the end", name)
+			}
+			break
+		}
+
+		tokens = append(tokens, token)
+	}
+
+	return SomeKey(tokens...), nil
+}
+
+// KeySplitDots is an ‘mdl.KeySplitter’ that splits names on each “.”.
+//
+// Example
+//
+//	key, err := mdl.KeySplitDots("address.street")     // == mdl.SomeKey("address", "street")
+//	key, err := mdl.KeySplitDots("user.address.city")  // == mdl.SomeKey("user", "address", "city")
+//	key, err := mdl.KeySplitDots("email_address")      // == mdl.SomeKey("email_address")
+//
+// A name with an empty part (ex: “address..street”, “.street”, or “address.”) is an error.
+func KeySplitDots(name string) (Key, error) {
+	tokens := strings.Split(name, ".")
+	if 1 == len(tokens) {
+		return SomeKey(name), nil
+	}
+
+	for _, token := range tokens {
+		if "" == token {
+			return NoKey(), fmt.Errorf("mdl: field name %q has an empty part", name)
+		}
+	}
+
+	return SomeKey(tokens...), nil
+}
+
+// KeySplitCanonical is an ‘mdl.KeySplitter’ for names written in the canonical form of ‘mdl.Key’
+// (i.e., split on each “/”, with “\” escaping the character after it).
+//
+// Example
+//
+//	key, err := mdl.KeySplitCanonical("address/street") // == mdl.SomeKey("address", "street")
+//	key, err := mdl.KeySplitCanonical(`i\/o`)           // == mdl.SomeKey("i/o")
+//
+// See also mdl.KeyDeserialize().
+func KeySplitCanonical(name string) (Key, error) {
+	if "" == name {
+		return SomeKey(name), nil
+	}
+
+	tokens, err := KeyDeserialize(name)
+	if nil != err {
+		return NoKey(), fmt.Errorf("mdl: field name %q is not a key in canonical form: %s", name, err)
+	}
+
+	return SomeKey(tokens...), nil
+}
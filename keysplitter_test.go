@@ -0,0 +1,174 @@
+package mdl_test
+
+import (
+	"github.com/reiver/go-mdl"
+
+	"testing"
+)
+
+func TestKeySplitter(t *testing.T) {
+
+	tests := []struct{
+		Name     string
+		Splitter mdl.KeySplitter
+		Expected mdl.Key
+	}{
+		{
+			Name:     "address[street]",
+			Splitter: mdl.KeySplitShallow,
+			Expected: mdl.SomeKey("address[street]"),
+		},
+
+
+
+		{
+			Name:     "email_address",
+			Splitter: mdl.KeySplitBrackets,
+			Expected: mdl.SomeKey("email_address"),
+		},
+		{
+			Name:     "address[street]",
+			Splitter: mdl.KeySplitBrackets,
+			Expected: mdl.SomeKey("address", "street"),
+		},
+		{
+			Name:     "user[address][city]",
+			Splitter: mdl.KeySplitBrackets,
+			Expected: mdl.SomeKey("user", "address", "city"),
+		},
+		{
+			Name:     "tags[]",
+			Splitter: mdl.KeySplitBrackets,
+			Expected: mdl.SomeKey("tags"),
+		},
+		{
+			Name:     "user[tags][]",
+			Splitter: mdl.KeySplitBrackets,
+			Expected: mdl.SomeKey("user", "tags"),
+		},
+		{
+			Name:     "i/o[a.b]",
+			Splitter: mdl.KeySplitBrackets,
+			Expected: mdl.SomeKey("i/o", "a.b"),
+		},
+
+
+
+		{
+			Name:     "email_address",
+			Splitter: mdl.KeySplitDots,
+			Expected: mdl.SomeKey("email_address"),
+		},
+		{
+			Name:     "address.street",
+			Splitter: mdl.KeySplitDots,
+			Expected: mdl.SomeKey("address", "street"),
+		},
+		{
+			Name:     "user.address.city",
+			Splitter: mdl.KeySplitDots,
+			Expected: mdl.SomeKey("user", "address", "city"),
+		},
+		{
+			Name:     "address[street]",
+			Splitter: mdl.KeySplitDots,
+			Expected: mdl.SomeKey("address[street]"),
+		},
+
+
+
+		{
+			Name:     "email_address",
+			Splitter: mdl.KeySplitCanonical,
+			Expected: mdl.SomeKey("email_address"),
+		},
+		{
+			Name:     "address/street",
+			Splitter: mdl.KeySplitCanonical,
+			Expected: mdl.SomeKey("address", "street"),
+		},
+		{
+			Name:     `i\/o/a\\b`,
+			Splitter: mdl.KeySplitCanonical,
+			Expected: mdl.SomeKey("i/o", `a\b`),
+		},
+	}
+
+	for testNumber, test := range tests {
+
+		actual, err := test.Splitter(test.Name)
+		if nil != err {
+			t.Errorf("For test #%d, did not expect an error, but actually got one: (%T) %q", testNumber, err, err)
+			t.Logf("NAME: %q", test.Name)
+			continue
+		}
+
+		if expected := test.Expected; expected != actual {
+			t.Errorf("For test #%d, expected %#v, but actually got %#v.", testNumber, expected, actual)
+			t.Logf("NAME: %q", test.Name)
+			continue
+		}
+	}
+}
+
+func TestKeySplitterError(t *testing.T) {
+
+	tests := []struct{
+		Name     string
+		Splitter mdl.KeySplitter
+	}{
+		{
+			Name:     "[street]",
+			Splitter: mdl.KeySplitBrackets,
+		},
+		{
+			Name:     "address[street",
+			Splitter: mdl.KeySplitBrackets,
+		},
+		{
+			Name:     "address[street]city",
+			Splitter: mdl.KeySplitBrackets,
+		},
+		{
+			Name:     "address[[street]]",
+			Splitter: mdl.KeySplitBrackets,
+		},
+		{
+			Name:     "tags[][name]",
+			Splitter: mdl.KeySplitBrackets,
+		},
+
+
+
+		{
+			Name:     "address..street",
+			Splitter: mdl.KeySplitDots,
+		},
+		{
+			Name:     ".street",
+			Splitter: mdl.KeySplitDots,
+		},
+		{
+			Name:     "address.",
+			Splitter: mdl.KeySplitDots,
+		},
+
+
+
+		{
+			Name:     "address/\xff",
+			Splitter: mdl.KeySplitCanonical,
+		},
+	}
+
+	for testNumber, test := range tests {
+
+		key, err := test.Splitter(test.Name)
+		if nil == err {
+			t.Errorf("For test #%d, expected an error, but did not actually get one.", testNumber)
+			t.Logf("NAME: %q", test.Name)
+			t.Logf("KEY: %#v", key)
+			continue
+		}
+	}
+}
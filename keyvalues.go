@@ -1,6 +1,9 @@
 package mdl
 
 import (
+	"sort"
+	"strconv"
+	"strings"
 	"sync"
 )
 
@@ -8,31 +11,148 @@ import (
 //
 // It stores key-value pairs.
 //
+// A key can have more than one value. (For example, an HTML form that was submitted with “tag=a&tag=b”.)
+// Use .Append() to add another value for a key, and .LoadAll() to get all the values for a key.
+//
+// Use .LoadInt64(), .LoadUint64(), .LoadFloat64(), .LoadBool(), .LoadTime(), .LoadDuration(), .LoadURL(), and .LoadEmailAddress()
+// to load a value as something other than a string.
+//
+// Use .For() (or .Range(), to be able to stop early) to go through the key-value pairs, sorted by key.
+//
+// Use .ForPrefix() to go through the key-value pairs whose keys start with a prefix (ex: everything under “database/”),
+// and .Sub() to get a read-only view of them with the prefix stripped.
+//
+// Use .Bind() to fill in a struct from the key-value pairs, rather than loading each value one at a time;
+// and .StoreStruct() to store a struct as key-value pairs.
+//
 // ‘mdl.KeyValues’ is the type of one of the fields for ‘mdl.Instruction’.
 type KeyValues struct {
 	mutex sync.RWMutex
-	data map[Key]string
+	data map[Key][]string
+
+	// keys is every key in ‘data’, sorted with Key.Compare(); so that the keys that start with a prefix are next to each other.
+	//
+	// New keys are appended to the end, and ‘unsorted’ is set; then keys is sorted (once) the next time it is read.
+	// (Keeping it sorted on every write would make adding n keys take O(n²) time.)
+	keys []Key
+	unsorted bool
 }
 
-// Fetch is similar to .Load().
-func (receiver *KeyValues) Fetch(key ...string) String {
-	return receiver.Load(SomeKey(key...))
+// Append adds ‘value’ to the values for ‘key’.
+//
+// Unlike .Store(), .Append() does not return an error if there is already a value for ‘key’.
+//
+// Example
+//
+//	var keyvalues mdl.KeyValues
+//
+//	keyvalues.Append(mdl.SomeKey("tag"), "a")
+//	keyvalues.Append(mdl.SomeKey("tag"), "b")
+//
+//	values := keyvalues.LoadAll(mdl.SomeKey("tag")) // == []string{"a", "b"}
+func (receiver *KeyValues) Append(key Key, value string) error {
+	if nil == receiver {
+		return errNilReceiver
+	}
+
+	if NoKey() == key {
+		return errEmptyKey
+	}
+
+	receiver.mutex.Lock()
+	defer receiver.mutex.Unlock()
+
+	if nil == receiver.data {
+		receiver.data = map[Key][]string{}
+	}
+
+	if _, found := receiver.data[key]; !found {
+		receiver.indexKey(key)
+	}
+
+	receiver.data[key] = append(receiver.data[key], value)
+
+	return nil
 }
 
-func (receiver *KeyValues) For(fn func(Key, string)) {
+// CanonicalForm returns the ‘mdl.KeyValues’ in ‘canonical form’.
+//
+// Each key-value pair is put on its own line, with the key in its canonical form, and the value quoted.
+// The lines are sorted by key. (A key with more than one value has a line for each value.)
+//
+// Example
+//
+// For example, for this ‘mdl.KeyValues’:
+//
+//	var keyvalues mdl.KeyValues
+//
+//	keyvalues.Store(mdl.SomeKey("database", "username"), "joeblow")
+//	keyvalues.Store(mdl.SomeKey("database", "password"), "password123")
+//
+// ... its canonical form is:
+//
+//	database/password "password123"
+//	database/username "joeblow"
+func (receiver *KeyValues) CanonicalForm() string {
 	if nil == receiver {
-		return
+		return ""
 	}
 
 	receiver.mutex.RLock()
 	defer receiver.mutex.RUnlock()
 
-	for k, v := range receiver.data {
-		fn(k, v)
+	var lines []string
+	for k, values := range receiver.data {
+		for _, v := range values {
+			lines = append(lines, k.CanonicalForm()+" "+strconv.Quote(v)+"\n")
+		}
 	}
 
+	sort.Strings(lines)
+
+	return strings.Join(lines, "")
 }
 
+// Fetch is similar to .Load().
+func (receiver *KeyValues) Fetch(key ...string) String {
+	return receiver.Load(SomeKey(key...))
+}
+
+// FetchAll is similar to .LoadAll().
+func (receiver *KeyValues) FetchAll(key ...string) []string {
+	return receiver.LoadAll(SomeKey(key...))
+}
+
+// For calls ‘fn’ for each key-value pair, sorted by key (see Key.Compare()).
+//
+// A key with more than one value has ‘fn’ called for each of its values, in the order they were stored.
+//
+// For goes through a snapshot of the key-value pairs, and does not hold a lock while calling ‘fn’;
+// so ‘fn’ can call .Store(), .Append(), etc, without deadlocking. (Changes made while For is running are not seen by it.)
+//
+// To stop early, use .Range() instead.
+func (receiver *KeyValues) For(fn func(Key, string)) {
+	receiver.ForPrefix(NoKey(), fn)
+}
+
+// ForPrefix calls ‘fn’ for each key-value pair whose key starts with ‘prefix’ (see Key.HasPrefix()), sorted by key.
+//
+// Unlike .For(), ForPrefix does not go through every key-value pair; it only goes through the ones that start with ‘prefix’.
+// Like .For(), it goes through a snapshot, and does not hold a lock while calling ‘fn’.
+//
+// Example
+//
+//	keyvalues.ForPrefix(mdl.SomeKey("database"), func(key mdl.Key, value string) {
+//		// key == mdl.SomeKey("database", "password"), then mdl.SomeKey("database", "username"), ...
+//	})
+func (receiver *KeyValues) ForPrefix(prefix Key, fn func(Key, string)) {
+	receiver.RangePrefix(prefix, func(key Key, value string) bool {
+		fn(key, value)
+		return true
+	})
+}
+
+// Len returns the number of keys.
 func (receiver *KeyValues) Len() int {
 	if nil == receiver {
 		return 0
@@ -44,6 +164,7 @@ func (receiver *KeyValues) Len() int {
 	return len(receiver.data)
 }
 
+// Load returns the (first) value for ‘key’.
 func (receiver *KeyValues) Load(key Key) String {
 	if nil == receiver {
 		return NoString()
@@ -60,12 +181,63 @@ func (receiver *KeyValues) Load(key Key) String {
 		return NoString()
 	}
 
-	value, found := data[key]
-	if !found {
+	values, found := data[key]
+	if !found || 0 == len(values) {
 		return NoString()
 	}
 
-	return SomeString(value)
+	return SomeString(values[0])
+}
+
+// LoadAll returns all the values for ‘key’, in the order they were stored.
+//
+// If there are no values for ‘key’, then LoadAll returns nil.
+func (receiver *KeyValues) LoadAll(key Key) []string {
+	if nil == receiver {
+		return nil
+	}
+	if NoKey() == key {
+		return nil
+	}
+
+	receiver.mutex.RLock()
+	defer receiver.mutex.RUnlock()
+
+	values, found := receiver.data[key]
+	if !found {
+		return nil
+	}
+
+	return append([]string(nil), values...)
+}
+
+// Range is like .For(), except that if ‘fn’ returns false, then Range stops.
+//
+// Example
+//
+//	var found mdl.Key
+//
+//	keyvalues.Range(func(key mdl.Key, value string) bool {
+//		if "joeblow" == value {
+//			found = key
+//			return false
+//		}
+//		return true
+//	})
+func (receiver *KeyValues) Range(fn func(Key, string) bool) {
+	receiver.RangePrefix(NoKey(), fn)
+}
+
+// RangePrefix is like .ForPrefix(), except that if ‘fn’ returns false, then RangePrefix stops.
+func (receiver *KeyValues) RangePrefix(prefix Key, fn func(Key, string) bool) {
+	receiver.rangePrefix(prefix, func(key Key, values []string) bool {
+		for _, v := range values {
+			if !fn(key, v) {
+				return false
+			}
+		}
+		return true
+	})
 }
 
 // ShallowStore being called as:
@@ -79,6 +251,10 @@ func (receiver *KeyValues) ShallowStore(key string, value string) error {
 	return receiver.Store(SomeKey(key), value)
 }
 
+// Store stores ‘value’ for ‘key’.
+//
+// If there is already a value for ‘key’, then Store returns an ‘mdl.KeyFound’ error.
+// (To add another value for ‘key’, use .Append() instead.)
 func (receiver *KeyValues) Store(key Key, value string) error {
 	if nil == receiver {
 		return errNilReceiver
@@ -92,19 +268,127 @@ func (receiver *KeyValues) Store(key Key, value string) error {
 	defer receiver.mutex.Unlock()
 
 	if nil == receiver.data {
-		receiver.data = map[Key]string{}
+		receiver.data = map[Key][]string{}
 	}
 
-	foundValue, found := receiver.data[key]
-	if found {
+	foundValues, found := receiver.data[key]
+	if found && 0 < len(foundValues) {
 		return internalKeyFound{
 			key:key,
 			value:value,
-			foundValue:foundValue,
+			foundValue:foundValues[0],
 		}
 	}
 
-	receiver.data[key] = value
+	if !found {
+		receiver.indexKey(key)
+	}
+
+	receiver.data[key] = []string{value}
 
 	return nil
 }
+
+// rangePrefix calls ‘fn’ for each key that starts with ‘prefix’ (and its values), sorted by key, until ‘fn’ returns false.
+//
+// The keys (and their values) are copied while holding the lock, and ‘fn’ is called after it is released.
+// (The values are not deep copied, since a slice of values is only ever appended to, or replaced; but ‘fn’ must not change them.)
+func (receiver *KeyValues) rangePrefix(prefix Key, fn func(Key, []string) bool) {
+	if nil == receiver {
+		return
+	}
+
+	type entry struct {
+		key Key
+		values []string
+	}
+
+	var entries []entry
+	collect := func() {
+		keys := receiver.keys
+
+		index := sort.Search(len(keys), func(i int) bool {
+			return 0 <= keys[i].Compare(prefix)
+		})
+
+		for ; index < len(keys) && keys[index].HasPrefix(prefix); index++ {
+			key := keys[index]
+			entries = append(entries, entry{key, receiver.data[key]})
+		}
+	}
+
+	receiver.mutex.RLock()
+	if !receiver.unsorted {
+		collect()
+		receiver.mutex.RUnlock()
+	} else {
+		receiver.mutex.RUnlock()
+
+		// Sorting changes the keys; so it needs the write lock.
+		receiver.mutex.Lock()
+		receiver.sortKeys()
+		collect()
+		receiver.mutex.Unlock()
+	}
+
+	for _, e := range entries {
+		if !fn(e.key, e.values) {
+			return
+		}
+	}
+}
+
+// indexKey adds ‘key’ to the keys (which are sorted later, by sortKeys). The caller must hold the (write) lock, and ‘key’ must not already be in ‘data’.
+func (receiver *KeyValues) indexKey(key Key) {
+	receiver.keys = append(receiver.keys, key)
+	receiver.unsorted = true
+}
+
+// replace replaces all the key-value pairs with ‘data’ (which it takes ownership of).
+func (receiver *KeyValues) replace(data map[Key][]string) {
+	var keys []Key = make([]Key, 0, len(data))
+	for key := range data {
+		keys = append(keys, key)
+	}
+	sort.Slice(keys, func(i, j int) bool {
+		return keys[i].Compare(keys[j]) < 0
+	})
+
+	receiver.mutex.Lock()
+	defer receiver.mutex.Unlock()
+
+	receiver.data = data
+	receiver.keys = keys
+	receiver.unsorted = false
+}
+
+// sortKeys sorts the keys, if any were added since they were last sorted. The caller must hold the (write) lock.
+func (receiver *KeyValues) sortKeys() {
+	if !receiver.unsorted {
+		return
+	}
+
+	keys := receiver.keys
+	sort.Slice(keys, func(i, j int) bool {
+		return keys[i].Compare(keys[j]) < 0
+	})
+
+	receiver.unsorted = false
+}
+
+// snapshot returns a copy of the key-value pairs, so that they can be worked with without holding the lock.
+func (receiver *KeyValues) snapshot() map[Key][]string {
+	if nil == receiver {
+		return nil
+	}
+
+	receiver.mutex.RLock()
+	defer receiver.mutex.RUnlock()
+
+	var data map[Key][]string = make(map[Key][]string, len(receiver.data))
+	for k, values := range receiver.data {
+		data[k] = append([]string(nil), values...)
+	}
+
+	return data
+}
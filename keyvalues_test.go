@@ -3,7 +3,11 @@ package mdl_test
 import (
 	"github.com/reiver/go-mdl"
 
+	"fmt"
+	"reflect"
+	"sort"
 	"testing"
+	"time"
 )
 
 func TestKeyValuesDoubleStoreError(t *testing.T) {
@@ -93,3 +97,260 @@ func TestKeyValuesDoubleStoreError(t *testing.T) {
 		}
 	}
 }
+
+func TestKeyValuesAppend(t *testing.T) {
+
+	var keyvalues mdl.KeyValues
+
+	if err := keyvalues.Store(mdl.SomeKey("tag"), "apple"); nil != err {
+		t.Errorf("Did not expect an error, but actually got one: (%T) %q", err, err)
+		return
+	}
+	if err := keyvalues.Append(mdl.SomeKey("tag"), "banana"); nil != err {
+		t.Errorf("Did not expect an error, but actually got one: (%T) %q", err, err)
+		return
+	}
+	if err := keyvalues.Append(mdl.SomeKey("tag"), "apple"); nil != err {
+		t.Errorf("Did not expect an error, but actually got one: (%T) %q", err, err)
+		return
+	}
+	if err := keyvalues.Append(mdl.SomeKey("name"), "joe"); nil != err {
+		t.Errorf("Did not expect an error, but actually got one: (%T) %q", err, err)
+		return
+	}
+
+	if err := keyvalues.Append(mdl.NoKey(), "nothing"); nil == err {
+		t.Errorf("Expected an error, but did not actually get one: %#v", err)
+		return
+	}
+
+	if err := keyvalues.Store(mdl.SomeKey("name"), "jane"); nil == err {
+		t.Errorf("Expected an error, but did not actually get one: %#v", err)
+		return
+	} else if _, casted := err.(mdl.KeyFound); !casted {
+		t.Errorf("Expected error to be a mdl.KeyFound, but actually wasn't: (%T) %q", err, err)
+		return
+	}
+
+	if expected, actual := 2, keyvalues.Len(); expected != actual {
+		t.Errorf("Expected %d, but actually got %d.", expected, actual)
+		return
+	}
+
+	if expected, actual := mdl.SomeString("apple"), keyvalues.Load(mdl.SomeKey("tag")); expected != actual {
+		t.Errorf("Expected value %#v, but actually got value %#v.", expected, actual)
+		return
+	}
+
+	{
+		expected := []string{"apple", "banana", "apple"}
+		actual := keyvalues.FetchAll("tag")
+
+		if len(expected) != len(actual) {
+			t.Errorf("Expected %d values, but actually got %d: %q", len(expected), len(actual), actual)
+			return
+		}
+		for i := range expected {
+			if expected[i] != actual[i] {
+				t.Errorf("For value #%d, expected %q, but actually got %q.", i, expected[i], actual[i])
+			}
+		}
+	}
+
+	if actual := keyvalues.LoadAll(mdl.SomeKey("color")); nil != actual {
+		t.Errorf("Expected nil, but actually got %q.", actual)
+		return
+	}
+
+	{
+		var count int
+		keyvalues.For(func(key mdl.Key, value string){
+			count++
+		})
+
+		if expected, actual := 4, count; expected != actual {
+			t.Errorf("Expected For to call the func %d times, but it actually called it %d times.", expected, actual)
+			return
+		}
+	}
+
+	{
+		expected := "name \"joe\"\n" +
+		            "tag \"apple\"\n" +
+		            "tag \"apple\"\n" +
+		            "tag \"banana\"\n"
+
+		if actual := keyvalues.CanonicalForm(); expected != actual {
+			t.Errorf("The canonical form that was actually gotten was not what was expected.")
+			t.Logf("EXPECTED:\n%s", expected)
+			t.Logf("ACTUAL:\n%s", actual)
+			return
+		}
+	}
+}
+
+func TestKeyValuesForSorted(t *testing.T) {
+
+	var keyvalues mdl.KeyValues
+	keyvalues.Store(mdl.SomeKey("version"), "2")
+	keyvalues.Store(mdl.SomeKey("database", "username"), "joeblow")
+	keyvalues.Store(mdl.SomeKey("database", "password"), "password123")
+	keyvalues.Append(mdl.SomeKey("tags"), "b")
+	keyvalues.Append(mdl.SomeKey("tags"), "a")
+	keyvalues.Store(mdl.SomeKey("cache"), "yes")
+	keyvalues.Store(mdl.SomeKey("database!"), "no")
+
+	var expected []string = []string{
+		`cache "yes"`,
+		`database/password "password123"`,
+		`database/username "joeblow"`,
+		`database! "no"`,
+		`tags "b"`,
+		`tags "a"`,
+		`version "2"`,
+	}
+
+	// Go maps are not ranged over in the same order each time; so go through more than once.
+	for i := 0; i < 10; i++ {
+		var actual []string
+		keyvalues.For(func(key mdl.Key, value string) {
+			actual = append(actual, fmt.Sprintf("%s %q", key.CanonicalForm(), value))
+		})
+
+		if !reflect.DeepEqual(expected, actual) {
+			t.Errorf("Expected %#v, but actually got %#v.", expected, actual)
+			return
+		}
+	}
+}
+
+func TestKeyValuesRange(t *testing.T) {
+
+	var keyvalues mdl.KeyValues
+	keyvalues.Store(mdl.SomeKey("a"), "1")
+	keyvalues.Append(mdl.SomeKey("b"), "2")
+	keyvalues.Append(mdl.SomeKey("b"), "3")
+	keyvalues.Store(mdl.SomeKey("c"), "4")
+
+	tests := []struct{
+		Stop     string
+		Expected []string
+	}{
+		{
+			Stop: "1",
+			Expected: []string{"1"},
+		},
+		{
+			Stop: "2",
+			Expected: []string{"1", "2"},
+		},
+		{
+			Stop: "3",
+			Expected: []string{"1", "2", "3"},
+		},
+		{
+			Stop: "never",
+			Expected: []string{"1", "2", "3", "4"},
+		},
+	}
+
+	for testNumber, test := range tests {
+
+		var actual []string
+		keyvalues.Range(func(key mdl.Key, value string) bool {
+			actual = append(actual, value)
+			return test.Stop != value
+		})
+
+		if expected := test.Expected; !reflect.DeepEqual(expected, actual) {
+			t.Errorf("For test #%d, expected %#v, but actually got %#v.", testNumber, expected, actual)
+			continue
+		}
+	}
+}
+
+func TestKeyValuesForStoreInside(t *testing.T) {
+
+	var keyvalues mdl.KeyValues
+	keyvalues.Store(mdl.SomeKey("a"), "1")
+	keyvalues.Store(mdl.SomeKey("b"), "2")
+
+	done := make(chan struct{})
+
+	go func() {
+		defer close(done)
+
+		keyvalues.For(func(key mdl.Key, value string) {
+			keyvalues.Store(mdl.SomeKey("copy").Join(key), value)
+		})
+	}()
+
+	select {
+	case <-done:
+	case <-time.After(5 * time.Second):
+		t.Errorf("Expected calling .Store() from inside of .For() not to deadlock, but it seems to have.")
+		return
+	}
+
+	if expected, actual := 4, keyvalues.Len(); expected != actual {
+		t.Errorf("Expected %d keys, but actually got %d.", expected, actual)
+		t.Logf("KEY-VALUES:\n%s", keyvalues.CanonicalForm())
+		return
+	}
+}
+
+func TestKeyValuesManyKeys(t *testing.T) {
+
+	const count = 100000
+
+	// The keys are not added in sorted order; which is what made adding keys slow, when the keys were kept sorted on every write.
+	var expected []string = make([]string, count)
+	for i := range expected {
+		expected[i] = fmt.Sprintf("k%06d", (i*7919)%count)
+	}
+
+	begin := time.Now()
+
+	var keyvalues mdl.KeyValues
+	for _, key := range expected {
+		if err := keyvalues.Store(mdl.SomeKey(key), "v"); nil != err {
+			t.Fatalf("Did not expect an error, but actually got one: (%T) %q", err, err)
+		}
+	}
+
+	var actual []string
+	keyvalues.For(func(key mdl.Key, value string) {
+		actual = append(actual, key.CanonicalForm())
+	})
+
+	if elapsed, limit := time.Since(begin), 5*time.Second; limit < elapsed {
+		t.Errorf("Expected storing %d keys to take less than %v, but it actually took %v.", count, limit, elapsed)
+	}
+
+	sort.Strings(expected)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("Expected the %d keys to be sorted, but they actually were not.", count)
+		return
+	}
+
+	// Keys added after the keys were sorted are sorted too.
+	keyvalues.Store(mdl.SomeKey("a"), "v")
+	keyvalues.Store(mdl.SomeKey("k050000x"), "v")
+
+	actual = nil
+	keyvalues.ForPrefix(mdl.SomeKey("k050000x"), func(key mdl.Key, value string) {
+		actual = append(actual, key.CanonicalForm())
+	})
+	if expected := []string{"k050000x"}; !reflect.DeepEqual(expected, actual) {
+		t.Errorf("Expected %#v, but actually got %#v.", expected, actual)
+	}
+
+	var first string
+	keyvalues.Range(func(key mdl.Key, value string) bool {
+		first = key.CanonicalForm()
+		return false
+	})
+	if expected, actual := "a", first; expected != actual {
+		t.Errorf("Expected the first key to be %q, but actually got %q.", expected, actual)
+	}
+}
@@ -0,0 +1,379 @@
+package mdl
+
+import (
+	"encoding"
+	"fmt"
+	"reflect"
+	"sort"
+	"strconv"
+	"time"
+)
+
+var (
+	reflectTypeDuration        = reflect.TypeOf(time.Duration(0))
+	reflectTypeKey             = reflect.TypeOf(Key{})
+	reflectTypeString          = reflect.TypeOf(String{})
+	reflectTypeTextUnmarshaler = reflect.TypeOf((*encoding.TextUnmarshaler)(nil)).Elem()
+)
+
+// Bind fills in the struct pointed to by ‘dst’ from the key-value pairs.
+//
+// Which key a struct field is filled in from is given by its “mdl” struct tag, which is a key in canonical form.
+// (A struct field without an “mdl” struct tag uses its name as the key; and a struct field with the struct tag `mdl:"-"` is skipped.)
+//
+// A struct field that is itself a struct has its struct fields filled in from keys that start with its key.
+// So for example, these 2 are filled in the same way:
+//
+//	type User struct {
+//		Street string `mdl:"address/street"`
+//	}
+//
+//	type User struct {
+//		Address struct {
+//			Street string `mdl:"street"`
+//		} `mdl:"address"`
+//	}
+//
+// An embedded struct without an “mdl” struct tag has its struct fields filled in as if they were the outer struct's struct fields.
+//
+// Struct fields of these types are supported:
+//
+// • ‘mdl.String’,
+//
+// • ‘mdl.Key’ (in canonical form),
+//
+// • string, bool, the int types, the uint types, and the float types (parsed with the "strconv" package),
+//
+// • time.Duration (parsed with time.ParseDuration()),
+//
+// • anything that fits encoding.TextUnmarshaler (which includes time.Time, in RFC 3339 format),
+//
+// • []byte,
+//
+// • structs of any of these,
+//
+// • pointers to any of these (which are only allocated if there is a value for them), and
+//
+// • slices of any of these.
+//
+// A slice is filled in from every value stored at its key (see .Append()); or, if there are none, from the keys
+// that have the (zero-based) index as their next token. (Which is how JSON arrays are stored. See ‘mdl.Instruction’.)
+//
+// A struct field that there is no value for is left as is.
+//
+// If a value cannot be converted to the type of its struct field, then Bind returns an ‘mdl.CannotConvert’ error,
+// which says what key the value was at.
+//
+// Example
+//
+//	type User struct {
+//		EmailAddress mdl.String `mdl:"email_address"`
+//		Age          int        `mdl:"age"`
+//		Street       string     `mdl:"address/street"`
+//		Tags         []string   `mdl:"tags"`
+//		Born         *time.Time `mdl:"born"`
+//	}
+//
+//	var user User
+//
+//	err := instruction.Data.Bind(&user)
+func (receiver *KeyValues) Bind(dst interface{}) error {
+	if nil == receiver {
+		return errNilReceiver
+	}
+
+	value := reflect.ValueOf(dst)
+	if reflect.Ptr != value.Kind() || value.IsNil() || reflect.Struct != value.Elem().Kind() {
+		return errNotStructPointer
+	}
+
+	data := receiver.snapshot()
+
+	var keys []Key = make([]Key, 0, len(data))
+	for key := range data {
+		keys = append(keys, key)
+	}
+	sort.Slice(keys, func(i, j int) bool {
+		return keys[i].Compare(keys[j]) < 0
+	})
+
+	var binder keyValuesBinder = keyValuesBinder{
+		data: data,
+		keys: keys,
+	}
+
+	return binder.bindStruct(nil, value.Elem())
+}
+
+type keyValuesBinder struct {
+	data map[Key][]string
+
+	// keys is every key in ‘data’, sorted with Key.Compare(); as in ‘mdl.KeyValues’.
+	keys []Key
+}
+
+func (receiver keyValuesBinder) bindStruct(prefix []string, value reflect.Value) error {
+	typ := value.Type()
+
+	for i := 0; i < typ.NumField(); i++ {
+		field := typ.Field(i)
+
+		tokens, skip, err := keyValuesFieldTokens(prefix, field)
+		if nil != err {
+			return err
+		}
+		if skip {
+			continue
+		}
+
+		if err := receiver.bindValue(tokens, value.Field(i)); nil != err {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (receiver keyValuesBinder) bindValue(tokens []string, value reflect.Value) error {
+	typ := value.Type()
+
+	if keyValuesIsLeaf(typ) {
+		if 0 == len(tokens) {
+			return fmt.Errorf("mdl: cannot bind %s without a key", typ)
+		}
+
+		key := SomeKey(tokens...)
+
+		values := receiver.data[key]
+		if 0 == len(values) {
+			return nil
+		}
+
+		return keyValuesBindText(key, values[0], value)
+	}
+
+	switch typ.Kind() {
+	case reflect.Ptr:
+		if !receiver.has(tokens) {
+			return nil
+		}
+		if value.IsNil() {
+			value.Set(reflect.New(typ.Elem()))
+		}
+		return receiver.bindValue(tokens, value.Elem())
+
+	case reflect.Struct:
+		return receiver.bindStruct(tokens, value)
+
+	case reflect.Slice:
+		return receiver.bindSlice(tokens, value)
+
+	default:
+		return fmt.Errorf("mdl: cannot bind key %#v to unsupported type %s", SomeKey(tokens...), typ)
+	}
+}
+
+func (receiver keyValuesBinder) bindSlice(tokens []string, value reflect.Value) error {
+	typ := value.Type()
+
+	if 0 == len(tokens) {
+		return fmt.Errorf("mdl: cannot bind %s without a key", typ)
+	}
+
+	key := SomeKey(tokens...)
+
+	if values := receiver.data[key]; 0 < len(values) {
+		if reflect.Uint8 == typ.Elem().Kind() {
+			value.SetBytes([]byte(values[0]))
+			return nil
+		}
+
+		if !keyValuesIsLeaf(typ.Elem()) {
+			return fmt.Errorf("mdl: cannot bind key %#v to %s, since it has values rather than indexed keys", key, typ)
+		}
+
+		slice := reflect.MakeSlice(typ, len(values), len(values))
+		for i, v := range values {
+			if err := keyValuesBindText(key, v, slice.Index(i)); nil != err {
+				return err
+			}
+		}
+		value.Set(slice)
+		return nil
+	}
+
+	var length int
+	for receiver.has(keyValuesAppendToken(tokens, strconv.Itoa(length))) {
+		length++
+	}
+	if 0 == length {
+		return nil
+	}
+
+	slice := reflect.MakeSlice(typ, length, length)
+	for i := 0; i < length; i++ {
+		if err := receiver.bindValue(keyValuesAppendToken(tokens, strconv.Itoa(i)), slice.Index(i)); nil != err {
+			return err
+		}
+	}
+	value.Set(slice)
+
+	return nil
+}
+
+// has returns whether there is a value at the key given by ‘tokens’, or at any key that starts with it.
+//
+// Since those keys are next to each other in ‘keys’, this is a binary search, rather than a scan of all the keys.
+// (.bindSlice() calls it for each index.)
+func (receiver keyValuesBinder) has(tokens []string) bool {
+	prefix := SomeKey(tokens...)
+	keys := receiver.keys
+
+	index := sort.Search(len(keys), func(i int) bool {
+		return 0 <= keys[i].Compare(prefix)
+	})
+
+	for ; index < len(keys) && keys[index].HasPrefix(prefix); index++ {
+		if 0 < len(receiver.data[keys[index]]) {
+			return true
+		}
+	}
+
+	return false
+}
+
+func keyValuesAppendToken(tokens []string, token string) []string {
+	var result []string = make([]string, len(tokens), len(tokens)+1)
+	copy(result, tokens)
+
+	return append(result, token)
+}
+
+func keyValuesBindText(key Key, text string, value reflect.Value) error {
+	typ := value.Type()
+
+	switch {
+	case reflectTypeString == typ:
+		value.Set(reflect.ValueOf(SomeString(text)))
+		return nil
+
+	case reflectTypeDuration == typ:
+		duration, err := time.ParseDuration(text)
+		if nil != err {
+			return cannotConvert(key, text, typ.String(), err)
+		}
+		value.SetInt(int64(duration))
+		return nil
+
+	case reflect.Ptr == typ.Kind():
+		if value.IsNil() {
+			value.Set(reflect.New(typ.Elem()))
+		}
+		return keyValuesBindText(key, text, value.Elem())
+
+	case reflect.PtrTo(typ).Implements(reflectTypeTextUnmarshaler):
+		unmarshaler := value.Addr().Interface().(encoding.TextUnmarshaler)
+		if err := unmarshaler.UnmarshalText([]byte(text)); nil != err {
+			return cannotConvert(key, text, typ.String(), err)
+		}
+		return nil
+	}
+
+	switch typ.Kind() {
+	case reflect.String:
+		value.SetString(text)
+
+	case reflect.Bool:
+		b, err := strconv.ParseBool(text)
+		if nil != err {
+			return cannotConvert(key, text, typ.String(), err)
+		}
+		value.SetBool(b)
+
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		i, err := strconv.ParseInt(text, 10, typ.Bits())
+		if nil != err {
+			return cannotConvert(key, text, typ.String(), err)
+		}
+		value.SetInt(i)
+
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		u, err := strconv.ParseUint(text, 10, typ.Bits())
+		if nil != err {
+			return cannotConvert(key, text, typ.String(), err)
+		}
+		value.SetUint(u)
+
+	case reflect.Float32, reflect.Float64:
+		f, err := strconv.ParseFloat(text, typ.Bits())
+		if nil != err {
+			return cannotConvert(key, text, typ.String(), err)
+		}
+		value.SetFloat(f)
+
+	default:
+		return fmt.Errorf("mdl: cannot bind key %#v to unsupported type %s", key, typ)
+	}
+
+	return nil
+}
+
+// keyValuesFieldTokens returns the key (as tokens) for a struct field, from its “mdl” struct tag.
+func keyValuesFieldTokens(prefix []string, field reflect.StructField) (tokens []string, skip bool, err error) {
+	tag, tagged := field.Tag.Lookup("mdl")
+	if "-" == tag {
+		return nil, true, nil
+	}
+
+	embedded := field.Anonymous && !tagged && reflect.Struct == field.Type.Kind()
+
+	// Unexported struct fields are skipped, except for embedded structs (whose exported struct fields can still be set).
+	if "" != field.PkgPath && !embedded {
+		return nil, true, nil
+	}
+
+	if embedded {
+		return prefix, false, nil
+	}
+
+	var fieldTokens []string = []string{field.Name}
+	if tagged {
+		fieldTokens, err = KeyDeserialize(tag)
+		if nil != err {
+			return nil, false, fmt.Errorf("mdl: struct field %s has a malformed “mdl” struct tag %q: %s", field.Name, tag, err)
+		}
+		if 0 == len(fieldTokens) {
+			return nil, false, fmt.Errorf("mdl: struct field %s has an empty “mdl” struct tag", field.Name)
+		}
+	}
+
+	tokens = make([]string, 0, len(prefix)+len(fieldTokens))
+	tokens = append(tokens, prefix...)
+	tokens = append(tokens, fieldTokens...)
+
+	return tokens, false, nil
+}
+
+// keyValuesIsLeaf returns whether a value of type ‘typ’ is converted from a single string (rather than being made up of other values).
+func keyValuesIsLeaf(typ reflect.Type) bool {
+	for reflect.Ptr == typ.Kind() {
+		typ = typ.Elem()
+	}
+
+	switch {
+	case reflectTypeString == typ, reflectTypeDuration == typ:
+		return true
+	case reflect.PtrTo(typ).Implements(reflectTypeTextUnmarshaler):
+		return true
+	}
+
+	switch typ.Kind() {
+	case reflect.String, reflect.Bool,
+		reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr,
+		reflect.Float32, reflect.Float64:
+		return true
+	default:
+		return false
+	}
+}
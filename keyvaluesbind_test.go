@@ -0,0 +1,295 @@
+package mdl_test
+
+import (
+	"github.com/reiver/go-mdl"
+
+	"reflect"
+	"strconv"
+	"testing"
+	"time"
+)
+
+func TestKeyValuesBind(t *testing.T) {
+
+	type Address struct {
+		Street string `mdl:"street"`
+		City   string `mdl:"city"`
+	}
+
+	type Base struct {
+		ID uint64 `mdl:"id"`
+	}
+
+	type User struct {
+		Base
+
+		EmailAddress mdl.String    `mdl:"email_address"`
+		Nickname     mdl.String    `mdl:"nickname"`
+		Age          int           `mdl:"age"`
+		Score        float64       `mdl:"score"`
+		Admin        bool          `mdl:"admin"`
+		Born         time.Time     `mdl:"born"`
+		Timeout      time.Duration `mdl:"timeout"`
+		Street       string        `mdl:"address/street"`
+		Address      Address       `mdl:"address"`
+		Work         *Address      `mdl:"work"`
+		Home         *Address      `mdl:"home"`
+		Height       *int          `mdl:"height"`
+		Weight       *int          `mdl:"weight"`
+		Tags         []string      `mdl:"tags"`
+		Numbers      []int         `mdl:"numbers"`
+		Previous     []Address     `mdl:"previous"`
+		Avatar       []byte        `mdl:"avatar"`
+		Odd          string        `mdl:"i\\/o"`
+		Ignored      string        `mdl:"-"`
+		Untagged     string
+		unexported   string
+	}
+
+	var keyvalues mdl.KeyValues
+	keyvalues.Store(mdl.SomeKey("id"), "42")
+	keyvalues.Store(mdl.SomeKey("email_address"), "joeblow@example.com")
+	keyvalues.Store(mdl.SomeKey("age"), "33")
+	keyvalues.Store(mdl.SomeKey("score"), "-2.5")
+	keyvalues.Store(mdl.SomeKey("admin"), "true")
+	keyvalues.Store(mdl.SomeKey("born"), "1985-05-07T10:25:09Z")
+	keyvalues.Store(mdl.SomeKey("timeout"), "1m30s")
+	keyvalues.Store(mdl.SomeKey("address", "street"), "123 Main St")
+	keyvalues.Store(mdl.SomeKey("address", "city"), "Vancouver")
+	keyvalues.Store(mdl.SomeKey("work", "city"), "Toronto")
+	keyvalues.Store(mdl.SomeKey("height"), "180")
+	keyvalues.Append(mdl.SomeKey("tags"), "a")
+	keyvalues.Append(mdl.SomeKey("tags"), "b")
+	keyvalues.Store(mdl.SomeKey("numbers", "0"), "1")
+	keyvalues.Store(mdl.SomeKey("numbers", "1"), "2")
+	keyvalues.Store(mdl.SomeKey("numbers", "2"), "3")
+	keyvalues.Store(mdl.SomeKey("previous", "0", "city"), "Halifax")
+	keyvalues.Store(mdl.SomeKey("previous", "1", "street"), "1 Water St")
+	keyvalues.Store(mdl.SomeKey("avatar"), "\x89PNG")
+	keyvalues.Store(mdl.SomeKey("i/o"), "yes")
+	keyvalues.Store(mdl.SomeKey("Ignored"), "no")
+	keyvalues.Store(mdl.SomeKey("-"), "no")
+	keyvalues.Store(mdl.SomeKey("Untagged"), "untagged")
+	keyvalues.Store(mdl.SomeKey("unexported"), "no")
+
+	var user User
+	user.Nickname = mdl.SomeString("left-alone")
+
+	if err := keyvalues.Bind(&user); nil != err {
+		t.Errorf("Did not expect an error, but actually got one: (%T) %q", err, err)
+		return
+	}
+
+	height := 180
+
+	expected := User{
+		Base: Base{
+			ID: 42,
+		},
+		EmailAddress: mdl.SomeString("joeblow@example.com"),
+		Nickname:     mdl.SomeString("left-alone"),
+		Age:          33,
+		Score:        -2.5,
+		Admin:        true,
+		Born:         time.Date(1985, 5, 7, 10, 25, 9, 0, time.UTC),
+		Timeout:      90 * time.Second,
+		Street:       "123 Main St",
+		Address: Address{
+			Street: "123 Main St",
+			City:   "Vancouver",
+		},
+		Work: &Address{
+			City: "Toronto",
+		},
+		Height:   &height,
+		Tags:     []string{"a", "b"},
+		Numbers:  []int{1, 2, 3},
+		Previous: []Address{
+			{City: "Halifax"},
+			{Street: "1 Water St"},
+		},
+		Avatar:   []byte("\x89PNG"),
+		Odd:      "yes",
+		Untagged: "untagged",
+	}
+
+	if !reflect.DeepEqual(expected, user) {
+		t.Errorf("The struct that was actually gotten was not what was expected.")
+		t.Logf("EXPECTED: %#v", expected)
+		t.Logf("ACTUAL:   %#v", user)
+		return
+	}
+}
+
+func TestKeyValuesBindCannotConvert(t *testing.T) {
+
+	tests := []struct{
+		KeyValues   func() *mdl.KeyValues
+		Dst         interface{}
+		ExpectedKey mdl.Key
+	}{
+		{
+			KeyValues: func() *mdl.KeyValues {
+				var keyvalues mdl.KeyValues
+				keyvalues.Store(mdl.SomeKey("age"), "thirty")
+				return &keyvalues
+			},
+			Dst: &struct{
+				Age int `mdl:"age"`
+			}{},
+			ExpectedKey: mdl.SomeKey("age"),
+		},
+		{
+			KeyValues: func() *mdl.KeyValues {
+				var keyvalues mdl.KeyValues
+				keyvalues.Store(mdl.SomeKey("address", "zip"), "-1")
+				return &keyvalues
+			},
+			Dst: &struct{
+				Address *struct{
+					Zip uint16 `mdl:"zip"`
+				} `mdl:"address"`
+			}{},
+			ExpectedKey: mdl.SomeKey("address", "zip"),
+		},
+		{
+			KeyValues: func() *mdl.KeyValues {
+				var keyvalues mdl.KeyValues
+				keyvalues.Store(mdl.SomeKey("flags", "0"), "true")
+				keyvalues.Store(mdl.SomeKey("flags", "1"), "maybe")
+				return &keyvalues
+			},
+			Dst: &struct{
+				Flags []bool `mdl:"flags"`
+			}{},
+			ExpectedKey: mdl.SomeKey("flags", "1"),
+		},
+		{
+			KeyValues: func() *mdl.KeyValues {
+				var keyvalues mdl.KeyValues
+				keyvalues.Store(mdl.SomeKey("born"), "yesterday")
+				return &keyvalues
+			},
+			Dst: &struct{
+				Born time.Time `mdl:"born"`
+			}{},
+			ExpectedKey: mdl.SomeKey("born"),
+		},
+		{
+			KeyValues: func() *mdl.KeyValues {
+				var keyvalues mdl.KeyValues
+				keyvalues.Store(mdl.SomeKey("timeout"), "soon")
+				return &keyvalues
+			},
+			Dst: &struct{
+				Timeout time.Duration `mdl:"timeout"`
+			}{},
+			ExpectedKey: mdl.SomeKey("timeout"),
+		},
+	}
+
+	for testNumber, test := range tests {
+
+		err := test.KeyValues().Bind(test.Dst)
+		if nil == err {
+			t.Errorf("For test #%d, expected an error, but did not actually get one.", testNumber)
+			continue
+		}
+
+		casted, ok := err.(mdl.CannotConvert)
+		if !ok {
+			t.Errorf("For test #%d, expected a mdl.CannotConvert error, but actually got: (%T) %q", testNumber, err, err)
+			continue
+		}
+
+		if expected, actual := test.ExpectedKey, casted.Key(); expected != actual {
+			t.Errorf("For test #%d, expected key %#v, but actually got %#v.", testNumber, expected, actual)
+			t.Logf("ERROR: %q", err)
+			continue
+		}
+	}
+}
+
+func TestKeyValuesBindError(t *testing.T) {
+
+	var keyvalues mdl.KeyValues
+	keyvalues.Store(mdl.SomeKey("age"), "33")
+
+	var notStruct int
+
+	tests := []interface{}{
+		nil,
+		notStruct,
+		&notStruct,
+		struct{}{},
+		(*struct{})(nil),
+		&struct{
+			Lookup map[string]string `mdl:"age"`
+		}{},
+	}
+
+	for testNumber, dst := range tests {
+		if err := keyvalues.Bind(dst); nil == err {
+			t.Errorf("For test #%d, expected an error, but did not actually get one.", testNumber)
+			t.Logf("DST: %#v", dst)
+			continue
+		}
+	}
+}
+
+func TestKeyValuesBindLongSlice(t *testing.T) {
+
+	type Row struct {
+		Name string `mdl:"name"`
+	}
+
+	type Table struct {
+		Numbers []int `mdl:"numbers"`
+		Rows    []Row `mdl:"rows"`
+		Sparse  []int `mdl:"sparse"`
+	}
+
+	const count = 5000
+
+	var keyvalues mdl.KeyValues
+	for i := 0; i < count; i++ {
+		keyvalues.Store(mdl.SomeKey("numbers", strconv.Itoa(i)), strconv.Itoa(i*2))
+		keyvalues.Store(mdl.SomeKey("rows", strconv.Itoa(i), "name"), "row-"+strconv.Itoa(i))
+	}
+
+	// “sparse/10” starts with “sparse/1” as a string, but not as a key; and “sparse/3” is missing.
+	keyvalues.Store(mdl.SomeKey("sparse", "0"), "0")
+	keyvalues.Store(mdl.SomeKey("sparse", "1"), "1")
+	keyvalues.Store(mdl.SomeKey("sparse", "2"), "2")
+	keyvalues.Store(mdl.SomeKey("sparse", "10"), "10")
+
+	var table Table
+	if err := keyvalues.Bind(&table); nil != err {
+		t.Errorf("Did not expect an error, but actually got one: (%T) %q", err, err)
+		return
+	}
+
+	if expected, actual := count, len(table.Numbers); expected != actual {
+		t.Errorf("Expected %d numbers, but actually got %d.", expected, actual)
+		return
+	}
+	if expected, actual := count, len(table.Rows); expected != actual {
+		t.Errorf("Expected %d rows, but actually got %d.", expected, actual)
+		return
+	}
+	for i := 0; i < count; i++ {
+		if expected, actual := i*2, table.Numbers[i]; expected != actual {
+			t.Errorf("For number #%d, expected %d, but actually got %d.", i, expected, actual)
+			return
+		}
+		if expected, actual := "row-"+strconv.Itoa(i), table.Rows[i].Name; expected != actual {
+			t.Errorf("For row #%d, expected %q, but actually got %q.", i, expected, actual)
+			return
+		}
+	}
+
+	if expected, actual := []int{0, 1, 2}, table.Sparse; !reflect.DeepEqual(expected, actual) {
+		t.Errorf("Expected %v, but actually got %v.", expected, actual)
+		return
+	}
+}
@@ -0,0 +1,200 @@
+package mdl
+
+import (
+	"net/mail"
+	"net/url"
+	"time"
+)
+
+// LoadBool returns the (first) value for ‘key’, as an ‘mdl.Bool’.
+//
+// The value is parsed with strconv.ParseBool(); so “1”, “t”, “true”, “0”, “f”, “false” (and a few others) are accepted.
+//
+// If there is no value for ‘key’, then LoadBool returns mdl.NoBool(), and no error.
+// If the value cannot be parsed, then LoadBool returns an ‘mdl.CannotConvert’ error.
+func (receiver *KeyValues) LoadBool(key Key) (Bool, error) {
+	var datum Bool
+
+	text, loaded := receiver.Load(key).Unwrap()
+	if !loaded {
+		return datum, nil
+	}
+
+	if err := datum.UnmarshalText([]byte(text)); nil != err {
+		return NoBool(), cannotConvert(key, text, "bool", err)
+	}
+
+	return datum, nil
+}
+
+// LoadDuration returns the (first) value for ‘key’, as an ‘mdl.Duration’.
+//
+// The value is parsed with time.ParseDuration(); so, for example, “90s”, and “1m30s” are both accepted.
+//
+// If there is no value for ‘key’, then LoadDuration returns mdl.NoDuration(), and no error.
+// If the value cannot be parsed, then LoadDuration returns an ‘mdl.CannotConvert’ error.
+func (receiver *KeyValues) LoadDuration(key Key) (Duration, error) {
+	var datum Duration
+
+	text, loaded := receiver.Load(key).Unwrap()
+	if !loaded {
+		return datum, nil
+	}
+
+	if err := datum.UnmarshalText([]byte(text)); nil != err {
+		return NoDuration(), cannotConvert(key, text, "time.Duration", err)
+	}
+
+	return datum, nil
+}
+
+// LoadEmailAddress returns the (first) value for ‘key’, if it is an e-mail address.
+//
+// Only a bare e-mail address (ex: “joeblow@example.com”) is accepted; and not one with a name, or angle brackets
+// (ex: “Joe Blow <joeblow@example.com>”).
+//
+// If there is no value for ‘key’, then LoadEmailAddress returns mdl.NoString(), and no error.
+// If the value is not an e-mail address, then LoadEmailAddress returns an ‘mdl.CannotConvert’ error.
+func (receiver *KeyValues) LoadEmailAddress(key Key) (String, error) {
+	text, loaded := receiver.Load(key).Unwrap()
+	if !loaded {
+		return NoString(), nil
+	}
+
+	address, err := mail.ParseAddress(text)
+	if nil != err {
+		return NoString(), cannotConvert(key, text, "e-mail address", err)
+	}
+	if "" != address.Name || address.Address != text {
+		return NoString(), cannotConvert(key, text, "e-mail address", errNotBareEmailAddress)
+	}
+
+	return SomeString(text), nil
+}
+
+// LoadFloat64 returns the (first) value for ‘key’, as an ‘mdl.Float64’.
+//
+// If there is no value for ‘key’, then LoadFloat64 returns mdl.NoFloat64(), and no error.
+// If the value cannot be parsed, then LoadFloat64 returns an ‘mdl.CannotConvert’ error.
+func (receiver *KeyValues) LoadFloat64(key Key) (Float64, error) {
+	var datum Float64
+
+	text, loaded := receiver.Load(key).Unwrap()
+	if !loaded {
+		return datum, nil
+	}
+
+	if err := datum.UnmarshalText([]byte(text)); nil != err {
+		return NoFloat64(), cannotConvert(key, text, "float64", err)
+	}
+
+	return datum, nil
+}
+
+// LoadInt64 returns the (first) value for ‘key’, as an ‘mdl.Int64’.
+//
+// The value must be a base 10 integer (ex: “-5”).
+//
+// If there is no value for ‘key’, then LoadInt64 returns mdl.NoInt64(), and no error.
+// If the value cannot be parsed, then LoadInt64 returns an ‘mdl.CannotConvert’ error.
+//
+// Example
+//
+//	port, err := instruction.Data.LoadInt64(mdl.SomeKey("database", "port"))
+//	if nil != err {
+//		return err
+//	}
+//
+//	fmt.Printf("port = %d\n", port.ElseUnwrap(5432))
+func (receiver *KeyValues) LoadInt64(key Key) (Int64, error) {
+	var datum Int64
+
+	text, loaded := receiver.Load(key).Unwrap()
+	if !loaded {
+		return datum, nil
+	}
+
+	if err := datum.UnmarshalText([]byte(text)); nil != err {
+		return NoInt64(), cannotConvert(key, text, "int64", err)
+	}
+
+	return datum, nil
+}
+
+// LoadTime returns the (first) value for ‘key’, as an ‘mdl.Time’, parsed with ‘layout’.
+// (See time.Parse() for how ‘layout’ works.)
+//
+// If ‘layout’ is empty, then time.RFC3339Nano is used (which also accepts time.RFC3339).
+//
+// If there is no value for ‘key’, then LoadTime returns mdl.NoTime(), and no error.
+// If the value cannot be parsed, then LoadTime returns an ‘mdl.CannotConvert’ error.
+//
+// Example
+//
+//	birthday, err := instruction.Data.LoadTime(mdl.SomeKey("birthday"), "2006-01-02")
+func (receiver *KeyValues) LoadTime(key Key, layout string) (Time, error) {
+	if "" == layout {
+		layout = time.RFC3339Nano
+	}
+
+	text, loaded := receiver.Load(key).Unwrap()
+	if !loaded {
+		return NoTime(), nil
+	}
+
+	datum, err := time.Parse(layout, text)
+	if nil != err {
+		return NoTime(), cannotConvert(key, text, "time.Time", err)
+	}
+
+	return SomeTime(datum), nil
+}
+
+// LoadUint64 returns the (first) value for ‘key’, as an ‘mdl.Uint64’.
+//
+// The value must be a base 10 integer that is not negative (ex: “5”).
+//
+// If there is no value for ‘key’, then LoadUint64 returns mdl.NoUint64(), and no error.
+// If the value cannot be parsed, then LoadUint64 returns an ‘mdl.CannotConvert’ error.
+func (receiver *KeyValues) LoadUint64(key Key) (Uint64, error) {
+	var datum Uint64
+
+	text, loaded := receiver.Load(key).Unwrap()
+	if !loaded {
+		return datum, nil
+	}
+
+	if err := datum.UnmarshalText([]byte(text)); nil != err {
+		return NoUint64(), cannotConvert(key, text, "uint64", err)
+	}
+
+	return datum, nil
+}
+
+// LoadURL returns the (first) value for ‘key’, if it is an absolute URL (ex: “https://example.com/path”).
+//
+// The value is returned as is (rather than as a *url.URL); so it can be stored, or compared, without
+// being changed by being parsed and re-serialized.
+//
+// If there is no value for ‘key’, then LoadURL returns mdl.NoString(), and no error.
+// If the value is not an absolute URL, then LoadURL returns an ‘mdl.CannotConvert’ error.
+func (receiver *KeyValues) LoadURL(key Key) (String, error) {
+	text, loaded := receiver.Load(key).Unwrap()
+	if !loaded {
+		return NoString(), nil
+	}
+
+	parsed, err := url.Parse(text)
+	if nil != err {
+		// A *url.Error repeats the value, which the ‘mdl.CannotConvert’ error already says.
+		if urlError, casted := err.(*url.Error); casted {
+			err = urlError.Err
+		}
+		return NoString(), cannotConvert(key, text, "URL", err)
+	}
+	if !parsed.IsAbs() || ("" == parsed.Host && "" == parsed.Opaque) {
+		return NoString(), cannotConvert(key, text, "URL", errNotAbsoluteURL)
+	}
+
+	return SomeString(text), nil
+}
@@ -0,0 +1,216 @@
+package mdl_test
+
+import (
+	"github.com/reiver/go-mdl"
+
+	"testing"
+	"time"
+)
+
+func TestKeyValuesLoadTyped(t *testing.T) {
+
+	var keyvalues mdl.KeyValues
+	keyvalues.Store(mdl.SomeKey("database", "port"), "5432")
+	keyvalues.Store(mdl.SomeKey("quota"), "18446744073709551615")
+	keyvalues.Store(mdl.SomeKey("score"), "-2.5")
+	keyvalues.Store(mdl.SomeKey("admin"), "t")
+	keyvalues.Store(mdl.SomeKey("born"), "1985-05-07")
+	keyvalues.Store(mdl.SomeKey("updated"), "2020-01-02T03:04:05.6Z")
+	keyvalues.Store(mdl.SomeKey("timeout"), "1m30s")
+	keyvalues.Store(mdl.SomeKey("homepage"), "https://example.com/path?q=1")
+	keyvalues.Store(mdl.SomeKey("email_address"), "joeblow@example.com")
+	keyvalues.Append(mdl.SomeKey("ages"), "33")
+	keyvalues.Append(mdl.SomeKey("ages"), "thirty-four")
+
+	tests := []struct{
+		Load     func() (interface{}, error)
+		Expected interface{}
+	}{
+		{
+			Load: func() (interface{}, error) { return keyvalues.LoadInt64(mdl.SomeKey("database", "port")) },
+			Expected: mdl.SomeInt64(5432),
+		},
+		{
+			Load: func() (interface{}, error) { return keyvalues.LoadInt64(mdl.SomeKey("ages")) },
+			Expected: mdl.SomeInt64(33),
+		},
+		{
+			Load: func() (interface{}, error) { return keyvalues.LoadUint64(mdl.SomeKey("quota")) },
+			Expected: mdl.SomeUint64(18446744073709551615),
+		},
+		{
+			Load: func() (interface{}, error) { return keyvalues.LoadFloat64(mdl.SomeKey("score")) },
+			Expected: mdl.SomeFloat64(-2.5),
+		},
+		{
+			Load: func() (interface{}, error) { return keyvalues.LoadBool(mdl.SomeKey("admin")) },
+			Expected: mdl.SomeBool(true),
+		},
+		{
+			Load: func() (interface{}, error) { return keyvalues.LoadTime(mdl.SomeKey("born"), "2006-01-02") },
+			Expected: mdl.SomeTime(time.Date(1985, 5, 7, 0, 0, 0, 0, time.UTC)),
+		},
+		{
+			Load: func() (interface{}, error) { return keyvalues.LoadTime(mdl.SomeKey("updated"), "") },
+			Expected: mdl.SomeTime(time.Date(2020, 1, 2, 3, 4, 5, 600000000, time.UTC)),
+		},
+		{
+			Load: func() (interface{}, error) { return keyvalues.LoadDuration(mdl.SomeKey("timeout")) },
+			Expected: mdl.SomeDuration(90 * time.Second),
+		},
+		{
+			Load: func() (interface{}, error) { return keyvalues.LoadURL(mdl.SomeKey("homepage")) },
+			Expected: mdl.SomeString("https://example.com/path?q=1"),
+		},
+		{
+			Load: func() (interface{}, error) { return keyvalues.LoadEmailAddress(mdl.SomeKey("email_address")) },
+			Expected: mdl.SomeString("joeblow@example.com"),
+		},
+
+		{
+			Load: func() (interface{}, error) { return keyvalues.LoadInt64(mdl.SomeKey("missing")) },
+			Expected: mdl.NoInt64(),
+		},
+		{
+			Load: func() (interface{}, error) { return keyvalues.LoadUint64(mdl.SomeKey("missing")) },
+			Expected: mdl.NoUint64(),
+		},
+		{
+			Load: func() (interface{}, error) { return keyvalues.LoadFloat64(mdl.SomeKey("missing")) },
+			Expected: mdl.NoFloat64(),
+		},
+		{
+			Load: func() (interface{}, error) { return keyvalues.LoadBool(mdl.SomeKey("missing")) },
+			Expected: mdl.NoBool(),
+		},
+		{
+			Load: func() (interface{}, error) { return keyvalues.LoadTime(mdl.SomeKey("missing"), "2006-01-02") },
+			Expected: mdl.NoTime(),
+		},
+		{
+			Load: func() (interface{}, error) { return keyvalues.LoadDuration(mdl.SomeKey("missing")) },
+			Expected: mdl.NoDuration(),
+		},
+		{
+			Load: func() (interface{}, error) { return keyvalues.LoadURL(mdl.SomeKey("missing")) },
+			Expected: mdl.NoString(),
+		},
+		{
+			Load: func() (interface{}, error) { return keyvalues.LoadEmailAddress(mdl.NoKey()) },
+			Expected: mdl.NoString(),
+		},
+	}
+
+	for testNumber, test := range tests {
+
+		actual, err := test.Load()
+		if nil != err {
+			t.Errorf("For test #%d, did not expect an error, but actually got one: (%T) %q", testNumber, err, err)
+			continue
+		}
+
+		if expected := test.Expected; expected != actual {
+			t.Errorf("For test #%d, expected %#v, but actually got %#v.", testNumber, expected, actual)
+			continue
+		}
+	}
+}
+
+func TestKeyValuesLoadTypedCannotConvert(t *testing.T) {
+
+	var keyvalues mdl.KeyValues
+	keyvalues.Store(mdl.SomeKey("database", "port"), "postgres")
+	keyvalues.Store(mdl.SomeKey("quota"), "-1")
+	keyvalues.Store(mdl.SomeKey("score"), "high")
+	keyvalues.Store(mdl.SomeKey("admin"), "maybe")
+	keyvalues.Store(mdl.SomeKey("born"), "May 7th")
+	keyvalues.Store(mdl.SomeKey("timeout"), "soon")
+	keyvalues.Store(mdl.SomeKey("homepage"), "/relative/path")
+	keyvalues.Store(mdl.SomeKey("website"), "http://[::1")
+	keyvalues.Store(mdl.SomeKey("email_address"), "joeblow")
+	keyvalues.Store(mdl.SomeKey("contact"), "Joe Blow <joeblow@example.com>")
+
+	tests := []struct{
+		Load          func() (interface{}, error)
+		ExpectedKey   mdl.Key
+		ExpectedValue string
+	}{
+		{
+			Load: func() (interface{}, error) { return keyvalues.LoadInt64(mdl.SomeKey("database", "port")) },
+			ExpectedKey: mdl.SomeKey("database", "port"),
+			ExpectedValue: "postgres",
+		},
+		{
+			Load: func() (interface{}, error) { return keyvalues.LoadUint64(mdl.SomeKey("quota")) },
+			ExpectedKey: mdl.SomeKey("quota"),
+			ExpectedValue: "-1",
+		},
+		{
+			Load: func() (interface{}, error) { return keyvalues.LoadFloat64(mdl.SomeKey("score")) },
+			ExpectedKey: mdl.SomeKey("score"),
+			ExpectedValue: "high",
+		},
+		{
+			Load: func() (interface{}, error) { return keyvalues.LoadBool(mdl.SomeKey("admin")) },
+			ExpectedKey: mdl.SomeKey("admin"),
+			ExpectedValue: "maybe",
+		},
+		{
+			Load: func() (interface{}, error) { return keyvalues.LoadTime(mdl.SomeKey("born"), "2006-01-02") },
+			ExpectedKey: mdl.SomeKey("born"),
+			ExpectedValue: "May 7th",
+		},
+		{
+			Load: func() (interface{}, error) { return keyvalues.LoadDuration(mdl.SomeKey("timeout")) },
+			ExpectedKey: mdl.SomeKey("timeout"),
+			ExpectedValue: "soon",
+		},
+		{
+			Load: func() (interface{}, error) { return keyvalues.LoadURL(mdl.SomeKey("homepage")) },
+			ExpectedKey: mdl.SomeKey("homepage"),
+			ExpectedValue: "/relative/path",
+		},
+		{
+			Load: func() (interface{}, error) { return keyvalues.LoadURL(mdl.SomeKey("website")) },
+			ExpectedKey: mdl.SomeKey("website"),
+			ExpectedValue: "http://[::1",
+		},
+		{
+			Load: func() (interface{}, error) { return keyvalues.LoadEmailAddress(mdl.SomeKey("email_address")) },
+			ExpectedKey: mdl.SomeKey("email_address"),
+			ExpectedValue: "joeblow",
+		},
+		{
+			Load: func() (interface{}, error) { return keyvalues.LoadEmailAddress(mdl.SomeKey("contact")) },
+			ExpectedKey: mdl.SomeKey("contact"),
+			ExpectedValue: "Joe Blow <joeblow@example.com>",
+		},
+	}
+
+	for testNumber, test := range tests {
+
+		_, err := test.Load()
+		if nil == err {
+			t.Errorf("For test #%d, expected an error, but did not actually get one.", testNumber)
+			continue
+		}
+
+		casted, ok := err.(mdl.CannotConvert)
+		if !ok {
+			t.Errorf("For test #%d, expected a mdl.CannotConvert error, but actually got: (%T) %q", testNumber, err, err)
+			continue
+		}
+
+		if expected, actual := test.ExpectedKey, casted.Key(); expected != actual {
+			t.Errorf("For test #%d, expected key %#v, but actually got %#v.", testNumber, expected, actual)
+			t.Logf("ERROR: %q", err)
+			continue
+		}
+
+		if expected, actual := test.ExpectedValue, casted.Value(); expected != actual {
+			t.Errorf("For test #%d, expected value %q, but actually got %q.", testNumber, expected, actual)
+			t.Logf("ERROR: %q", err)
+			continue
+		}
+	}
+}
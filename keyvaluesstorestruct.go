@@ -0,0 +1,301 @@
+package mdl
+
+import (
+	"encoding"
+	"fmt"
+	"reflect"
+	"strconv"
+	"time"
+)
+
+var (
+	reflectTypeOptionNothing = reflect.TypeOf((*optionNothing)(nil)).Elem()
+	reflectTypeTextMarshaler = reflect.TypeOf((*encoding.TextMarshaler)(nil)).Elem()
+)
+
+// StoreStruct stores each of the struct fields of ‘src’ (a struct, or a pointer to a struct) as key-value pairs.
+//
+// It is the opposite of .Bind(), and uses the same “mdl” struct tags to decide which key each struct field is stored at.
+//
+// Struct fields are turned into values like this:
+//
+// • ‘mdl.String’ is stored as is; except for mdl.NoString(), which is skipped,
+//
+// • ‘mdl.Key’ is stored in its canonical form; except for mdl.NoKey(), which is skipped,
+//
+// • the other option types (‘mdl.Int64’, ‘mdl.Bool’, ‘mdl.Time’, etc) are stored in their text form; except for ‘nothing’, which is skipped,
+//
+// • string, bool, the int types, the uint types, and the float types are formatted with the "strconv" package,
+//
+// • time.Duration is formatted with its .String() method,
+//
+// • anything that fits encoding.TextMarshaler (which includes time.Time, in RFC 3339 format) is formatted with its .MarshalText() method,
+//
+// • []byte is stored as is,
+//
+// • a nil pointer is skipped; any other pointer has what it points to stored,
+//
+// • a struct has each of its struct fields stored, under its key,
+//
+// • a slice of any of the types that are stored as a single value is stored as multiple values at its key (see .Append()), and
+//
+// • any other slice has each of its elements stored under its (zero-based) index.
+//
+// If 2 struct fields would be stored at the same key, or a struct field would be stored at a key that already has a value,
+// then StoreStruct returns an ‘mdl.KeyFound’ error, and nothing is stored.
+//
+// Example
+//
+//	type User struct {
+//		EmailAddress mdl.String `mdl:"email_address"`
+//		Street       string     `mdl:"address/street"`
+//		Tags         []string   `mdl:"tags"`
+//	}
+//
+//	user := User{
+//		EmailAddress: mdl.SomeString("joeblow@example.com"),
+//		Street:       "123 Main St",
+//		Tags:         []string{"a", "b"},
+//	}
+//
+//	var instruction mdl.Instruction
+//
+//	err := instruction.Data.StoreStruct(user)
+func (receiver *KeyValues) StoreStruct(src interface{}) error {
+	if nil == receiver {
+		return errNilReceiver
+	}
+
+	value := reflect.ValueOf(src)
+	if reflect.Ptr == value.Kind() {
+		if value.IsNil() {
+			return errNotStructPointer
+		}
+		value = value.Elem()
+	}
+	if reflect.Struct != value.Kind() {
+		return errNotStructPointer
+	}
+
+	var encoder keyValuesEncoder = keyValuesEncoder{
+		data: map[Key][]string{},
+	}
+
+	if err := encoder.encodeStruct(nil, value); nil != err {
+		return err
+	}
+
+	receiver.mutex.Lock()
+	defer receiver.mutex.Unlock()
+
+	for _, key := range encoder.keys {
+		if foundValues := receiver.data[key]; 0 < len(foundValues) {
+			return internalKeyFound{
+				key:key,
+				value:encoder.data[key][0],
+				foundValue:foundValues[0],
+			}
+		}
+	}
+
+	if nil == receiver.data {
+		receiver.data = map[Key][]string{}
+	}
+
+	for _, key := range encoder.keys {
+		if _, found := receiver.data[key]; !found {
+			receiver.indexKey(key)
+		}
+		receiver.data[key] = encoder.data[key]
+	}
+
+	return nil
+}
+
+type keyValuesEncoder struct {
+	data map[Key][]string
+	keys []Key
+}
+
+func (receiver *keyValuesEncoder) encodeStruct(prefix []string, value reflect.Value) error {
+	typ := value.Type()
+
+	for i := 0; i < typ.NumField(); i++ {
+		field := typ.Field(i)
+
+		tokens, skip, err := keyValuesFieldTokens(prefix, field)
+		if nil != err {
+			return err
+		}
+		if skip {
+			continue
+		}
+
+		if err := receiver.encodeValue(tokens, value.Field(i)); nil != err {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (receiver *keyValuesEncoder) encodeValue(tokens []string, value reflect.Value) error {
+	typ := value.Type()
+
+	if reflect.Ptr == typ.Kind() {
+		if value.IsNil() {
+			return nil
+		}
+		return receiver.encodeValue(tokens, value.Elem())
+	}
+
+	if keyValuesIsLeaf(typ) {
+		if 0 == len(tokens) {
+			return fmt.Errorf("mdl: cannot store %s without a key", typ)
+		}
+
+		key := SomeKey(tokens...)
+
+		text, ok, err := keyValuesText(key, value)
+		if nil != err {
+			return err
+		}
+		if !ok {
+			return nil
+		}
+
+		return receiver.store(key, text)
+	}
+
+	switch typ.Kind() {
+	case reflect.Struct:
+		return receiver.encodeStruct(tokens, value)
+
+	case reflect.Slice:
+		return receiver.encodeSlice(tokens, value)
+
+	default:
+		return fmt.Errorf("mdl: cannot store %s at key %#v, since it is an unsupported type", typ, SomeKey(tokens...))
+	}
+}
+
+func (receiver *keyValuesEncoder) encodeSlice(tokens []string, value reflect.Value) error {
+	typ := value.Type()
+
+	if 0 == len(tokens) {
+		return fmt.Errorf("mdl: cannot store %s without a key", typ)
+	}
+
+	if value.IsNil() {
+		return nil
+	}
+
+	key := SomeKey(tokens...)
+
+	if reflect.Uint8 == typ.Elem().Kind() {
+		return receiver.store(key, string(value.Bytes()))
+	}
+
+	if keyValuesIsLeaf(typ.Elem()) {
+		var values []string
+		for i := 0; i < value.Len(); i++ {
+			text, ok, err := keyValuesText(key, value.Index(i))
+			if nil != err {
+				return err
+			}
+			if !ok {
+				continue
+			}
+			values = append(values, text)
+		}
+
+		return receiver.store(key, values...)
+	}
+
+	for i := 0; i < value.Len(); i++ {
+		if err := receiver.encodeValue(keyValuesAppendToken(tokens, strconv.Itoa(i)), value.Index(i)); nil != err {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (receiver *keyValuesEncoder) store(key Key, values ...string) error {
+	if 0 == len(values) {
+		return nil
+	}
+
+	if foundValues, found := receiver.data[key]; found {
+		return internalKeyFound{
+			key:key,
+			value:values[0],
+			foundValue:foundValues[0],
+		}
+	}
+
+	receiver.data[key] = values
+	receiver.keys = append(receiver.keys, key)
+
+	return nil
+}
+
+// keyValuesText returns the value to store for ‘value’. It returns false if nothing should be stored.
+func keyValuesText(key Key, value reflect.Value) (string, bool, error) {
+	typ := value.Type()
+
+	switch {
+	case reflectTypeString == typ:
+		text, ok := value.Interface().(String).Unwrap()
+		return text, ok, nil
+
+	case reflectTypeKey == typ:
+		asKey := value.Interface().(Key)
+		if NoKey() == asKey {
+			return "", false, nil
+		}
+		return asKey.CanonicalForm(), true, nil
+
+	case reflectTypeDuration == typ:
+		return time.Duration(value.Int()).String(), true, nil
+
+	case reflect.Ptr == typ.Kind():
+		if value.IsNil() {
+			return "", false, nil
+		}
+		return keyValuesText(key, value.Elem())
+
+	case typ.Implements(reflectTypeOptionNothing) && value.Interface().(optionNothing).isNothing():
+		return "", false, nil
+
+	case typ.Implements(reflectTypeTextMarshaler), value.CanAddr() && reflect.PtrTo(typ).Implements(reflectTypeTextMarshaler):
+		if !typ.Implements(reflectTypeTextMarshaler) {
+			value = value.Addr()
+		}
+		text, err := value.Interface().(encoding.TextMarshaler).MarshalText()
+		if nil != err {
+			return "", false, fmt.Errorf("mdl: cannot store value at key %#v: %s", key, err)
+		}
+		return string(text), true, nil
+	}
+
+	switch typ.Kind() {
+	case reflect.String:
+		return value.String(), true, nil
+
+	case reflect.Bool:
+		return strconv.FormatBool(value.Bool()), true, nil
+
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return strconv.FormatInt(value.Int(), 10), true, nil
+
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		return strconv.FormatUint(value.Uint(), 10), true, nil
+
+	case reflect.Float32, reflect.Float64:
+		return strconv.FormatFloat(value.Float(), 'g', -1, typ.Bits()), true, nil
+
+	default:
+		return "", false, fmt.Errorf("mdl: cannot store %s at key %#v, since it is an unsupported type", typ, key)
+	}
+}
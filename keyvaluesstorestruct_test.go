@@ -0,0 +1,265 @@
+package mdl_test
+
+import (
+	"github.com/reiver/go-mdl"
+
+	"reflect"
+	"testing"
+	"time"
+)
+
+func TestKeyValuesStoreStruct(t *testing.T) {
+
+	type Address struct {
+		Street string `mdl:"street"`
+		City   string `mdl:"city"`
+	}
+
+	type Base struct {
+		ID uint64 `mdl:"id"`
+	}
+
+	type User struct {
+		Base
+
+		EmailAddress mdl.String    `mdl:"email_address"`
+		Nickname     mdl.String    `mdl:"nickname"`
+		Age          int           `mdl:"age"`
+		Score        float64       `mdl:"score"`
+		Admin        bool          `mdl:"admin"`
+		Born         time.Time     `mdl:"born"`
+		Timeout      time.Duration `mdl:"timeout"`
+		Address      Address       `mdl:"address"`
+		Work         *Address      `mdl:"work"`
+		Home         *Address      `mdl:"home"`
+		Height       *int          `mdl:"height"`
+		Weight       *int          `mdl:"weight"`
+		Tags         []string      `mdl:"tags"`
+		Previous     []Address     `mdl:"previous"`
+		Avatar       []byte        `mdl:"avatar"`
+		Odd          string        `mdl:"i\\/o"`
+		Ignored      string        `mdl:"-"`
+		Untagged     string
+		unexported   string
+	}
+
+	height := 180
+
+	user := User{
+		Base: Base{
+			ID: 42,
+		},
+		EmailAddress: mdl.SomeString("joeblow@example.com"),
+		Nickname:     mdl.NoString(),
+		Age:          33,
+		Score:        -2.5,
+		Admin:        true,
+		Born:         time.Date(1985, 5, 7, 10, 25, 9, 0, time.UTC),
+		Timeout:      90 * time.Second,
+		Address: Address{
+			Street: "123 Main St",
+			City:   "Vancouver",
+		},
+		Work: &Address{
+			City: "Toronto",
+		},
+		Height:   &height,
+		Tags:     []string{"a", "b"},
+		Previous: []Address{
+			{City: "Halifax"},
+		},
+		Avatar:     []byte("\x89PNG"),
+		Odd:        "yes",
+		Ignored:    "no",
+		Untagged:   "untagged",
+		unexported: "no",
+	}
+
+	var keyvalues mdl.KeyValues
+
+	if err := keyvalues.StoreStruct(&user); nil != err {
+		t.Errorf("Did not expect an error, but actually got one: (%T) %q", err, err)
+		return
+	}
+
+	var expected mdl.KeyValues
+	expected.Store(mdl.SomeKey("id"), "42")
+	expected.Store(mdl.SomeKey("email_address"), "joeblow@example.com")
+	expected.Store(mdl.SomeKey("age"), "33")
+	expected.Store(mdl.SomeKey("score"), "-2.5")
+	expected.Store(mdl.SomeKey("admin"), "true")
+	expected.Store(mdl.SomeKey("born"), "1985-05-07T10:25:09Z")
+	expected.Store(mdl.SomeKey("timeout"), "1m30s")
+	expected.Store(mdl.SomeKey("address", "street"), "123 Main St")
+	expected.Store(mdl.SomeKey("address", "city"), "Vancouver")
+	expected.Store(mdl.SomeKey("work", "street"), "")
+	expected.Store(mdl.SomeKey("work", "city"), "Toronto")
+	expected.Store(mdl.SomeKey("height"), "180")
+	expected.Append(mdl.SomeKey("tags"), "a")
+	expected.Append(mdl.SomeKey("tags"), "b")
+	expected.Store(mdl.SomeKey("previous", "0", "street"), "")
+	expected.Store(mdl.SomeKey("previous", "0", "city"), "Halifax")
+	expected.Store(mdl.SomeKey("avatar"), "\x89PNG")
+	expected.Store(mdl.SomeKey("i/o"), "yes")
+	expected.Store(mdl.SomeKey("Untagged"), "untagged")
+
+	if expected, actual := expected.CanonicalForm(), keyvalues.CanonicalForm(); expected != actual {
+		t.Errorf("The key-values that were actually gotten were not what was expected.")
+		t.Logf("EXPECTED:...")
+		t.Log(expected)
+		t.Logf("ACTUAL:...")
+		t.Log(actual)
+		return
+	}
+
+	var bound User
+	if err := keyvalues.Bind(&bound); nil != err {
+		t.Errorf("Did not expect an error, but actually got one: (%T) %q", err, err)
+		return
+	}
+	user.Ignored = ""
+	user.unexported = ""
+	if !reflect.DeepEqual(user, bound) {
+		t.Errorf("Binding the stored key-values did not give back the same struct.")
+		t.Logf("EXPECTED: %#v", user)
+		t.Logf("ACTUAL:   %#v", bound)
+		return
+	}
+}
+
+func TestKeyValuesStoreStructKeyFound(t *testing.T) {
+
+	{
+		var keyvalues mdl.KeyValues
+
+		src := struct{
+			Street  string `mdl:"address/street"`
+			Address struct{
+				Street string `mdl:"street"`
+			} `mdl:"address"`
+		}{
+			Street: "123 Main St",
+		}
+		src.Address.Street = "1 Water St"
+
+		err := keyvalues.StoreStruct(src)
+		casted, ok := err.(mdl.KeyFound)
+		if !ok {
+			t.Errorf("Expected a mdl.KeyFound error, but actually got: (%T) %v", err, err)
+			return
+		}
+		if expected, actual := mdl.SomeKey("address", "street"), casted.Key(); expected != actual {
+			t.Errorf("Expected key %#v, but actually got %#v.", expected, actual)
+			return
+		}
+		if expected, actual := 0, keyvalues.Len(); expected != actual {
+			t.Errorf("Expected nothing to be stored, but actually %d keys were stored.", actual)
+			return
+		}
+	}
+
+	{
+		var keyvalues mdl.KeyValues
+		keyvalues.Store(mdl.SomeKey("age"), "33")
+
+		src := struct{
+			Name string `mdl:"name"`
+			Age  int    `mdl:"age"`
+		}{
+			Name: "Joe",
+			Age:  34,
+		}
+
+		err := keyvalues.StoreStruct(src)
+		casted, ok := err.(mdl.KeyFound)
+		if !ok {
+			t.Errorf("Expected a mdl.KeyFound error, but actually got: (%T) %v", err, err)
+			return
+		}
+		if expected, actual := "33", casted.FoundValue(); expected != actual {
+			t.Errorf("Expected found value %q, but actually got %q.", expected, actual)
+			return
+		}
+		if expected, actual := 1, keyvalues.Len(); expected != actual {
+			t.Errorf("Expected nothing to be stored, but actually got %d keys.", actual)
+			return
+		}
+	}
+}
+
+func TestKeyValuesStoreStructError(t *testing.T) {
+
+	var keyvalues mdl.KeyValues
+
+	var notStruct int
+
+	tests := []interface{}{
+		nil,
+		notStruct,
+		&notStruct,
+		(*struct{})(nil),
+		struct{
+			Lookup map[string]string `mdl:"lookup"`
+		}{
+			Lookup: map[string]string{},
+		},
+	}
+
+	for testNumber, src := range tests {
+		if err := keyvalues.StoreStruct(src); nil == err {
+			t.Errorf("For test #%d, expected an error, but did not actually get one.", testNumber)
+			t.Logf("SRC: %#v", src)
+			continue
+		}
+	}
+}
+
+func TestKeyValuesStoreStructOptionTypes(t *testing.T) {
+
+	type Settings struct {
+		Port    mdl.Int64    `mdl:"port"`
+		Limit   mdl.Uint64   `mdl:"limit"`
+		Ratio   mdl.Float64  `mdl:"ratio"`
+		Debug   mdl.Bool     `mdl:"debug"`
+		Started mdl.Time     `mdl:"started"`
+		Timeout mdl.Duration `mdl:"timeout"`
+		Secret  mdl.Bytes    `mdl:"secret"`
+	}
+
+	settings := Settings{
+		Port:    mdl.SomeInt64(5432),
+		Debug:   mdl.SomeBool(false),
+		Timeout: mdl.SomeDuration(90 * time.Second),
+	}
+
+	var keyvalues mdl.KeyValues
+	if err := keyvalues.StoreStruct(settings); nil != err {
+		t.Errorf("Did not expect an error, but actually got one: (%T) %q", err, err)
+		return
+	}
+
+	var expected mdl.KeyValues
+	expected.Store(mdl.SomeKey("port"), "5432")
+	expected.Store(mdl.SomeKey("debug"), "false")
+	expected.Store(mdl.SomeKey("timeout"), "1m30s")
+
+	if expected, actual := expected.CanonicalForm(), keyvalues.CanonicalForm(); expected != actual {
+		t.Errorf("The key-values that were actually gotten were not what was expected.")
+		t.Logf("EXPECTED:...")
+		t.Log(expected)
+		t.Logf("ACTUAL:...")
+		t.Log(actual)
+		return
+	}
+
+	var bound Settings
+	if err := keyvalues.Bind(&bound); nil != err {
+		t.Errorf("Did not expect an error, but actually got one: (%T) %q", err, err)
+		return
+	}
+
+	if expected, actual := settings, bound; expected != actual {
+		t.Errorf("Expected %#v, but actually got %#v.", expected, actual)
+		return
+	}
+}
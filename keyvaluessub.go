@@ -0,0 +1,160 @@
+package mdl
+
+import (
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// Sub returns a read-only view of the key-value pairs whose keys start with ‘prefix’ (see Key.HasPrefix()),
+// with ‘prefix’ stripped from their keys.
+//
+// The view is not a copy; so changes made to the ‘mdl.KeyValues’ after .Sub() is called show up in the view.
+//
+// (A value stored at ‘prefix’ itself is not part of the view, since it would have no key once ‘prefix’ is stripped.)
+//
+// Example
+//
+//	var keyvalues mdl.KeyValues
+//
+//	keyvalues.Store(mdl.SomeKey("database", "username"), "joeblow")
+//	keyvalues.Store(mdl.SomeKey("database", "password"), "password123")
+//	keyvalues.Store(mdl.SomeKey("version"), "2")
+//
+//	database := keyvalues.Sub(mdl.SomeKey("database"))
+//
+//	username := database.Load(mdl.SomeKey("username")) // == mdl.SomeString("joeblow")
+//	version  := database.Load(mdl.SomeKey("version"))  // == mdl.NoString()
+func (receiver *KeyValues) Sub(prefix Key) KeyValuesView {
+	return KeyValuesView{
+		keyvalues: receiver,
+		prefix: prefix,
+	}
+}
+
+// KeyValuesView is a read-only view of some of the key-value pairs in an ‘mdl.KeyValues’.
+//
+// See mdl.KeyValues.Sub().
+type KeyValuesView struct {
+	keyvalues *KeyValues
+	prefix Key
+}
+
+// CanonicalForm returns the ‘mdl.KeyValuesView’ in ‘canonical form’; with the same format as mdl.KeyValues.CanonicalForm(),
+// but with the prefix stripped from the keys.
+func (receiver KeyValuesView) CanonicalForm() string {
+	var lines []string
+	receiver.rangePrefix(NoKey(), func(key Key, values []string) bool {
+		for _, v := range values {
+			lines = append(lines, key.CanonicalForm()+" "+strconv.Quote(v)+"\n")
+		}
+		return true
+	})
+
+	sort.Strings(lines)
+
+	return strings.Join(lines, "")
+}
+
+// Fetch is similar to .Load().
+func (receiver KeyValuesView) Fetch(key ...string) String {
+	return receiver.Load(SomeKey(key...))
+}
+
+// FetchAll is similar to .LoadAll().
+func (receiver KeyValuesView) FetchAll(key ...string) []string {
+	return receiver.LoadAll(SomeKey(key...))
+}
+
+// For calls ‘fn’ for each key-value pair in the view, sorted by key.
+//
+// A key with more than one value has ‘fn’ called for each of its values.
+//
+// Like mdl.KeyValues.For(), it goes through a snapshot, and does not hold a lock while calling ‘fn’.
+func (receiver KeyValuesView) For(fn func(Key, string)) {
+	receiver.ForPrefix(NoKey(), fn)
+}
+
+// ForPrefix calls ‘fn’ for each key-value pair in the view whose key starts with ‘prefix’, sorted by key.
+func (receiver KeyValuesView) ForPrefix(prefix Key, fn func(Key, string)) {
+	receiver.RangePrefix(prefix, func(key Key, value string) bool {
+		fn(key, value)
+		return true
+	})
+}
+
+// Len returns the number of keys in the view.
+func (receiver KeyValuesView) Len() int {
+	var length int
+	receiver.rangePrefix(NoKey(), func(Key, []string) bool {
+		length++
+		return true
+	})
+
+	return length
+}
+
+// Load returns the (first) value for ‘key’ (which does not include the prefix).
+func (receiver KeyValuesView) Load(key Key) String {
+	if NoKey() == key {
+		return NoString()
+	}
+
+	return receiver.keyvalues.Load(receiver.prefix.Join(key))
+}
+
+// LoadAll returns all the values for ‘key’ (which does not include the prefix), in the order they were stored.
+//
+// If there are no values for ‘key’, then LoadAll returns nil.
+func (receiver KeyValuesView) LoadAll(key Key) []string {
+	if NoKey() == key {
+		return nil
+	}
+
+	return receiver.keyvalues.LoadAll(receiver.prefix.Join(key))
+}
+
+// Prefix returns the prefix that is stripped from the keys of the view.
+func (receiver KeyValuesView) Prefix() Key {
+	return receiver.prefix
+}
+
+// Range is like .For(), except that if ‘fn’ returns false, then Range stops.
+func (receiver KeyValuesView) Range(fn func(Key, string) bool) {
+	receiver.RangePrefix(NoKey(), fn)
+}
+
+// RangePrefix is like .ForPrefix(), except that if ‘fn’ returns false, then RangePrefix stops.
+func (receiver KeyValuesView) RangePrefix(prefix Key, fn func(Key, string) bool) {
+	receiver.rangePrefix(prefix, func(key Key, values []string) bool {
+		for _, v := range values {
+			if !fn(key, v) {
+				return false
+			}
+		}
+		return true
+	})
+}
+
+// Sub returns a read-only view of the key-value pairs in this view whose keys start with ‘prefix’, with ‘prefix’ stripped from their keys.
+//
+// I.e., keyvalues.Sub(a).Sub(b) is the same as keyvalues.Sub(a.Join(b)).
+func (receiver KeyValuesView) Sub(prefix Key) KeyValuesView {
+	return KeyValuesView{
+		keyvalues: receiver.keyvalues,
+		prefix: receiver.prefix.Join(prefix),
+	}
+}
+
+// rangePrefix calls ‘fn’ for each key in the view that starts with ‘prefix’ (and its values), with the view's prefix stripped from the key,
+// until ‘fn’ returns false.
+func (receiver KeyValuesView) rangePrefix(prefix Key, fn func(Key, []string) bool) {
+	receiver.keyvalues.rangePrefix(receiver.prefix.Join(prefix), func(key Key, values []string) bool {
+		stripped := key.TrimPrefix(receiver.prefix)
+		if NoKey() == stripped {
+			return true
+		}
+
+		return fn(stripped, values)
+	})
+}
@@ -0,0 +1,191 @@
+package mdl_test
+
+import (
+	"github.com/reiver/go-mdl"
+
+	"reflect"
+	"testing"
+)
+
+type keyValuesSubPair struct {
+	Key   mdl.Key
+	Value string
+}
+
+func keyValuesSubFixture() *mdl.KeyValues {
+	var keyvalues mdl.KeyValues
+
+	keyvalues.Store(mdl.SomeKey("version"), "2")
+	keyvalues.Store(mdl.SomeKey("database", "username"), "joeblow")
+	keyvalues.Store(mdl.SomeKey("database"), "primary")
+	keyvalues.Store(mdl.SomeKey("databases"), "no")
+	keyvalues.Store(mdl.SomeKey("database!"), "no")
+	keyvalues.Store(mdl.SomeKey("database/x"), "no")
+	keyvalues.Store(mdl.SomeKey("database", "password"), "password123")
+	keyvalues.Store(mdl.SomeKey("database", "replica", "host"), "db2.example.com")
+	keyvalues.Append(mdl.SomeKey("database", "options"), "b")
+	keyvalues.Append(mdl.SomeKey("database", "options"), "a")
+	keyvalues.Store(mdl.SomeKey("cache", "host"), "cache.example.com")
+
+	return &keyvalues
+}
+
+func TestKeyValuesForPrefix(t *testing.T) {
+
+	keyvalues := keyValuesSubFixture()
+
+	var actual []keyValuesSubPair
+	keyvalues.ForPrefix(mdl.SomeKey("database"), func(key mdl.Key, value string) {
+		actual = append(actual, keyValuesSubPair{key, value})
+	})
+
+	expected := []keyValuesSubPair{
+		{mdl.SomeKey("database"), "primary"},
+		{mdl.SomeKey("database", "options"), "b"},
+		{mdl.SomeKey("database", "options"), "a"},
+		{mdl.SomeKey("database", "password"), "password123"},
+		{mdl.SomeKey("database", "replica", "host"), "db2.example.com"},
+		{mdl.SomeKey("database", "username"), "joeblow"},
+	}
+
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("Expected %#v, but actually got %#v.", expected, actual)
+		return
+	}
+}
+
+func TestKeyValuesForPrefixNoKey(t *testing.T) {
+
+	keyvalues := keyValuesSubFixture()
+
+	var actual []mdl.Key
+	keyvalues.ForPrefix(mdl.NoKey(), func(key mdl.Key, value string) {
+		if 0 < len(actual) && actual[len(actual)-1] == key {
+			return
+		}
+		actual = append(actual, key)
+	})
+
+	if expected, actual := keyvalues.Len(), len(actual); expected != actual {
+		t.Errorf("Expected %d keys, but actually got %d.", expected, actual)
+		return
+	}
+
+	for i := 1; i < len(actual); i++ {
+		if 0 <= actual[i-1].Compare(actual[i]) {
+			t.Errorf("Expected the keys to be sorted, but %#v comes before %#v.", actual[i-1], actual[i])
+			return
+		}
+	}
+}
+
+func TestKeyValuesSub(t *testing.T) {
+
+	keyvalues := keyValuesSubFixture()
+
+	database := keyvalues.Sub(mdl.SomeKey("database"))
+
+	if expected, actual := mdl.SomeKey("database"), database.Prefix(); expected != actual {
+		t.Errorf("Expected prefix %#v, but actually got %#v.", expected, actual)
+		return
+	}
+
+	if expected, actual := 4, database.Len(); expected != actual {
+		t.Errorf("Expected %d keys, but actually got %d.", expected, actual)
+		return
+	}
+
+	if expected, actual := mdl.SomeString("joeblow"), database.Load(mdl.SomeKey("username")); expected != actual {
+		t.Errorf("Expected %#v, but actually got %#v.", expected, actual)
+		return
+	}
+	if expected, actual := mdl.SomeString("password123"), database.Fetch("password"); expected != actual {
+		t.Errorf("Expected %#v, but actually got %#v.", expected, actual)
+		return
+	}
+	if expected, actual := mdl.NoString(), database.Load(mdl.SomeKey("version")); expected != actual {
+		t.Errorf("Expected %#v, but actually got %#v.", expected, actual)
+		return
+	}
+	if expected, actual := mdl.NoString(), database.Load(mdl.NoKey()); expected != actual {
+		t.Errorf("Expected %#v, but actually got %#v.", expected, actual)
+		return
+	}
+	if expected, actual := []string{"b", "a"}, database.FetchAll("options"); !reflect.DeepEqual(expected, actual) {
+		t.Errorf("Expected %#v, but actually got %#v.", expected, actual)
+		return
+	}
+
+	{
+		var actual []keyValuesSubPair
+		database.For(func(key mdl.Key, value string) {
+			actual = append(actual, keyValuesSubPair{key, value})
+		})
+
+		expected := []keyValuesSubPair{
+			{mdl.SomeKey("options"), "b"},
+			{mdl.SomeKey("options"), "a"},
+			{mdl.SomeKey("password"), "password123"},
+			{mdl.SomeKey("replica", "host"), "db2.example.com"},
+			{mdl.SomeKey("username"), "joeblow"},
+		}
+
+		if !reflect.DeepEqual(expected, actual) {
+			t.Errorf("Expected %#v, but actually got %#v.", expected, actual)
+			return
+		}
+	}
+
+	{
+		expected := "options \"a\"\n" +
+			"options \"b\"\n" +
+			"password \"password123\"\n" +
+			"replica/host \"db2.example.com\"\n" +
+			"username \"joeblow\"\n"
+
+		if actual := database.CanonicalForm(); expected != actual {
+			t.Errorf("Expected %q, but actually got %q.", expected, actual)
+			return
+		}
+	}
+
+	replica := database.Sub(mdl.SomeKey("replica"))
+	if expected, actual := keyvalues.Sub(mdl.SomeKey("database", "replica")), replica; expected != actual {
+		t.Errorf("Expected %#v, but actually got %#v.", expected, actual)
+		return
+	}
+	if expected, actual := mdl.SomeString("db2.example.com"), replica.Fetch("host"); expected != actual {
+		t.Errorf("Expected %#v, but actually got %#v.", expected, actual)
+		return
+	}
+
+	// The view is not a copy.
+	keyvalues.Store(mdl.SomeKey("database", "port"), "5432")
+
+	if expected, actual := mdl.SomeString("5432"), database.Fetch("port"); expected != actual {
+		t.Errorf("Expected %#v, but actually got %#v.", expected, actual)
+		return
+	}
+	if expected, actual := 5, database.Len(); expected != actual {
+		t.Errorf("Expected %d keys, but actually got %d.", expected, actual)
+		return
+	}
+}
+
+func TestKeyValuesSubZero(t *testing.T) {
+
+	var view mdl.KeyValuesView
+
+	if expected, actual := 0, view.Len(); expected != actual {
+		t.Errorf("Expected %d, but actually got %d.", expected, actual)
+		return
+	}
+	if expected, actual := mdl.NoString(), view.Fetch("anything"); expected != actual {
+		t.Errorf("Expected %#v, but actually got %#v.", expected, actual)
+		return
+	}
+	if expected, actual := "", view.CanonicalForm(); expected != actual {
+		t.Errorf("Expected %q, but actually got %q.", expected, actual)
+		return
+	}
+}
@@ -0,0 +1,66 @@
+package mdl
+
+import (
+	"fmt"
+	"net/http"
+)
+
+// MalformedBody is the error returned from mdl.Instruction.Scan() when the HTTP request body could not be parsed (for example, because it is not valid JSON).
+//
+// For example:
+//
+//	var instruction mdl.Instruction
+//	
+//	// ...
+//	
+//	err := instruction.Scan(request)
+//	
+//	if nil != err {
+//		switch casted := err.(type) {
+//		case mdl.MalformedBody:
+//			http.Error(responseWriter, casted.Error(), casted.StatusCode())
+//			return
+//		default:
+//			//@TODO
+//		}
+//	}
+type MalformedBody interface {
+	error
+	MalformedBody()
+
+	// StatusCode returns the HTTP status code that goes with this error (i.e., 400 “Bad Request”).
+	StatusCode() int
+
+	// Unwrap returns the error that caused this error, if there is one.
+	Unwrap() error
+}
+
+type internalMalformedBody struct {
+	err error
+}
+
+func malformedBody(err error) MalformedBody {
+	return internalMalformedBody{
+		err: err,
+	}
+}
+
+func (receiver internalMalformedBody) Error() string {
+	if nil == receiver.err {
+		return "mdl: malformed body"
+	}
+
+	return fmt.Sprintf("mdl: malformed body: %s", receiver.err)
+}
+
+func (receiver internalMalformedBody) StatusCode() int {
+	return http.StatusBadRequest
+}
+
+func (receiver internalMalformedBody) Unwrap() error {
+	return receiver.err
+}
+
+func (internalMalformedBody) MalformedBody() {
+	// Nothing here.
+}
@@ -0,0 +1,23 @@
+package mdl
+
+import (
+	"testing"
+)
+
+func TestInternalMalformedBodyAsError(t *testing.T) {
+	var err error = internalMalformedBody{} // THIS IS THE LINE THAT ACTUALLY MATTERS.
+
+	if nil == err {
+		t.Errorf("This should never happen.")
+		return
+	}
+}
+
+func TestInternalMalformedBodyAsMalformedBody(t *testing.T) {
+	var complainer MalformedBody = internalMalformedBody{} // THIS IS THE LINE THAT ACTUALLY MATTERS.
+
+	if nil == complainer {
+		t.Errorf("This should never happen.")
+		return
+	}
+}
@@ -0,0 +1,52 @@
+package mdl
+
+import (
+	"fmt"
+)
+
+// MalformedIdempotentID is the error returned from mdl.ParseIdempotentID() when the Idempotent ID does not have
+// the expected shape.
+//
+// For example:
+//
+//	when, err := mdl.ParseIdempotentID(id)
+//	
+//	if nil != err {
+//		switch err.(type) {
+//		case mdl.MalformedIdempotentID:
+//			//@TODO
+//		default:
+//			//@TODO
+//		}
+//	}
+type MalformedIdempotentID interface {
+	error
+	MalformedIdempotentID()
+
+	// IdempotentID returns the Idempotent ID that was malformed.
+	IdempotentID() string
+}
+
+type internalMalformedIdempotentID struct {
+	id     string
+	reason string
+}
+
+func malformedIdempotentID(id string, reason string) MalformedIdempotentID {
+	return internalMalformedIdempotentID{
+		id:     id,
+		reason: reason,
+	}
+}
+
+func (receiver internalMalformedIdempotentID) Error() string {
+	return fmt.Sprintf("mdl: malformed idempotent ID %q: %s", receiver.id, receiver.reason)
+}
+
+func (receiver internalMalformedIdempotentID) IdempotentID() string {
+	return receiver.id
+}
+
+func (internalMalformedIdempotentID) MalformedIdempotentID() {
+	// Nothing here.
+}
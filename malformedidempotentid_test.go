@@ -0,0 +1,23 @@
+package mdl
+
+import (
+	"testing"
+)
+
+func TestInternalMalformedIdempotentIDAsError(t *testing.T) {
+	var err error = internalMalformedIdempotentID{} // THIS IS THE LINE THAT ACTUALLY MATTERS.
+
+	if nil == err {
+		t.Errorf("This should never happen.")
+		return
+	}
+}
+
+func TestInternalMalformedIdempotentIDAsMalformedIdempotentID(t *testing.T) {
+	var complainer MalformedIdempotentID = internalMalformedIdempotentID{} // THIS IS THE LINE THAT ACTUALLY MATTERS.
+
+	if nil == complainer {
+		t.Errorf("This should never happen.")
+		return
+	}
+}
@@ -0,0 +1,69 @@
+package mdl
+
+import (
+	"fmt"
+)
+
+// MalformedKey is the error returned from mdl.KeyDeserialize(), mdl.Key.Scan(), mdl.Key.UnmarshalText(), and mdl.Key.UnmarshalJSON()
+// when the serialized key is not valid; such as when it ends with a lone backslash, or is not valid UTF-8.
+//
+// It is also returned from mdl.CompileKeyPattern() when the key pattern is not valid.
+//
+// For example:
+//
+//	var key mdl.Key
+//	
+//	// ...
+//	
+//	err := key.Scan(`database\`)
+//	
+//	if nil != err {
+//		switch casted := err.(type) {
+//		case mdl.MalformedKey:
+//			fmt.Printf("bad key: %q\n", casted.Serialized())
+//		default:
+//			//@TODO
+//		}
+//	}
+type MalformedKey interface {
+	error
+	MalformedKey()
+
+	// Serialized returns the serialized key that was not valid.
+	Serialized() string
+
+	// Unwrap returns the error that caused this error, if there is one.
+	Unwrap() error
+}
+
+type internalMalformedKey struct {
+	serialized string
+	err error
+}
+
+func malformedKey(serialized string, err error) MalformedKey {
+	return internalMalformedKey{
+		serialized: serialized,
+		err:        err,
+	}
+}
+
+func (receiver internalMalformedKey) Error() string {
+	if nil == receiver.err {
+		return fmt.Sprintf("mdl: malformed key %q", receiver.serialized)
+	}
+
+	return fmt.Sprintf("mdl: malformed key %q: %s", receiver.serialized, receiver.err)
+}
+
+func (receiver internalMalformedKey) Serialized() string {
+	return receiver.serialized
+}
+
+func (receiver internalMalformedKey) Unwrap() error {
+	return receiver.err
+}
+
+func (internalMalformedKey) MalformedKey() {
+	// Nothing here.
+}
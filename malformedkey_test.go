@@ -0,0 +1,23 @@
+package mdl
+
+import (
+	"testing"
+)
+
+func TestInternalMalformedKeyAsError(t *testing.T) {
+	var err error = internalMalformedKey{} // THIS IS THE LINE THAT ACTUALLY MATTERS.
+
+	if nil == err {
+		t.Errorf("This should never happen.")
+		return
+	}
+}
+
+func TestInternalMalformedKeyAsMalformedKey(t *testing.T) {
+	var complainer MalformedKey = internalMalformedKey{} // THIS IS THE LINE THAT ACTUALLY MATTERS.
+
+	if nil == complainer {
+		t.Errorf("This should never happen.")
+		return
+	}
+}
@@ -0,0 +1,583 @@
+package mdltest
+
+import (
+	"github.com/reiver/go-mdl"
+
+	"fmt"
+	"math"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+var eventCount uint64
+
+// TestEventStore checks that an ‘mdl.EventStore’ behaves the way package mdl expects it to.
+//
+// ‘newStore’ is called to get a new (empty) ‘mdl.EventStore’ for each of the checks, which are run as subtests.
+// (Anything that needs to be cleaned up afterwards can be done after TestEventStore returns.)
+//
+// Example
+//
+//	func TestMyEventStore(t *testing.T) {
+//		mdltest.TestEventStore(t, func(t *testing.T) mdl.EventStore {
+//			return NewMyEventStore()
+//		})
+//	}
+func TestEventStore(t *testing.T, newStore func(t *testing.T) mdl.EventStore) {
+
+	tests := []struct{
+		Name string
+		Func func(*testing.T, mdl.EventStore)
+	}{
+		{"Empty",                testEventStoreEmpty},
+		{"AppendAndRead",        testEventStoreAppendAndRead},
+		{"ExpectedVersion",      testEventStoreExpectedVersion},
+		{"AppendNoEvents",       testEventStoreAppendNoEvents},
+		{"AppendError",          testEventStoreAppendError},
+		{"AppendNoType",         testEventStoreAppendNoType},
+		{"ReadStreamForward",    testEventStoreReadStreamForward},
+		{"ReadStreamBackward",   testEventStoreReadStreamBackward},
+		{"ReadAll",              testEventStoreReadAll},
+		{"ReadReturnsCopies",    testEventStoreReadReturnsCopies},
+		{"ConcurrentAppend",     testEventStoreConcurrentAppend},
+	}
+
+	for _, test := range tests {
+		test := test
+
+		t.Run(test.Name, func(t *testing.T) {
+			store := newStore(t)
+			if nil == store {
+				t.Fatalf("newStore returned a nil mdl.EventStore.")
+			}
+
+			test.Func(t, store)
+		})
+	}
+}
+
+// NewEvent returns an ‘mdl.Event’ to use in tests, with ‘eventType’ as its Type, and ‘data’ (pairs of keys in canonical form, and values) as its Data.
+//
+// Example
+//
+//	event := mdltest.NewEvent("EMAIL_RECORDED", "email_address", "joeblow@example.com")
+func NewEvent(eventType string, data ...string) *mdl.Event {
+	var event mdl.Event
+
+	event.ID = mdl.SomeString(fmt.Sprintf("%s-%d", eventType, atomic.AddUint64(&eventCount, 1)))
+	event.Type = mdl.SomeString(eventType)
+	event.OccurredAt = mdl.SomeTime(time.Date(2015, 5, 7, 10, 25, 9, 123456789, time.UTC))
+
+	for i := 0; i+1 < len(data); i += 2 {
+		var key mdl.Key
+		if err := key.Scan(data[i]); nil != err {
+			panic(err)
+		}
+		event.Data.Append(key, data[i+1])
+	}
+
+	return &event
+}
+
+// SameEvent returns an error saying how ‘actual’ is different from ‘expected’; or nil if they are the same.
+//
+// The OccurredAt of the events are compared with time.Time.Equal(), since a time may be stored with a different (but equivalent) time zone.
+func SameEvent(expected *mdl.Event, actual *mdl.Event) error {
+	switch {
+	case nil == expected && nil == actual:
+		return nil
+	case nil == expected || nil == actual:
+		return fmt.Errorf("expected event %#v, but actually got %#v", expected, actual)
+	}
+
+	strings := []struct{
+		Name     string
+		Expected mdl.String
+		Actual   mdl.String
+	}{
+		{"ID",            expected.ID,            actual.ID},
+		{"Type",          expected.Type,          actual.Type},
+		{"StreamID",      expected.StreamID,      actual.StreamID},
+		{"CausationID",   expected.CausationID,   actual.CausationID},
+		{"CorrelationID", expected.CorrelationID, actual.CorrelationID},
+	}
+	for _, s := range strings {
+		if s.Expected != s.Actual {
+			return fmt.Errorf("expected %s %#v, but actually got %#v", s.Name, s.Expected, s.Actual)
+		}
+	}
+
+	if expected.Version != actual.Version {
+		return fmt.Errorf("expected Version %#v, but actually got %#v", expected.Version, actual.Version)
+	}
+	if expected.Position != actual.Position {
+		return fmt.Errorf("expected Position %#v, but actually got %#v", expected.Position, actual.Position)
+	}
+
+	{
+		e, eSomething := expected.OccurredAt.Unwrap()
+		a, aSomething := actual.OccurredAt.Unwrap()
+		if eSomething != aSomething || !e.Equal(a) {
+			return fmt.Errorf("expected OccurredAt %#v, but actually got %#v", expected.OccurredAt, actual.OccurredAt)
+		}
+	}
+
+	if e, a := expected.Data.CanonicalForm(), actual.Data.CanonicalForm(); e != a {
+		return fmt.Errorf("expected Data %q, but actually got %q", e, a)
+	}
+	if e, a := expected.Metadata.CanonicalForm(), actual.Metadata.CanonicalForm(); e != a {
+		return fmt.Errorf("expected Metadata %q, but actually got %q", e, a)
+	}
+
+	return nil
+}
+
+func testEventStoreEmpty(t *testing.T, store mdl.EventStore) {
+
+	events, err := store.ReadAll(0, 0)
+	if nil != err {
+		t.Fatalf("Did not expect an error from .ReadAll(), but actually got one: (%T) %q", err, err)
+	}
+	if expected, actual := 0, len(events); expected != actual {
+		t.Fatalf("Expected %d events from .ReadAll(), but actually got %d.", expected, actual)
+	}
+
+	events, err = store.ReadStreamForward("user/123", 0, 0)
+	if nil != err {
+		t.Fatalf("Did not expect an error from .ReadStreamForward(), but actually got one: (%T) %q", err, err)
+	}
+	if expected, actual := 0, len(events); expected != actual {
+		t.Fatalf("Expected %d events from .ReadStreamForward(), but actually got %d.", expected, actual)
+	}
+
+	events, err = store.ReadStreamBackward("user/123", math.MaxUint64, 0)
+	if nil != err {
+		t.Fatalf("Did not expect an error from .ReadStreamBackward(), but actually got one: (%T) %q", err, err)
+	}
+	if expected, actual := 0, len(events); expected != actual {
+		t.Fatalf("Expected %d events from .ReadStreamBackward(), but actually got %d.", expected, actual)
+	}
+}
+
+func testEventStoreAppendAndRead(t *testing.T, store mdl.EventStore) {
+
+	first := NewEvent("USER_REGISTERED", "email_address", "joeblow@example.com", "tags", "a", "tags", "b", `i\/o/x`, "y")
+	first.CausationID = mdl.SomeString("instruction-1")
+	first.CorrelationID = mdl.SomeString("conversation-1")
+	first.Metadata.Store(mdl.SomeKey("user_agent"), "curl/7.64.1")
+	first.StreamID = mdl.SomeString("ignored")
+	first.Version = mdl.SomeUint64(99)
+	first.Position = mdl.SomeUint64(99)
+
+	second := NewEvent("EMAIL_RECORDED", "email_address", "joe@example.com")
+
+	version, err := store.Append("user/123", mdl.SomeUint64(0), first, second)
+	if nil != err {
+		t.Fatalf("Did not expect an error from .Append(), but actually got one: (%T) %q", err, err)
+	}
+	if expected, actual := uint64(2), version; expected != actual {
+		t.Fatalf("Expected .Append() to return version %d, but actually got %d.", expected, actual)
+	}
+
+	for i, event := range []*mdl.Event{first, second} {
+		if expected, actual := mdl.SomeString("user/123"), event.StreamID; expected != actual {
+			t.Errorf("For event #%d, expected .Append() to set the StreamID to %#v, but actually got %#v.", i, expected, actual)
+		}
+		if expected, actual := mdl.SomeUint64(uint64(i+1)), event.Version; expected != actual {
+			t.Errorf("For event #%d, expected .Append() to set the Version to %#v, but actually got %#v.", i, expected, actual)
+		}
+		if expected, actual := mdl.SomeUint64(uint64(i+1)), event.Position; expected != actual {
+			t.Errorf("For event #%d, expected .Append() to set the Position to %#v, but actually got %#v.", i, expected, actual)
+		}
+	}
+
+	events, err := store.ReadStreamForward("user/123", 0, 0)
+	if nil != err {
+		t.Fatalf("Did not expect an error from .ReadStreamForward(), but actually got one: (%T) %q", err, err)
+	}
+	if expected, actual := 2, len(events); expected != actual {
+		t.Fatalf("Expected %d events from .ReadStreamForward(), but actually got %d.", expected, actual)
+	}
+	for i, event := range []*mdl.Event{first, second} {
+		if err := SameEvent(event, events[i]); nil != err {
+			t.Errorf("For event #%d, %s.", i, err)
+		}
+	}
+
+	if expected, actual := []string{"a", "b"}, events[0].Data.FetchAll("tags"); fmt.Sprint(expected) != fmt.Sprint(actual) {
+		t.Errorf("Expected the values of a key to stay in order %#v, but actually got %#v.", expected, actual)
+	}
+}
+
+func testEventStoreExpectedVersion(t *testing.T, store mdl.EventStore) {
+
+	if _, err := store.Append("user/123", mdl.SomeUint64(0), NewEvent("A"), NewEvent("B")); nil != err {
+		t.Fatalf("Did not expect an error from .Append(), but actually got one: (%T) %q", err, err)
+	}
+
+	tests := []struct{
+		ExpectedVersion mdl.Uint64
+		Conflict        bool
+	}{
+		{mdl.SomeUint64(0), true},
+		{mdl.SomeUint64(1), true},
+		{mdl.SomeUint64(3), true},
+		{mdl.SomeUint64(2), false},
+		{mdl.SomeUint64(2), true},
+		{mdl.NoUint64(),    false},
+		{mdl.SomeUint64(4), false},
+	}
+
+	var version uint64 = 2
+
+	for testNumber, test := range tests {
+
+		actualVersion, err := store.Append("user/123", test.ExpectedVersion, NewEvent("C"))
+
+		if !test.Conflict {
+			if nil != err {
+				t.Fatalf("For test #%d, did not expect an error from .Append(), but actually got one: (%T) %q", testNumber, err, err)
+			}
+			version++
+			if expected, actual := version, actualVersion; expected != actual {
+				t.Fatalf("For test #%d, expected .Append() to return version %d, but actually got %d.", testNumber, expected, actual)
+			}
+			continue
+		}
+
+		if nil == err {
+			t.Fatalf("For test #%d, expected an error from .Append(), but did not actually get one.", testNumber)
+		}
+
+		conflict, casted := err.(mdl.VersionConflict)
+		if !casted {
+			t.Fatalf("For test #%d, expected an mdl.VersionConflict error from .Append(), but actually got: (%T) %q", testNumber, err, err)
+		}
+
+		if expected, actual := "user/123", conflict.StreamID(); expected != actual {
+			t.Errorf("For test #%d, expected the stream ID to be %q, but actually got %q.", testNumber, expected, actual)
+		}
+		if expected, actual := test.ExpectedVersion.ElseUnwrap(math.MaxUint64), conflict.ExpectedVersion(); expected != actual {
+			t.Errorf("For test #%d, expected the expected version to be %d, but actually got %d.", testNumber, expected, actual)
+		}
+		if expected, actual := version, conflict.ActualVersion(); expected != actual {
+			t.Errorf("For test #%d, expected the actual version to be %d, but actually got %d.", testNumber, expected, actual)
+		}
+	}
+
+	events, err := store.ReadAll(0, 0)
+	if nil != err {
+		t.Fatalf("Did not expect an error from .ReadAll(), but actually got one: (%T) %q", err, err)
+	}
+	if expected, actual := int(version), len(events); expected != actual {
+		t.Fatalf("Expected nothing to be appended when there is a version conflict; expected %d events, but actually got %d.", expected, actual)
+	}
+}
+
+func testEventStoreAppendNoEvents(t *testing.T, store mdl.EventStore) {
+
+	version, err := store.Append("user/123", mdl.SomeUint64(0))
+	if nil != err {
+		t.Fatalf("Did not expect an error from .Append(), but actually got one: (%T) %q", err, err)
+	}
+	if expected, actual := uint64(0), version; expected != actual {
+		t.Fatalf("Expected .Append() to return version %d, but actually got %d.", expected, actual)
+	}
+
+	if _, err := store.Append("user/123", mdl.SomeUint64(0), NewEvent("A")); nil != err {
+		t.Fatalf("Did not expect an error from .Append(), but actually got one: (%T) %q", err, err)
+	}
+
+	version, err = store.Append("user/123", mdl.NoUint64())
+	if nil != err {
+		t.Fatalf("Did not expect an error from .Append(), but actually got one: (%T) %q", err, err)
+	}
+	if expected, actual := uint64(1), version; expected != actual {
+		t.Fatalf("Expected .Append() to return version %d, but actually got %d.", expected, actual)
+	}
+
+	if _, err := store.Append("user/123", mdl.SomeUint64(0)); nil == err {
+		t.Fatalf("Expected an error from .Append() with no events and the wrong expected version, but did not actually get one.")
+	} else if _, casted := err.(mdl.VersionConflict); !casted {
+		t.Fatalf("Expected an mdl.VersionConflict error from .Append(), but actually got: (%T) %q", err, err)
+	}
+}
+
+func testEventStoreAppendError(t *testing.T, store mdl.EventStore) {
+
+	if _, err := store.Append("", mdl.NoUint64(), NewEvent("A")); nil == err {
+		t.Errorf("Expected an error from .Append() with an empty stream ID, but did not actually get one.")
+	}
+
+	if _, err := store.Append("user/123", mdl.NoUint64(), NewEvent("A"), nil); nil == err {
+		t.Errorf("Expected an error from .Append() with a nil event, but did not actually get one.")
+	}
+
+	events, err := store.ReadAll(0, 0)
+	if nil != err {
+		t.Fatalf("Did not expect an error from .ReadAll(), but actually got one: (%T) %q", err, err)
+	}
+	if expected, actual := 0, len(events); expected != actual {
+		t.Fatalf("Expected nothing to be appended when .Append() returns an error; expected %d events, but actually got %d.", expected, actual)
+	}
+}
+
+func testEventStoreAppendNoType(t *testing.T, store mdl.EventStore) {
+
+	event := NewEvent("A", "email_address", "joeblow@example.com")
+	event.Type = mdl.NoString()
+
+	if _, err := store.Append("user/123", mdl.SomeUint64(0), event); nil != err {
+		t.Fatalf("Did not expect an error from .Append() with an event without a Type, but actually got one: (%T) %q", err, err)
+	}
+
+	events, err := store.ReadStreamForward("user/123", 0, 0)
+	if nil != err {
+		t.Fatalf("Did not expect an error from .ReadStreamForward(), but actually got one: (%T) %q", err, err)
+	}
+	if expected, actual := 1, len(events); expected != actual {
+		t.Fatalf("Expected %d events from .ReadStreamForward(), but actually got %d.", expected, actual)
+	}
+	if err := SameEvent(event, events[0]); nil != err {
+		t.Errorf("For the event, %s.", err)
+	}
+	if expected, actual := mdl.NoString(), events[0].Type; expected != actual {
+		t.Errorf("Expected the Type to be %#v, but actually got %#v.", expected, actual)
+	}
+}
+
+// testEventStoreAppendFixture appends 5 events to “a”, and 3 events to “b”, interleaved.
+func testEventStoreAppendFixture(t *testing.T, store mdl.EventStore) {
+	var streamIDs []string = []string{"a", "b", "a", "a", "b", "a", "b", "a"}
+
+	for i, streamID := range streamIDs {
+		if _, err := store.Append(streamID, mdl.NoUint64(), NewEvent(fmt.Sprintf("E%d", i+1))); nil != err {
+			t.Fatalf("Did not expect an error from .Append(), but actually got one: (%T) %q", err, err)
+		}
+	}
+}
+
+func testEventStoreTypes(events []*mdl.Event) string {
+	var result []string
+	for _, event := range events {
+		result = append(result, event.Type.ElseUnwrap("?")+"@"+fmt.Sprint(event.Version.ElseUnwrap(0))+"#"+fmt.Sprint(event.Position.ElseUnwrap(0)))
+	}
+
+	return fmt.Sprint(result)
+}
+
+func testEventStoreReadStreamForward(t *testing.T, store mdl.EventStore) {
+
+	testEventStoreAppendFixture(t, store)
+
+	tests := []struct{
+		StreamID    string
+		FromVersion uint64
+		Limit       int
+		Expected    string
+	}{
+		{"a", 0, 0, "[E1@1#1 E3@2#3 E4@3#4 E6@4#6 E8@5#8]"},
+		{"a", 1, 0, "[E1@1#1 E3@2#3 E4@3#4 E6@4#6 E8@5#8]"},
+		{"a", 3, 0, "[E4@3#4 E6@4#6 E8@5#8]"},
+		{"a", 3, 2, "[E4@3#4 E6@4#6]"},
+		{"a", 5, 0, "[E8@5#8]"},
+		{"a", 6, 0, "[]"},
+		{"a", math.MaxUint64, 0, "[]"},
+		{"b", 2, 10, "[E5@2#5 E7@3#7]"},
+		{"c", 0, 0, "[]"},
+	}
+
+	for testNumber, test := range tests {
+
+		events, err := store.ReadStreamForward(test.StreamID, test.FromVersion, test.Limit)
+		if nil != err {
+			t.Errorf("For test #%d, did not expect an error from .ReadStreamForward(), but actually got one: (%T) %q", testNumber, err, err)
+			continue
+		}
+
+		if expected, actual := test.Expected, testEventStoreTypes(events); expected != actual {
+			t.Errorf("For test #%d, expected %s, but actually got %s.", testNumber, expected, actual)
+			continue
+		}
+	}
+}
+
+func testEventStoreReadStreamBackward(t *testing.T, store mdl.EventStore) {
+
+	testEventStoreAppendFixture(t, store)
+
+	tests := []struct{
+		StreamID    string
+		FromVersion uint64
+		Limit       int
+		Expected    string
+	}{
+		{"a", math.MaxUint64, 0, "[E8@5#8 E6@4#6 E4@3#4 E3@2#3 E1@1#1]"},
+		{"a", 5, 0, "[E8@5#8 E6@4#6 E4@3#4 E3@2#3 E1@1#1]"},
+		{"a", 6, 2, "[E8@5#8 E6@4#6]"},
+		{"a", 3, 0, "[E4@3#4 E3@2#3 E1@1#1]"},
+		{"a", 3, 2, "[E4@3#4 E3@2#3]"},
+		{"a", 1, 0, "[E1@1#1]"},
+		{"a", 0, 0, "[]"},
+		{"b", math.MaxUint64, 1, "[E7@3#7]"},
+		{"c", math.MaxUint64, 0, "[]"},
+	}
+
+	for testNumber, test := range tests {
+
+		events, err := store.ReadStreamBackward(test.StreamID, test.FromVersion, test.Limit)
+		if nil != err {
+			t.Errorf("For test #%d, did not expect an error from .ReadStreamBackward(), but actually got one: (%T) %q", testNumber, err, err)
+			continue
+		}
+
+		if expected, actual := test.Expected, testEventStoreTypes(events); expected != actual {
+			t.Errorf("For test #%d, expected %s, but actually got %s.", testNumber, expected, actual)
+			continue
+		}
+	}
+}
+
+func testEventStoreReadAll(t *testing.T, store mdl.EventStore) {
+
+	testEventStoreAppendFixture(t, store)
+
+	tests := []struct{
+		FromPosition uint64
+		Limit        int
+		Expected     string
+	}{
+		{0, 0, "[E1@1#1 E2@1#2 E3@2#3 E4@3#4 E5@2#5 E6@4#6 E7@3#7 E8@5#8]"},
+		{1, 3, "[E1@1#1 E2@1#2 E3@2#3]"},
+		{4, 2, "[E4@3#4 E5@2#5]"},
+		{8, 0, "[E8@5#8]"},
+		{9, 0, "[]"},
+		{math.MaxUint64, 0, "[]"},
+	}
+
+	for testNumber, test := range tests {
+
+		events, err := store.ReadAll(test.FromPosition, test.Limit)
+		if nil != err {
+			t.Errorf("For test #%d, did not expect an error from .ReadAll(), but actually got one: (%T) %q", testNumber, err, err)
+			continue
+		}
+
+		if expected, actual := test.Expected, testEventStoreTypes(events); expected != actual {
+			t.Errorf("For test #%d, expected %s, but actually got %s.", testNumber, expected, actual)
+			continue
+		}
+	}
+}
+
+func testEventStoreReadReturnsCopies(t *testing.T, store mdl.EventStore) {
+
+	appended := NewEvent("A", "name", "before")
+	if _, err := store.Append("a", mdl.NoUint64(), appended); nil != err {
+		t.Fatalf("Did not expect an error from .Append(), but actually got one: (%T) %q", err, err)
+	}
+
+	// Changing the appended event should not change what is in the store.
+	appended.Type = mdl.SomeString("CHANGED")
+	appended.Data.Store(mdl.SomeKey("other"), "changed")
+
+	events, err := store.ReadAll(0, 0)
+	if nil != err {
+		t.Fatalf("Did not expect an error from .ReadAll(), but actually got one: (%T) %q", err, err)
+	}
+	if expected, actual := 1, len(events); expected != actual {
+		t.Fatalf("Expected %d events, but actually got %d.", expected, actual)
+	}
+
+	// Neither should changing an event that was read.
+	events[0].Type = mdl.SomeString("CHANGED")
+	events[0].Data.Store(mdl.SomeKey("another"), "changed")
+
+	events, err = store.ReadStreamForward("a", 0, 0)
+	if nil != err {
+		t.Fatalf("Did not expect an error from .ReadStreamForward(), but actually got one: (%T) %q", err, err)
+	}
+	if expected, actual := 1, len(events); expected != actual {
+		t.Fatalf("Expected %d events, but actually got %d.", expected, actual)
+	}
+
+	if expected, actual := mdl.SomeString("A"), events[0].Type; expected != actual {
+		t.Errorf("Expected the Type to be %#v, but actually got %#v.", expected, actual)
+	}
+	if expected, actual := "name \"before\"\n", events[0].Data.CanonicalForm(); expected != actual {
+		t.Errorf("Expected the Data to be %q, but actually got %q.", expected, actual)
+	}
+}
+
+func testEventStoreConcurrentAppend(t *testing.T, store mdl.EventStore) {
+
+	const writers = 8
+	const rounds = 10
+
+	// Each writer keeps trying to append to the same stream with the version it last read; so most of the appends conflict.
+	// In the end, every writer should have appended each of its events exactly once, with no version used twice.
+	var waitgroup sync.WaitGroup
+	var errs chan error = make(chan error, writers)
+
+	for w := 0; w < writers; w++ {
+		waitgroup.Add(1)
+
+		go func(w int) {
+			defer waitgroup.Done()
+
+			for r := 0; r < rounds; r++ {
+				for {
+					events, err := store.ReadStreamBackward("counter", math.MaxUint64, 1)
+					if nil != err {
+						errs <- err
+						return
+					}
+
+					var version uint64
+					if 0 < len(events) {
+						version = events[0].Version.ElseUnwrap(0)
+					}
+
+					_, err = store.Append("counter", mdl.SomeUint64(version), NewEvent(fmt.Sprintf("W%dR%d", w, r)))
+					if nil == err {
+						break
+					}
+					if _, casted := err.(mdl.VersionConflict); !casted {
+						errs <- err
+						return
+					}
+				}
+			}
+		}(w)
+	}
+
+	waitgroup.Wait()
+	close(errs)
+
+	for err := range errs {
+		t.Fatalf("Did not expect an error, but actually got one: (%T) %q", err, err)
+	}
+
+	events, err := store.ReadStreamForward("counter", 0, 0)
+	if nil != err {
+		t.Fatalf("Did not expect an error from .ReadStreamForward(), but actually got one: (%T) %q", err, err)
+	}
+	if expected, actual := writers*rounds, len(events); expected != actual {
+		t.Fatalf("Expected %d events, but actually got %d.", expected, actual)
+	}
+
+	var seen map[string]struct{} = map[string]struct{}{}
+	for i, event := range events {
+		if expected, actual := mdl.SomeUint64(uint64(i+1)), event.Version; expected != actual {
+			t.Fatalf("For event #%d, expected Version %#v, but actually got %#v.", i, expected, actual)
+		}
+
+		eventType := event.Type.ElseUnwrap("")
+		if _, found := seen[eventType]; found {
+			t.Fatalf("Event %q was appended more than once.", eventType)
+		}
+		seen[eventType] = struct{}{}
+	}
+}
@@ -0,0 +1,176 @@
+package mdl
+
+import (
+	"sync"
+)
+
+// MemoryEventStore is an in-memory ‘mdl.EventStore’.
+//
+// The zero value is ready to use.
+//
+// Since the events are only held in memory, they are lost when the program exits; and they are not shared
+// between multiple instances of a program. For those, use some other ‘mdl.EventStore’.
+type MemoryEventStore struct {
+	mutex   sync.RWMutex
+	events  []*Event
+	streams map[string][]*Event
+
+	notifier eventStoreNotifier
+}
+
+// Append makes ‘mdl.MemoryEventStore’ fit the ‘mdl.EventStore’ interface.
+func (receiver *MemoryEventStore) Append(streamID string, expectedVersion Uint64, events ...*Event) (uint64, error) {
+	if nil == receiver {
+		return 0, errNilReceiver
+	}
+
+	if err := eventStoreCheckAppend(streamID, events); nil != err {
+		return 0, err
+	}
+
+	// The events are copied before taking the lock; since copying them locks each of their ‘mdl.KeyValues’.
+	var appended []*Event = make([]*Event, len(events))
+	for i, event := range events {
+		var copied Event
+		if err := event.CopyTo(&copied); nil != err {
+			return 0, err
+		}
+		appended[i] = &copied
+	}
+
+	receiver.mutex.Lock()
+	defer receiver.mutex.Unlock()
+
+	if nil == receiver.streams {
+		receiver.streams = map[string][]*Event{}
+	}
+
+	stream := receiver.streams[streamID]
+
+	version := uint64(len(stream))
+	if err := eventStoreCheckVersion(streamID, expectedVersion, version); nil != err {
+		return version, err
+	}
+
+	for i, event := range appended {
+		version++
+		position := uint64(len(receiver.events)) + 1
+
+		event.StreamID = SomeString(streamID)
+		event.Version  = SomeUint64(version)
+		event.Position = SomeUint64(position)
+
+		events[i].StreamID = event.StreamID
+		events[i].Version  = event.Version
+		events[i].Position = event.Position
+
+		stream = append(stream, event)
+		receiver.events = append(receiver.events, event)
+	}
+
+	receiver.streams[streamID] = stream
+
+	if 0 < len(appended) {
+		receiver.notifier.notify()
+	}
+
+	return version, nil
+}
+
+// Notify makes ‘mdl.MemoryEventStore’ fit the ‘mdl.EventStoreNotifier’ interface.
+func (receiver *MemoryEventStore) Notify() <-chan struct{} {
+	if nil == receiver {
+		return nil
+	}
+
+	return receiver.notifier.Notify()
+}
+
+// ReadAll makes ‘mdl.MemoryEventStore’ fit the ‘mdl.EventStore’ interface.
+func (receiver *MemoryEventStore) ReadAll(fromPosition uint64, limit int) ([]*Event, error) {
+	if nil == receiver {
+		return nil, errNilReceiver
+	}
+
+	var found []*Event
+	func() {
+		receiver.mutex.RLock()
+		defer receiver.mutex.RUnlock()
+
+		found = memoryEventStoreFrom(receiver.events, fromPosition)
+	}()
+
+	return memoryEventStoreCopy(found[:eventStoreLimit(len(found), limit)])
+}
+
+// ReadStreamBackward makes ‘mdl.MemoryEventStore’ fit the ‘mdl.EventStore’ interface.
+func (receiver *MemoryEventStore) ReadStreamBackward(streamID string, fromVersion uint64, limit int) ([]*Event, error) {
+	if nil == receiver {
+		return nil, errNilReceiver
+	}
+
+	var stream []*Event
+	func() {
+		receiver.mutex.RLock()
+		defer receiver.mutex.RUnlock()
+
+		stream = receiver.streams[streamID]
+	}()
+
+	if fromVersion < uint64(len(stream)) {
+		stream = stream[:fromVersion]
+	}
+
+	var found []*Event = make([]*Event, eventStoreLimit(len(stream), limit))
+	for i := range found {
+		found[i] = stream[len(stream)-1-i]
+	}
+
+	return memoryEventStoreCopy(found)
+}
+
+// ReadStreamForward makes ‘mdl.MemoryEventStore’ fit the ‘mdl.EventStore’ interface.
+func (receiver *MemoryEventStore) ReadStreamForward(streamID string, fromVersion uint64, limit int) ([]*Event, error) {
+	if nil == receiver {
+		return nil, errNilReceiver
+	}
+
+	var found []*Event
+	func() {
+		receiver.mutex.RLock()
+		defer receiver.mutex.RUnlock()
+
+		found = memoryEventStoreFrom(receiver.streams[streamID], fromVersion)
+	}()
+
+	return memoryEventStoreCopy(found[:eventStoreLimit(len(found), limit)])
+}
+
+// memoryEventStoreCopy returns copies of ‘events’.
+func memoryEventStoreCopy(events []*Event) ([]*Event, error) {
+	var result []*Event = make([]*Event, len(events))
+	for i, event := range events {
+		var copied Event
+		if err := event.CopyTo(&copied); nil != err {
+			return nil, err
+		}
+		result[i] = &copied
+	}
+
+	return result, nil
+}
+
+// memoryEventStoreFrom returns the events from the (one-based) index ‘from’ on.
+// (Which is their Version, for the events in a stream; and their Position, for all the events.)
+//
+// The events in the ‘mdl.MemoryEventStore’ are never changed, and their slices are only ever appended to; so what is returned can be used without the lock.
+func memoryEventStoreFrom(events []*Event, from uint64) []*Event {
+	if 0 == from {
+		from = 1
+	}
+	if uint64(len(events)) < from {
+		return nil
+	}
+
+	return events[from-1:]
+}
@@ -0,0 +1,16 @@
+package mdl_test
+
+import (
+	"github.com/reiver/go-mdl"
+	"github.com/reiver/go-mdl/mdltest"
+
+	"testing"
+)
+
+var _ mdl.EventStore = &mdl.MemoryEventStore{}
+
+func TestMemoryEventStore(t *testing.T) {
+	mdltest.TestEventStore(t, func(*testing.T) mdl.EventStore {
+		return &mdl.MemoryEventStore{}
+	})
+}
@@ -0,0 +1,94 @@
+package mdl
+
+import (
+	"sync"
+	"time"
+)
+
+// MemoryIdempotencyStore is an in-memory ‘mdl.IdempotencyStore’.
+//
+// The zero value is ready to use.
+//
+// Since the records are only held in memory, they are lost when the program exits; and they are not shared
+// between multiple instances of a program. For those, use ‘mdl.FileIdempotencyStore’ (or some other ‘mdl.IdempotencyStore’).
+type MemoryIdempotencyStore struct {
+	mutex   sync.Mutex
+	records map[string]IdempotencyRecord
+}
+
+// Purge removes all the records that have expired as of ‘now’.
+func (receiver *MemoryIdempotencyStore) Purge(now time.Time) {
+	if nil == receiver {
+		return
+	}
+
+	receiver.mutex.Lock()
+	defer receiver.mutex.Unlock()
+
+	for id, record := range receiver.records {
+		if !now.Before(record.Expires) {
+			delete(receiver.records, id)
+		}
+	}
+}
+
+// Reserve makes ‘mdl.MemoryIdempotencyStore’ fit the ‘mdl.IdempotencyStore’ interface.
+func (receiver *MemoryIdempotencyStore) Reserve(id string, fingerprint string, now time.Time, ttl time.Duration) (IdempotencyRecord, bool, error) {
+	if nil == receiver {
+		return IdempotencyRecord{}, false, errNilReceiver
+	}
+
+	receiver.mutex.Lock()
+	defer receiver.mutex.Unlock()
+
+	if nil == receiver.records {
+		receiver.records = map[string]IdempotencyRecord{}
+	}
+
+	if record, found := receiver.records[id]; found && now.Before(record.Expires) {
+		return record, true, nil
+	}
+
+	receiver.records[id] = IdempotencyRecord{
+		Fingerprint: fingerprint,
+		Expires:     now.Add(ttl),
+	}
+
+	return IdempotencyRecord{}, false, nil
+}
+
+// Complete makes ‘mdl.MemoryIdempotencyStore’ fit the ‘mdl.IdempotencyStore’ interface.
+func (receiver *MemoryIdempotencyStore) Complete(id string, response IdempotencyResponse) error {
+	if nil == receiver {
+		return errNilReceiver
+	}
+
+	receiver.mutex.Lock()
+	defer receiver.mutex.Unlock()
+
+	record, found := receiver.records[id]
+	if !found {
+		return errIdempotentIDNotReserved
+	}
+
+	record.Completed = true
+	record.Response = response
+
+	receiver.records[id] = record
+
+	return nil
+}
+
+// Release makes ‘mdl.MemoryIdempotencyStore’ fit the ‘mdl.IdempotencyStore’ interface.
+func (receiver *MemoryIdempotencyStore) Release(id string) error {
+	if nil == receiver {
+		return errNilReceiver
+	}
+
+	receiver.mutex.Lock()
+	defer receiver.mutex.Unlock()
+
+	delete(receiver.records, id)
+
+	return nil
+}
@@ -0,0 +1,13 @@
+package mdl_test
+
+import (
+	"github.com/reiver/go-mdl"
+
+	"testing"
+)
+
+func TestMemoryIdempotencyStore(t *testing.T) {
+	var store mdl.MemoryIdempotencyStore
+
+	testIdempotencyStore(t, &store)
+}
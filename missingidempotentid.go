@@ -0,0 +1,66 @@
+package mdl
+
+import (
+	"fmt"
+	"net/http"
+)
+
+// MissingIdempotentID is the error returned from mdl.Instruction.Scan() when the instruction ‘Idempotent ID’ is missing (i.e., when there is no “X-Idempotent-ID” HTTP request header).
+//
+// For example:
+//
+//	var instruction mdl.Instruction
+//	
+//	// ...
+//	
+//	err := instruction.Scan(request)
+//	
+//	if nil != err {
+//		switch casted := err.(type) {
+//		case mdl.MissingIdempotentID:
+//			http.Error(responseWriter, casted.Error(), casted.StatusCode())
+//			return
+//		default:
+//			//@TODO
+//		}
+//	}
+type MissingIdempotentID interface {
+	error
+	MissingIdempotentID()
+
+	// StatusCode returns the HTTP status code that goes with this error (i.e., 400 “Bad Request”).
+	StatusCode() int
+
+	// Unwrap returns the error that caused this error, if there is one.
+	Unwrap() error
+}
+
+type internalMissingIdempotentID struct {
+	err error
+}
+
+func missingIdempotentID(err error) MissingIdempotentID {
+	return internalMissingIdempotentID{
+		err: err,
+	}
+}
+
+func (receiver internalMissingIdempotentID) Error() string {
+	if nil == receiver.err {
+		return "mdl: missing idempotent ID"
+	}
+
+	return fmt.Sprintf("mdl: missing idempotent ID: %s", receiver.err)
+}
+
+func (receiver internalMissingIdempotentID) StatusCode() int {
+	return http.StatusBadRequest
+}
+
+func (receiver internalMissingIdempotentID) Unwrap() error {
+	return receiver.err
+}
+
+func (internalMissingIdempotentID) MissingIdempotentID() {
+	// Nothing here.
+}
@@ -0,0 +1,23 @@
+package mdl
+
+import (
+	"testing"
+)
+
+func TestInternalMissingIdempotentIDAsError(t *testing.T) {
+	var err error = internalMissingIdempotentID{} // THIS IS THE LINE THAT ACTUALLY MATTERS.
+
+	if nil == err {
+		t.Errorf("This should never happen.")
+		return
+	}
+}
+
+func TestInternalMissingIdempotentIDAsMissingIdempotentID(t *testing.T) {
+	var complainer MissingIdempotentID = internalMissingIdempotentID{} // THIS IS THE LINE THAT ACTUALLY MATTERS.
+
+	if nil == complainer {
+		t.Errorf("This should never happen.")
+		return
+	}
+}
@@ -0,0 +1,163 @@
+package mdl
+
+import (
+	"fmt"
+	"net/http"
+	"sort"
+	"strings"
+	"sync"
+)
+
+// InstructionHandler handles an ‘mdl.Instruction’ received over HTTP.
+//
+// ‘mdl.Mux’ dispatches to an ‘mdl.InstructionHandler’ based on the instruction ‘Verb’.
+type InstructionHandler interface {
+	ServeInstruction(http.ResponseWriter, *http.Request, *Instruction)
+}
+
+// InstructionHandlerFunc is an adapter that lets an ordinary func be used as an ‘mdl.InstructionHandler’.
+type InstructionHandlerFunc func(http.ResponseWriter, *http.Request, *Instruction)
+
+// ServeInstruction makes ‘mdl.InstructionHandlerFunc’ fit the ‘mdl.InstructionHandler’ interface.
+func (fn InstructionHandlerFunc) ServeInstruction(responseWriter http.ResponseWriter, request *http.Request, instruction *Instruction) {
+	fn(responseWriter, request, instruction)
+}
+
+// Mux is an ‘http.Handler’ that receives the ‘mdl.Instruction’ from the HTTP request, and then dispatches
+// it to the ‘mdl.InstructionHandler’ registered for its ‘Verb’.
+//
+// Example
+//
+// Here is an example of using ‘mdl.Mux’:
+//
+//	var mux mdl.Mux
+//	
+//	mux.HandleFunc("RECORD_EMAIL", func(responseWriter http.ResponseWriter, request *http.Request, instruction *mdl.Instruction) {
+//		emailAddress := instruction.Data.Fetch("email_address")
+//	
+//		// ...
+//	})
+//	
+//	mux.Handle("EMPTY_SHOPPING_CART", emptyShoppingCartHandler)
+//	
+//	// ...
+//	
+//	http.Handle("/v1/users", &mux)
+//
+// Errors
+//
+// If the HTTP request is for a ‘Verb’ that does not have an ‘mdl.InstructionHandler’ registered for it, then
+// ‘mdl.Mux’ responds with “405 Method Not Allowed”, and lists the ‘Verbs’ that are allowed (in both the
+// “Allow” HTTP response header, and the HTTP response body).
+//
+// If the instruction cannot be received from the HTTP request, then ‘mdl.Mux’ responds with the HTTP status code
+// that goes with the error; i.e., “400 Bad Request” for ‘mdl.MissingIdempotentID’ and ‘mdl.MalformedBody’,
+// “413 Payload Too Large” for ‘mdl.PayloadTooLarge’, and “415 Unsupported Media Type” for ‘mdl.UnsupportedMediaType’.
+type Mux struct {
+
+	// Decoder is used to receive the ‘mdl.Instruction’ from the HTTP request.
+	Decoder InstructionDecoder
+
+	mutex    sync.RWMutex
+	handlers map[string]InstructionHandler
+}
+
+// Handle registers the handler for the given verb.
+//
+// If a handler is already registered for the verb, then Handle panics.
+func (receiver *Mux) Handle(verb string, handler InstructionHandler) {
+	if nil == receiver {
+		panic(errNilReceiver)
+	}
+	if "" == verb {
+		panic("mdl: empty verb")
+	}
+	if nil == handler {
+		panic("mdl: nil handler")
+	}
+
+	receiver.mutex.Lock()
+	defer receiver.mutex.Unlock()
+
+	if nil == receiver.handlers {
+		receiver.handlers = map[string]InstructionHandler{}
+	}
+
+	if _, found := receiver.handlers[verb]; found {
+		panic(fmt.Sprintf("mdl: multiple registrations for verb %q", verb))
+	}
+
+	receiver.handlers[verb] = handler
+}
+
+// HandleFunc registers the handler func for the given verb.
+func (receiver *Mux) HandleFunc(verb string, fn func(http.ResponseWriter, *http.Request, *Instruction)) {
+	if nil == fn {
+		panic("mdl: nil handler")
+	}
+
+	receiver.Handle(verb, InstructionHandlerFunc(fn))
+}
+
+// ServeHTTP makes ‘mdl.Mux’ fit the ‘http.Handler’ interface.
+func (receiver *Mux) ServeHTTP(responseWriter http.ResponseWriter, request *http.Request) {
+	if nil == responseWriter {
+		return
+	}
+	if nil == receiver || nil == request {
+		http.Error(responseWriter, "Internal Server Error", http.StatusInternalServerError)
+		return
+	}
+
+	var handler InstructionHandler
+	{
+		verb, _ := inferVerb(request, receiver.Decoder.methodOverrideHeader())
+
+		var found bool
+
+		receiver.mutex.RLock()
+		handler, found = receiver.handlers[verb]
+		receiver.mutex.RUnlock()
+
+		if !found {
+			allowed := strings.Join(receiver.Verbs(), ", ")
+
+			responseWriter.Header().Set("Allow", allowed)
+			http.Error(responseWriter, fmt.Sprintf("Method Not Allowed\nAllowed: %s", allowed), http.StatusMethodNotAllowed)
+			return
+		}
+	}
+
+	instruction, err := receiver.Decoder.Decode(request)
+	if nil != err {
+		switch casted := err.(type) {
+		case interface{ StatusCode() int }:
+			http.Error(responseWriter, http.StatusText(casted.StatusCode()), casted.StatusCode())
+		default:
+			http.Error(responseWriter, "Internal Server Error", http.StatusInternalServerError)
+		}
+		return
+	}
+	defer instruction.RemoveAttachments()
+
+	handler.ServeInstruction(responseWriter, request, instruction)
+}
+
+// Verbs returns the (sorted) verbs that have a handler registered for them.
+func (receiver *Mux) Verbs() []string {
+	if nil == receiver {
+		return nil
+	}
+
+	receiver.mutex.RLock()
+	defer receiver.mutex.RUnlock()
+
+	var verbs []string
+	for verb, _ := range receiver.handlers {
+		verbs = append(verbs, verb)
+	}
+
+	sort.Strings(verbs)
+
+	return verbs
+}
@@ -0,0 +1,126 @@
+package mdl_test
+
+import (
+	"github.com/reiver/go-mdl"
+
+	"net/http"
+	"net/http/httptest"
+	"strings"
+
+	"testing"
+)
+
+func TestMuxServeHTTP(t *testing.T) {
+
+	var mux mdl.Mux
+
+	mux.HandleFunc("RECORD_EMAIL", func(responseWriter http.ResponseWriter, request *http.Request, instruction *mdl.Instruction) {
+		responseWriter.Write([]byte("RECORD_EMAIL " + instruction.Data.Fetch("email_address").ElseUnwrap("")))
+	})
+	mux.HandleFunc("EMPTY_SHOPPING_CART", func(responseWriter http.ResponseWriter, request *http.Request, instruction *mdl.Instruction) {
+		responseWriter.Write([]byte("EMPTY_SHOPPING_CART"))
+	})
+
+	tests := []struct{
+		Method      string
+		Header      map[string]string
+		Body        string
+		ExpectedStatusCode int
+		ExpectedBody       string
+		ExpectedAllow      string
+	}{
+		{
+			Method: http.MethodPost,
+			Header: map[string]string{
+				"X-Idempotent-ID": "abc-123",
+				"X-HTTP-Method-Override": "RECORD_EMAIL",
+				"Content-Type": "application/x-www-form-urlencoded",
+			},
+			Body: "email_address=joeblow@example.com",
+			ExpectedStatusCode: http.StatusOK,
+			ExpectedBody: "RECORD_EMAIL joeblow@example.com",
+		},
+		{
+			Method: "EMPTY_SHOPPING_CART",
+			Header: map[string]string{
+				"X-Idempotent-ID": "abc-123",
+				"Content-Type": "application/json",
+			},
+			Body: "{}",
+			ExpectedStatusCode: http.StatusOK,
+			ExpectedBody: "EMPTY_SHOPPING_CART",
+		},
+
+
+
+		{
+			Method: "LAUNCH_ROCKET",
+			Header: map[string]string{
+				"X-Idempotent-ID": "abc-123",
+				"Content-Type": "application/json",
+			},
+			Body: "{}",
+			ExpectedStatusCode: http.StatusMethodNotAllowed,
+			ExpectedBody: "Method Not Allowed\nAllowed: EMPTY_SHOPPING_CART, RECORD_EMAIL\n",
+			ExpectedAllow: "EMPTY_SHOPPING_CART, RECORD_EMAIL",
+		},
+		{
+			Method: "RECORD_EMAIL",
+			Header: map[string]string{
+				"Content-Type": "application/json",
+			},
+			Body: "{}",
+			ExpectedStatusCode: http.StatusBadRequest,
+		},
+		{
+			Method: "RECORD_EMAIL",
+			Header: map[string]string{
+				"X-Idempotent-ID": "abc-123",
+				"Content-Type": "application/json",
+			},
+			Body: "{",
+			ExpectedStatusCode: http.StatusBadRequest,
+		},
+		{
+			Method: "RECORD_EMAIL",
+			Header: map[string]string{
+				"X-Idempotent-ID": "abc-123",
+				"Content-Type": "text/plain",
+			},
+			Body: "joeblow@example.com",
+			ExpectedStatusCode: http.StatusUnsupportedMediaType,
+		},
+	}
+
+	for testNumber, test := range tests {
+
+		request := httptest.NewRequest(test.Method, "/v1/users", strings.NewReader(test.Body))
+		for name, value := range test.Header {
+			request.Header.Set(name, value)
+		}
+
+		recorder := httptest.NewRecorder()
+
+		mux.ServeHTTP(recorder, request)
+
+		if expected, actual := test.ExpectedStatusCode, recorder.Code; expected != actual {
+			t.Errorf("For test #%d, expected HTTP status code %d, but actually got %d.", testNumber, expected, actual)
+			t.Logf("BODY: %q", recorder.Body.String())
+			continue
+		}
+
+		if "" != test.ExpectedBody {
+			if expected, actual := test.ExpectedBody, recorder.Body.String(); expected != actual {
+				t.Errorf("For test #%d, the HTTP response body that was actually gotten was not what was expected.", testNumber)
+				t.Logf("EXPECTED: %q", expected)
+				t.Logf("ACTUAL:   %q", actual)
+				continue
+			}
+		}
+
+		if expected, actual := test.ExpectedAllow, recorder.Header().Get("Allow"); expected != actual {
+			t.Errorf("For test #%d, expected “Allow” HTTP response header %q, but actually got %q.", testNumber, expected, actual)
+			continue
+		}
+	}
+}
@@ -0,0 +1,38 @@
+package mdl
+
+// The binary form of an option type (such as ‘mdl.String’) is a single byte that says whether it has a value
+// (0 for ‘nothing’, 1 for ‘something’), followed by the binary form of the value (if there is one).
+const (
+	optionBinaryNothing   byte = 0
+	optionBinarySomething byte = 1
+)
+
+func optionMarshalBinary(loaded bool, datum []byte) []byte {
+	if !loaded {
+		return []byte{optionBinaryNothing}
+	}
+
+	var data []byte = make([]byte, 1+len(datum))
+	data[0] = optionBinarySomething
+	copy(data[1:], datum)
+
+	return data
+}
+
+func optionUnmarshalBinary(data []byte) (datum []byte, loaded bool, err error) {
+	if 0 == len(data) {
+		return nil, false, errMalformedBinary
+	}
+
+	switch data[0] {
+	case optionBinaryNothing:
+		if 1 != len(data) {
+			return nil, false, errMalformedBinary
+		}
+		return nil, false, nil
+	case optionBinarySomething:
+		return data[1:], true, nil
+	default:
+		return nil, false, errMalformedBinary
+	}
+}
@@ -0,0 +1,36 @@
+package mdl
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// optionFormatDirective rebuilds the formatting directive (ex: "%08.3f") that ‘f’ and ‘c’ came from, so that
+// an option type can pass the formatting of its value on to the "fmt" package.
+func optionFormatDirective(f fmt.State, c rune) string {
+	var builder strings.Builder
+
+	builder.WriteRune('%')
+	for _, flag := range "+-# 0" {
+		if f.Flag(int(flag)) {
+			builder.WriteRune(flag)
+		}
+	}
+	if width, ok := f.Width(); ok {
+		builder.WriteString(strconv.Itoa(width))
+	}
+	if precision, ok := f.Precision(); ok {
+		builder.WriteRune('.')
+		builder.WriteString(strconv.Itoa(precision))
+	}
+	builder.WriteRune(c)
+
+	return builder.String()
+}
+
+// optionNothing is implemented by the option types (such as ‘mdl.String’), so that code that works with
+// any of them can tell if one has no value.
+type optionNothing interface {
+	isNothing() bool
+}
@@ -0,0 +1,66 @@
+package mdl
+
+import (
+	"fmt"
+	"net/http"
+)
+
+// PayloadTooLarge is the error returned from mdl.Instruction.Scan() when the HTTP request body is larger than the limit.
+//
+// For example:
+//
+//	var instruction mdl.Instruction
+//	
+//	// ...
+//	
+//	err := instruction.Scan(request)
+//	
+//	if nil != err {
+//		switch casted := err.(type) {
+//		case mdl.PayloadTooLarge:
+//			http.Error(responseWriter, casted.Error(), casted.StatusCode())
+//			return
+//		default:
+//			//@TODO
+//		}
+//	}
+type PayloadTooLarge interface {
+	error
+	PayloadTooLarge()
+
+	// StatusCode returns the HTTP status code that goes with this error (i.e., 413 “Payload Too Large”).
+	StatusCode() int
+
+	// Unwrap returns the error that caused this error, if there is one.
+	Unwrap() error
+}
+
+type internalPayloadTooLarge struct {
+	err error
+}
+
+func payloadTooLarge(err error) PayloadTooLarge {
+	return internalPayloadTooLarge{
+		err: err,
+	}
+}
+
+func (receiver internalPayloadTooLarge) Error() string {
+	if nil == receiver.err {
+		return "mdl: payload too large"
+	}
+
+	return fmt.Sprintf("mdl: payload too large: %s", receiver.err)
+}
+
+func (receiver internalPayloadTooLarge) StatusCode() int {
+	return http.StatusRequestEntityTooLarge
+}
+
+func (receiver internalPayloadTooLarge) Unwrap() error {
+	return receiver.err
+}
+
+func (internalPayloadTooLarge) PayloadTooLarge() {
+	// Nothing here.
+}
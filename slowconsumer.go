@@ -0,0 +1,45 @@
+package mdl
+
+import (
+	"fmt"
+)
+
+// SlowConsumer is the error a subscription (see mdl.Subscribe()) ends with, when its policy is mdl.SlowConsumerEnd,
+// and whatever is receiving its events is not keeping up.
+//
+// Position returns the Position of the first event that was not delivered; so that a new subscription can be started from there.
+//
+// For example:
+//
+//	for event := range subscription.Events() {
+//		// ...
+//	}
+//
+//	switch casted := subscription.Err().(type) {
+//	case mdl.SlowConsumer:
+//		options.FromPosition = casted.Position()
+//		subscription = mdl.Subscribe(ctx, store, options)
+//	default:
+//		//@TODO
+//	}
+type SlowConsumer interface {
+	error
+	SlowConsumer()
+	Position() uint64
+}
+
+type internalSlowConsumer struct {
+	position uint64
+}
+
+func (receiver internalSlowConsumer) Error() string {
+	return fmt.Sprintf("mdl: slow consumer; subscription ended before delivering the event at position %d", receiver.position)
+}
+
+func (receiver internalSlowConsumer) Position() uint64 {
+	return receiver.position
+}
+
+func (internalSlowConsumer) SlowConsumer() {
+	// Nothing here.
+}
@@ -0,0 +1,23 @@
+package mdl
+
+import (
+	"testing"
+)
+
+func TestInternalSlowConsumerAsError(t *testing.T) {
+	var err error = internalSlowConsumer{} // THIS IS THE LINE THAT ACTUALLY MATTERS.
+
+	if nil == err {
+		t.Errorf("This should never happen.")
+		return
+	}
+}
+
+func TestInternalSlowConsumerAsSlowConsumer(t *testing.T) {
+	var complainer SlowConsumer = internalSlowConsumer{} // THIS IS THE LINE THAT ACTUALLY MATTERS.
+
+	if nil == complainer {
+		t.Errorf("This should never happen.")
+		return
+	}
+}
@@ -0,0 +1,535 @@
+package mdl
+
+import (
+	"database/sql"
+	"fmt"
+	"math"
+	"math/rand"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// SQLDialect is the SQL dialect an ‘mdl.SQLEventStore’ talks to its database in.
+type SQLDialect int
+
+// The SQL dialects an ‘mdl.SQLEventStore’ knows about.
+const (
+	SQLDialectPostgreSQL SQLDialect = iota + 1
+	SQLDialectMySQL
+	SQLDialectSQLite
+)
+
+// DefaultSQLEventStoreTable is the name of the table an ‘mdl.SQLEventStore’ uses, if mdl.SQLEventStore.Table is not set.
+const DefaultSQLEventStoreTable = "mdl_events"
+
+// SQLEventStore is an ‘mdl.EventStore’ that keeps its events in a table in an SQL database.
+// It only uses package "database/sql"; so it works with any database driver for one of the SQL dialects it knows about.
+//
+// Each event is a row in the table. The table has a primary key on the (global) position of the event, and a unique
+// constraint on (stream_id, version); so two programs appending to the same stream at the same time cannot both succeed.
+// (Whichever one loses gets an ‘mdl.VersionConflict’ error.)
+//
+// The position of appended events is one more than the last position in the table, in the same transaction as the insert.
+// So positions have no gaps; and an event is never committed after an event with a greater position, which matters to
+// something following mdl.EventStore.ReadAll().
+//
+// The table can be created with mdl.SQLEventStore.CreateTable(); or with the SQL from mdl.SQLDialect.CreateTableSQL().
+//
+// Example
+//
+//	db, err := sql.Open("postgres", dataSourceName)
+//
+//	// ...
+//
+//	var store = mdl.SQLEventStore{
+//		DB: db,
+//		Dialect: mdl.SQLDialectPostgreSQL,
+//	}
+//
+//	if err := store.CreateTable(); nil != err {
+//		return err
+//	}
+type SQLEventStore struct {
+
+	// DB is the database the events are kept in.
+	DB *sql.DB
+
+	// Dialect is the SQL dialect of DB.
+	Dialect SQLDialect
+
+	// Table is the name of the table the events are kept in.
+	// If it is empty, then DefaultSQLEventStoreTable is used.
+	//
+	// It is put into the SQL as is; so it must not come from anything untrusted.
+	Table string
+
+	notifier eventStoreNotifier
+}
+
+// How many times Append tries to insert, when it keeps losing races to something else appending at the same time; and how
+// long it waits before its first retry. (The wait doubles after each retry, and is jittered so that racing appends spread out.)
+const (
+	sqlEventStoreAppendAttempts = 10
+	sqlEventStoreAppendBackoff  = time.Millisecond
+)
+
+// sqlEventStoreConflict is which unique constraint (if any) a failed insert violated.
+type sqlEventStoreConflict int
+
+const (
+	sqlEventStoreNoConflict sqlEventStoreConflict = iota
+	sqlEventStorePositionConflict
+	sqlEventStoreVersionConflict
+)
+
+// Append makes ‘mdl.SQLEventStore’ fit the ‘mdl.EventStore’ interface.
+//
+// If something else appends at the same time, and gets the position (or, with no expected version, the version) first,
+// Append tries again. If it still has not succeeded after a few tries, it returns the error from the last one.
+func (receiver *SQLEventStore) Append(streamID string, expectedVersion Uint64, events ...*Event) (uint64, error) {
+	if nil == receiver {
+		return 0, errNilReceiver
+	}
+	if err := receiver.check(); nil != err {
+		return 0, err
+	}
+
+	if err := eventStoreCheckAppend(streamID, events); nil != err {
+		return 0, err
+	}
+
+	var appended []*Event = make([]*Event, len(events))
+	for i, event := range events {
+		var copied Event
+		if err := event.CopyTo(&copied); nil != err {
+			return 0, err
+		}
+		appended[i] = &copied
+	}
+
+	for attempt := 1; ; attempt++ {
+		version, conflict, err := receiver.append(streamID, expectedVersion, appended)
+
+		var retry bool
+		switch conflict {
+		case sqlEventStorePositionConflict:
+			// Something else appended (to some stream) at the same time, and got the position first.
+			retry = true
+		case sqlEventStoreVersionConflict:
+			expected, something := expectedVersion.Unwrap()
+			if !something {
+				// Any version will do; so try again at the new version.
+				retry = true
+				break
+			}
+
+			actual, err := receiver.version(receiver.DB, streamID)
+			if nil != err {
+				return 0, err
+			}
+			if expected == actual {
+				// Whatever got the version first did not commit after all.
+				retry = true
+				break
+			}
+			return actual, internalVersionConflict{
+				streamID:streamID,
+				expectedVersion:expected,
+				actualVersion:actual,
+			}
+		}
+		if retry && attempt < sqlEventStoreAppendAttempts {
+			backoff := sqlEventStoreAppendBackoff << uint(attempt-1)
+			time.Sleep(time.Duration(rand.Int63n(int64(backoff))) + backoff/2)
+			continue
+		}
+		if nil != err {
+			return version, err
+		}
+
+		for i, event := range appended {
+			events[i].StreamID = event.StreamID
+			events[i].Version  = event.Version
+			events[i].Position = event.Position
+		}
+
+		if 0 < len(appended) {
+			receiver.notifier.notify()
+		}
+
+		return version, nil
+	}
+}
+
+// CreateTable creates the table the events are kept in, if it does not already exist.
+func (receiver *SQLEventStore) CreateTable() error {
+	if nil == receiver {
+		return errNilReceiver
+	}
+	if err := receiver.check(); nil != err {
+		return err
+	}
+
+	query, err := receiver.Dialect.CreateTableSQL(receiver.table())
+	if nil != err {
+		return err
+	}
+
+	_, err = receiver.DB.Exec(query)
+
+	return err
+}
+
+// Notify makes ‘mdl.SQLEventStore’ fit the ‘mdl.EventStoreNotifier’ interface.
+//
+// It only knows about events appended through this ‘mdl.SQLEventStore’.
+func (receiver *SQLEventStore) Notify() <-chan struct{} {
+	if nil == receiver {
+		return nil
+	}
+
+	return receiver.notifier.Notify()
+}
+
+// ReadAll makes ‘mdl.SQLEventStore’ fit the ‘mdl.EventStore’ interface.
+func (receiver *SQLEventStore) ReadAll(fromPosition uint64, limit int) ([]*Event, error) {
+	if nil == receiver {
+		return nil, errNilReceiver
+	}
+	if err := receiver.check(); nil != err {
+		return nil, err
+	}
+
+	if math.MaxInt64 < fromPosition {
+		return []*Event{}, nil
+	}
+
+	query := "SELECT position, stream_id, version, event FROM " + receiver.table() +
+		" WHERE position >= " + receiver.Dialect.placeholder(1) +
+		" ORDER BY position ASC" + sqlEventStoreLimit(limit)
+
+	return receiver.query(query, int64(fromPosition))
+}
+
+// ReadStreamBackward makes ‘mdl.SQLEventStore’ fit the ‘mdl.EventStore’ interface.
+func (receiver *SQLEventStore) ReadStreamBackward(streamID string, fromVersion uint64, limit int) ([]*Event, error) {
+	if nil == receiver {
+		return nil, errNilReceiver
+	}
+	if err := receiver.check(); nil != err {
+		return nil, err
+	}
+
+	if math.MaxInt64 < fromVersion {
+		fromVersion = math.MaxInt64
+	}
+
+	query := "SELECT position, stream_id, version, event FROM " + receiver.table() +
+		" WHERE stream_id = " + receiver.Dialect.placeholder(1) + " AND version <= " + receiver.Dialect.placeholder(2) +
+		" ORDER BY version DESC" + sqlEventStoreLimit(limit)
+
+	return receiver.query(query, streamID, int64(fromVersion))
+}
+
+// ReadStreamForward makes ‘mdl.SQLEventStore’ fit the ‘mdl.EventStore’ interface.
+func (receiver *SQLEventStore) ReadStreamForward(streamID string, fromVersion uint64, limit int) ([]*Event, error) {
+	if nil == receiver {
+		return nil, errNilReceiver
+	}
+	if err := receiver.check(); nil != err {
+		return nil, err
+	}
+
+	if math.MaxInt64 < fromVersion {
+		return []*Event{}, nil
+	}
+
+	query := "SELECT position, stream_id, version, event FROM " + receiver.table() +
+		" WHERE stream_id = " + receiver.Dialect.placeholder(1) + " AND version >= " + receiver.Dialect.placeholder(2) +
+		" ORDER BY version ASC" + sqlEventStoreLimit(limit)
+
+	return receiver.query(query, streamID, int64(fromVersion))
+}
+
+// append tries to append ‘events’ in one transaction.
+//
+// If an insert violates one of the unique constraints (because something else appended at the same time), then the
+// transaction is rolled back, and which one was violated is returned.
+func (receiver *SQLEventStore) append(streamID string, expectedVersion Uint64, events []*Event) (uint64, sqlEventStoreConflict, error) {
+	tx, err := receiver.DB.Begin()
+	if nil != err {
+		return 0, sqlEventStoreNoConflict, err
+	}
+	committed := false
+	defer func() {
+		if !committed {
+			tx.Rollback()
+		}
+	}()
+
+	version, err := receiver.version(tx, streamID)
+	if nil != err {
+		return 0, sqlEventStoreNoConflict, err
+	}
+	if err := eventStoreCheckVersion(streamID, expectedVersion, version); nil != err {
+		return version, sqlEventStoreNoConflict, err
+	}
+
+	if len(events) <= 0 {
+		return version, sqlEventStoreNoConflict, nil
+	}
+
+	var position int64
+	if err := tx.QueryRow("SELECT COALESCE(MAX(position), 0) FROM " + receiver.table()).Scan(&position); nil != err {
+		return 0, sqlEventStoreNoConflict, err
+	}
+
+	query := "INSERT INTO " + receiver.table() + " (position, stream_id, version, event_type, event) VALUES (" +
+		receiver.Dialect.placeholder(1) + ", " +
+		receiver.Dialect.placeholder(2) + ", " +
+		receiver.Dialect.placeholder(3) + ", " +
+		receiver.Dialect.placeholder(4) + ", " +
+		receiver.Dialect.placeholder(5) + ")"
+
+	for i, event := range events {
+		event.StreamID = SomeString(streamID)
+		event.Version  = SomeUint64(version + uint64(i) + 1)
+		event.Position = SomeUint64(uint64(position) + uint64(i) + 1)
+
+		value, err := event.Value()
+		if nil != err {
+			return 0, sqlEventStoreNoConflict, err
+		}
+
+		// (An event without a Type gets NULL; since mdl.NoString().Value() returns an error.)
+		var eventType interface{}
+		if datum, something := event.Type.Unwrap(); something {
+			eventType = datum
+		}
+
+		_, err = tx.Exec(query, int64(uint64(position)+uint64(i)+1), streamID, int64(version+uint64(i)+1), eventType, value)
+		if nil != err {
+			return 0, receiver.Dialect.conflict(receiver.table(), err), err
+		}
+	}
+
+	committed = true
+	if err := tx.Commit(); nil != err {
+		return 0, receiver.Dialect.conflict(receiver.table(), err), err
+	}
+
+	return version + uint64(len(events)), sqlEventStoreNoConflict, nil
+}
+
+func (receiver *SQLEventStore) check() error {
+	if nil == receiver.DB {
+		return errNilDB
+	}
+
+	return receiver.Dialect.check()
+}
+
+func (receiver *SQLEventStore) query(query string, args ...interface{}) ([]*Event, error) {
+	rows, err := receiver.DB.Query(query, args...)
+	if nil != err {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var result []*Event = []*Event{}
+
+	for rows.Next() {
+		var position int64
+		var streamID string
+		var version int64
+		var event Event
+
+		if err := rows.Scan(&position, &streamID, &version, &event); nil != err {
+			return nil, err
+		}
+
+		// The columns are what the constraints are on; so they win over whatever is in the JSON.
+		event.StreamID = SomeString(streamID)
+		event.Version  = SomeUint64(uint64(version))
+		event.Position = SomeUint64(uint64(position))
+
+		result = append(result, &event)
+	}
+	if err := rows.Err(); nil != err {
+		return nil, err
+	}
+
+	return result, nil
+}
+
+func (receiver *SQLEventStore) table() string {
+	if "" == receiver.Table {
+		return DefaultSQLEventStoreTable
+	}
+
+	return receiver.Table
+}
+
+// version returns the version the stream ‘streamID’ is at.
+func (receiver *SQLEventStore) version(queryer interface{QueryRow(string, ...interface{}) *sql.Row}, streamID string) (uint64, error) {
+	var version int64
+
+	query := "SELECT COALESCE(MAX(version), 0) FROM " + receiver.table() + " WHERE stream_id = " + receiver.Dialect.placeholder(1)
+	if err := queryer.QueryRow(query, streamID).Scan(&version); nil != err {
+		return 0, err
+	}
+
+	return uint64(version), nil
+}
+
+// CreateTableSQL returns the SQL to create the table (named ‘table’) that an ‘mdl.SQLEventStore’ keeps its events in.
+//
+// For example, for mdl.SQLDialectPostgreSQL:
+//
+//	CREATE TABLE IF NOT EXISTS mdl_events (
+//		position   BIGINT       NOT NULL,
+//		stream_id  VARCHAR(255) NOT NULL,
+//		version    BIGINT       NOT NULL,
+//		event_type VARCHAR(255),
+//		event      TEXT         NOT NULL,
+//		CONSTRAINT mdl_events_position PRIMARY KEY (position),
+//		CONSTRAINT mdl_events_stream_version UNIQUE (stream_id, version)
+//	)
+//
+// For mdl.SQLDialectMySQL, stream_id is VARBINARY(255) rather than VARCHAR(255); since MySQL’s default collation is case-insensitive,
+// and would make “user/A” and “user/a” the same stream to the unique constraint, and to ReadStreamForward and ReadStreamBackward.
+//
+// ‘table’ can be schema-qualified (ex: “public.mdl_events”); the constraints are named after the unqualified part (ex: “mdl_events_position”).
+func (receiver SQLDialect) CreateTableSQL(table string) (string, error) {
+	if err := receiver.check(); nil != err {
+		return "", err
+	}
+
+	var bigint, streamID, text, suffix string
+	switch receiver {
+	case SQLDialectPostgreSQL:
+		bigint, streamID, text = "BIGINT", "VARCHAR(255)", "TEXT"
+	case SQLDialectMySQL:
+		bigint, streamID, text, suffix = "BIGINT", "VARBINARY(255)", "LONGTEXT", " ENGINE=InnoDB DEFAULT CHARSET=utf8mb4"
+	case SQLDialectSQLite:
+		bigint, streamID, text = "INTEGER", "VARCHAR(255)", "TEXT"
+	}
+
+	return fmt.Sprintf(
+		"CREATE TABLE IF NOT EXISTS %s (\n" +
+		"\tposition   %-12s NOT NULL,\n" +
+		"\tstream_id  %-12s NOT NULL,\n" +
+		"\tversion    %-12s NOT NULL,\n" +
+		"\tevent_type VARCHAR(255),\n" +
+		"\tevent      %-12s NOT NULL,\n" +
+		"\tCONSTRAINT %s_position PRIMARY KEY (position),\n" +
+		"\tCONSTRAINT %s_stream_version UNIQUE (stream_id, version)\n" +
+		")%s",
+		table, bigint, streamID, bigint, text, sqlEventStoreUnqualified(table), sqlEventStoreUnqualified(table), suffix), nil
+}
+
+// String returns the name of the SQL dialect.
+func (receiver SQLDialect) String() string {
+	switch receiver {
+	case SQLDialectPostgreSQL:
+		return "PostgreSQL"
+	case SQLDialectMySQL:
+		return "MySQL"
+	case SQLDialectSQLite:
+		return "SQLite"
+	default:
+		return fmt.Sprintf("SQLDialect(%d)", int(receiver))
+	}
+}
+
+func (receiver SQLDialect) check() error {
+	switch receiver {
+	case SQLDialectPostgreSQL, SQLDialectMySQL, SQLDialectSQLite:
+		return nil
+	default:
+		return fmt.Errorf("mdl: unknown SQL dialect %s", receiver)
+	}
+}
+
+// conflict returns which of the unique constraints on ‘table’ (if any) ‘err’ says was violated.
+//
+// Package "database/sql" does not have a type for this kind of error, and each database driver has its own; so this goes by the error message.
+// For example:
+//
+// • PostgreSQL: duplicate key value violates unique constraint "mdl_events_stream_version"
+//
+// • MySQL: Error 1062: Duplicate entry 'user/123-3' for key 'mdl_events_stream_version'
+//
+// • SQLite: UNIQUE constraint failed: mdl_events.stream_id, mdl_events.version
+//
+// None of these include the schema; so a schema-qualified ‘table’ (ex: “public.mdl_events”) is matched by its unqualified part.
+func (receiver SQLDialect) conflict(table string, err error) sqlEventStoreConflict {
+	if nil == err {
+		return sqlEventStoreNoConflict
+	}
+
+	table = sqlEventStoreUnqualified(table)
+
+	message := err.Error()
+
+	switch receiver {
+	case SQLDialectPostgreSQL:
+		if !strings.Contains(message, "duplicate key") {
+			break
+		}
+		if strings.Contains(message, `"`+table+`_stream_version"`) {
+			return sqlEventStoreVersionConflict
+		}
+		if strings.Contains(message, `"`+table+`_position"`) {
+			return sqlEventStorePositionConflict
+		}
+	case SQLDialectMySQL:
+		if !strings.Contains(message, "Duplicate entry") {
+			break
+		}
+		// (Newer versions of MySQL put the table name in front of the key name.)
+		if strings.Contains(message, table+"_stream_version'") {
+			return sqlEventStoreVersionConflict
+		}
+		if strings.Contains(message, "PRIMARY'") {
+			return sqlEventStorePositionConflict
+		}
+	case SQLDialectSQLite:
+		if !strings.Contains(message, "UNIQUE constraint failed") {
+			break
+		}
+		if strings.Contains(message, table+".stream_id, "+table+".version") {
+			return sqlEventStoreVersionConflict
+		}
+		if strings.Contains(message, table+".position") {
+			return sqlEventStorePositionConflict
+		}
+	}
+
+	return sqlEventStoreNoConflict
+}
+
+// placeholder returns the placeholder for the n'th (one-based) argument to a query.
+func (receiver SQLDialect) placeholder(n int) string {
+	if SQLDialectPostgreSQL == receiver {
+		return "$" + strconv.Itoa(n)
+	}
+
+	return "?"
+}
+
+// sqlEventStoreUnqualified returns ‘table’ without its schema (if it has one).
+//
+// For example, “public.mdl_events” becomes “mdl_events”.
+func sqlEventStoreUnqualified(table string) string {
+	return table[strings.LastIndexByte(table, '.')+1:]
+}
+
+func sqlEventStoreLimit(limit int) string {
+	if limit <= 0 {
+		return ""
+	}
+
+	return " LIMIT " + strconv.Itoa(limit)
+}
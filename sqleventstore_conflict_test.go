@@ -0,0 +1,54 @@
+package mdl
+
+import (
+	"errors"
+
+	"testing"
+)
+
+func TestSQLDialectConflict(t *testing.T) {
+
+	tests := []struct{
+		Dialect  SQLDialect
+		Table    string
+		Message  string
+		Expected sqlEventStoreConflict
+	}{
+		{SQLDialectPostgreSQL, "mdl_events", `pq: duplicate key value violates unique constraint "mdl_events_stream_version"`, sqlEventStoreVersionConflict},
+		{SQLDialectPostgreSQL, "mdl_events", `ERROR: duplicate key value violates unique constraint "mdl_events_position" (SQLSTATE 23505)`, sqlEventStorePositionConflict},
+		{SQLDialectPostgreSQL, "mdl_events", `pq: duplicate key value violates unique constraint "other_events_stream_version"`, sqlEventStoreNoConflict},
+		{SQLDialectPostgreSQL, "mdl_events", `pq: relation "mdl_events" does not exist`, sqlEventStoreNoConflict},
+
+		{SQLDialectMySQL, "mdl_events", `Error 1062: Duplicate entry 'user/123-3' for key 'mdl_events_stream_version'`, sqlEventStoreVersionConflict},
+		{SQLDialectMySQL, "mdl_events", `Error 1062 (23000): Duplicate entry 'user/123-3' for key 'mdl_events.mdl_events_stream_version'`, sqlEventStoreVersionConflict},
+		{SQLDialectMySQL, "mdl_events", `Error 1062: Duplicate entry '17' for key 'PRIMARY'`, sqlEventStorePositionConflict},
+		{SQLDialectMySQL, "mdl_events", `Error 1062 (23000): Duplicate entry '17' for key 'mdl_events.PRIMARY'`, sqlEventStorePositionConflict},
+		{SQLDialectMySQL, "mdl_events", `Error 1213: Deadlock found when trying to get lock`, sqlEventStoreNoConflict},
+
+		{SQLDialectSQLite, "mdl_events", `UNIQUE constraint failed: mdl_events.stream_id, mdl_events.version`, sqlEventStoreVersionConflict},
+		{SQLDialectSQLite, "mdl_events", `UNIQUE constraint failed: mdl_events.position`, sqlEventStorePositionConflict},
+		{SQLDialectSQLite, "mdl_events", `database is locked`, sqlEventStoreNoConflict},
+
+		{SQLDialectPostgreSQL, "public.mdl_events", `pq: duplicate key value violates unique constraint "mdl_events_stream_version"`, sqlEventStoreVersionConflict},
+		{SQLDialectPostgreSQL, "public.mdl_events", `pq: duplicate key value violates unique constraint "mdl_events_position"`, sqlEventStorePositionConflict},
+		{SQLDialectMySQL, "app.mdl_events", `Error 1062 (23000): Duplicate entry 'user/123-3' for key 'mdl_events.mdl_events_stream_version'`, sqlEventStoreVersionConflict},
+		{SQLDialectSQLite, "main.mdl_events", `UNIQUE constraint failed: mdl_events.stream_id, mdl_events.version`, sqlEventStoreVersionConflict},
+		{SQLDialectSQLite, "main.mdl_events", `UNIQUE constraint failed: mdl_events.position`, sqlEventStorePositionConflict},
+
+		{SQLDialectSQLite, "mdl_events", `pq: duplicate key value violates unique constraint "mdl_events_stream_version"`, sqlEventStoreNoConflict},
+	}
+
+	for testNumber, test := range tests {
+
+		if expected, actual := test.Expected, test.Dialect.conflict(test.Table, errors.New(test.Message)); expected != actual {
+			t.Errorf("For test #%d, expected %d, but actually got %d.", testNumber, expected, actual)
+			t.Logf("DIALECT: %s", test.Dialect)
+			t.Logf("MESSAGE: %s", test.Message)
+			continue
+		}
+	}
+
+	if expected, actual := sqlEventStoreNoConflict, SQLDialectSQLite.conflict("mdl_events", nil); expected != actual {
+		t.Errorf("For a nil error, expected %d, but actually got %d.", expected, actual)
+	}
+}
@@ -0,0 +1,273 @@
+package mdl_test
+
+import (
+	"github.com/reiver/go-mdl"
+	"github.com/reiver/go-mdl/mdltest"
+
+	"database/sql"
+	"errors"
+	"fmt"
+	"sync"
+	"sync/atomic"
+
+	"testing"
+)
+
+var _ mdl.EventStore = &mdl.SQLEventStore{}
+
+var sqlEventStoreDatabaseCount uint64
+
+func sqlEventStoreFixture(t *testing.T, dialect mdl.SQLDialect, table string) *mdl.SQLEventStore {
+	var name string
+	switch dialect {
+	case mdl.SQLDialectPostgreSQL:
+		name = "postgresql"
+	case mdl.SQLDialectMySQL:
+		name = "mysql"
+	default:
+		name = "sqlite"
+	}
+
+	db, err := sql.Open(fakeSQLDriverName, fmt.Sprintf("%s:test%d", name, atomic.AddUint64(&sqlEventStoreDatabaseCount, 1)))
+	if nil != err {
+		t.Fatalf("Did not expect an error, but actually got one: (%T) %q", err, err)
+	}
+
+	store := &mdl.SQLEventStore{
+		DB: db,
+		Dialect: dialect,
+		Table: table,
+	}
+
+	if err := store.CreateTable(); nil != err {
+		t.Fatalf("Did not expect an error from .CreateTable(), but actually got one: (%T) %q", err, err)
+	}
+
+	return store
+}
+
+func TestSQLEventStore(t *testing.T) {
+
+	tests := []struct{
+		Dialect mdl.SQLDialect
+		Table   string
+	}{
+		{mdl.SQLDialectPostgreSQL, ""},
+		{mdl.SQLDialectMySQL,      ""},
+		{mdl.SQLDialectSQLite,     ""},
+		{mdl.SQLDialectSQLite,     "app_events"},
+	}
+
+	for _, test := range tests {
+		test := test
+
+		t.Run(test.Dialect.String()+"/"+test.Table, func(t *testing.T) {
+			mdltest.TestEventStore(t, func(t *testing.T) mdl.EventStore {
+				return sqlEventStoreFixture(t, test.Dialect, test.Table)
+			})
+		})
+	}
+}
+
+func TestSQLEventStoreConcurrentStreams(t *testing.T) {
+
+	for _, dialect := range []mdl.SQLDialect{mdl.SQLDialectPostgreSQL, mdl.SQLDialectMySQL, mdl.SQLDialectSQLite} {
+
+		store := sqlEventStoreFixture(t, dialect, "")
+
+		// Appending to different streams at the same time races for the same positions, which Append should retry.
+		const writers = 8
+		var waitgroup sync.WaitGroup
+		var errs chan error = make(chan error, writers)
+
+		for w := 0; w < writers; w++ {
+			waitgroup.Add(1)
+
+			go func(w int) {
+				defer waitgroup.Done()
+
+				for r := 0; r < 5; r++ {
+					if _, err := store.Append(fmt.Sprintf("stream/%d", w), mdl.NoUint64(), mdltest.NewEvent("E")); nil != err {
+						errs <- err
+						return
+					}
+				}
+			}(w)
+		}
+
+		waitgroup.Wait()
+		close(errs)
+
+		for err := range errs {
+			t.Fatalf("For dialect %s, did not expect an error, but actually got one: (%T) %q", dialect, err, err)
+		}
+
+		events, err := store.ReadAll(0, 0)
+		if nil != err {
+			t.Fatalf("For dialect %s, did not expect an error from .ReadAll(), but actually got one: (%T) %q", dialect, err, err)
+		}
+		if expected, actual := writers*5, len(events); expected != actual {
+			t.Fatalf("For dialect %s, expected %d events, but actually got %d.", dialect, expected, actual)
+		}
+		for i, event := range events {
+			if expected, actual := mdl.SomeUint64(uint64(i+1)), event.Position; expected != actual {
+				t.Fatalf("For dialect %s, for event #%d, expected Position %#v, but actually got %#v.", dialect, i, expected, actual)
+			}
+		}
+	}
+}
+
+func TestSQLEventStoreAppendRetries(t *testing.T) {
+
+	db, err := sql.Open(fakeSQLDriverName, "sqlite:always-conflicts")
+	if nil != err {
+		t.Fatalf("Did not expect an error, but actually got one: (%T) %q", err, err)
+	}
+	store := mdl.SQLEventStore{
+		DB: db,
+		Dialect: mdl.SQLDialectSQLite,
+	}
+	if err := store.CreateTable(); nil != err {
+		t.Fatalf("Did not expect an error from .CreateTable(), but actually got one: (%T) %q", err, err)
+	}
+
+	// Every insert loses the race for the position; so Append should give up, rather than trying forever.
+	fakeSQL.mutex.Lock()
+	database := fakeSQL.databases["sqlite:always-conflicts"]
+	fakeSQL.mutex.Unlock()
+
+	database.mutex.Lock()
+	database.insertErr = errors.New("UNIQUE constraint failed: mdl_events.position")
+	database.mutex.Unlock()
+
+	_, err = store.Append("a", mdl.NoUint64(), mdltest.NewEvent("E"))
+	if nil == err {
+		t.Fatalf("Expected an error from .Append(), but did not actually get one.")
+	}
+	if expected, actual := database.insertErr.Error(), err.Error(); expected != actual {
+		t.Errorf("Expected the error from the last try %q, but actually got %q.", expected, actual)
+	}
+
+	database.mutex.Lock()
+	inserts := database.inserts
+	database.mutex.Unlock()
+	if inserts < 2 {
+		t.Errorf("Expected .Append() to try again, but it only tried %d times.", inserts)
+	}
+}
+
+func TestSQLDialectCreateTableSQL(t *testing.T) {
+
+	tests := []struct{
+		Dialect  mdl.SQLDialect
+		Expected string
+	}{
+		{
+			Dialect: mdl.SQLDialectPostgreSQL,
+			Expected:
+				"CREATE TABLE IF NOT EXISTS events (\n"+
+				"\tposition   BIGINT       NOT NULL,\n"+
+				"\tstream_id  VARCHAR(255) NOT NULL,\n"+
+				"\tversion    BIGINT       NOT NULL,\n"+
+				"\tevent_type VARCHAR(255),\n"+
+				"\tevent      TEXT         NOT NULL,\n"+
+				"\tCONSTRAINT events_position PRIMARY KEY (position),\n"+
+				"\tCONSTRAINT events_stream_version UNIQUE (stream_id, version)\n"+
+				")",
+		},
+		{
+			Dialect: mdl.SQLDialectMySQL,
+			Expected:
+				"CREATE TABLE IF NOT EXISTS events (\n"+
+				"\tposition   BIGINT       NOT NULL,\n"+
+				"\tstream_id  VARBINARY(255) NOT NULL,\n"+
+				"\tversion    BIGINT       NOT NULL,\n"+
+				"\tevent_type VARCHAR(255),\n"+
+				"\tevent      LONGTEXT     NOT NULL,\n"+
+				"\tCONSTRAINT events_position PRIMARY KEY (position),\n"+
+				"\tCONSTRAINT events_stream_version UNIQUE (stream_id, version)\n"+
+				") ENGINE=InnoDB DEFAULT CHARSET=utf8mb4",
+		},
+		{
+			Dialect: mdl.SQLDialectSQLite,
+			Expected:
+				"CREATE TABLE IF NOT EXISTS events (\n"+
+				"\tposition   INTEGER      NOT NULL,\n"+
+				"\tstream_id  VARCHAR(255) NOT NULL,\n"+
+				"\tversion    INTEGER      NOT NULL,\n"+
+				"\tevent_type VARCHAR(255),\n"+
+				"\tevent      TEXT         NOT NULL,\n"+
+				"\tCONSTRAINT events_position PRIMARY KEY (position),\n"+
+				"\tCONSTRAINT events_stream_version UNIQUE (stream_id, version)\n"+
+				")",
+		},
+	}
+
+	for testNumber, test := range tests {
+
+		actual, err := test.Dialect.CreateTableSQL("events")
+		if nil != err {
+			t.Errorf("For test #%d, did not expect an error, but actually got one: (%T) %q", testNumber, err, err)
+			continue
+		}
+
+		if expected := test.Expected; expected != actual {
+			t.Errorf("For test #%d, the actual SQL is not what was expected.", testNumber)
+			t.Logf("EXPECTED:\n%s", expected)
+			t.Logf("ACTUAL:\n%s", actual)
+			continue
+		}
+	}
+}
+
+func TestSQLDialectCreateTableSQLSchema(t *testing.T) {
+
+	actual, err := mdl.SQLDialectPostgreSQL.CreateTableSQL("public.mdl_events")
+	if nil != err {
+		t.Fatalf("Did not expect an error, but actually got one: (%T) %q", err, err)
+	}
+
+	expected :=
+		"CREATE TABLE IF NOT EXISTS public.mdl_events (\n"+
+		"\tposition   BIGINT       NOT NULL,\n"+
+		"\tstream_id  VARCHAR(255) NOT NULL,\n"+
+		"\tversion    BIGINT       NOT NULL,\n"+
+		"\tevent_type VARCHAR(255),\n"+
+		"\tevent      TEXT         NOT NULL,\n"+
+		"\tCONSTRAINT mdl_events_position PRIMARY KEY (position),\n"+
+		"\tCONSTRAINT mdl_events_stream_version UNIQUE (stream_id, version)\n"+
+		")"
+
+	if expected != actual {
+		t.Errorf("The actual SQL is not what was expected.")
+		t.Logf("EXPECTED:\n%s", expected)
+		t.Logf("ACTUAL:\n%s", actual)
+	}
+}
+
+func TestSQLEventStoreError(t *testing.T) {
+
+	if _, err := mdl.SQLDialect(0).CreateTableSQL("events"); nil == err {
+		t.Errorf("Expected an error for an unknown SQL dialect, but did not actually get one.")
+	}
+
+	var store mdl.SQLEventStore
+	if _, err := store.ReadAll(0, 0); nil == err {
+		t.Errorf("Expected an error from an mdl.SQLEventStore with no DB, but did not actually get one.")
+	}
+
+	// The table was never created.
+	db, err := sql.Open(fakeSQLDriverName, "sqlite:no-table")
+	if nil != err {
+		t.Fatalf("Did not expect an error, but actually got one: (%T) %q", err, err)
+	}
+	store = mdl.SQLEventStore{
+		DB: db,
+		Dialect: mdl.SQLDialectSQLite,
+	}
+	if _, err := store.Append("a", mdl.NoUint64(), mdltest.NewEvent("E")); nil == err {
+		t.Errorf("Expected an error from .Append(), but did not actually get one.")
+	} else if _, casted := err.(mdl.VersionConflict); casted {
+		t.Errorf("Did not expect an mdl.VersionConflict error, but actually got one: %q", err)
+	}
+}
@@ -2,6 +2,8 @@ package mdl
 
 import (
 	"database/sql/driver"
+	"encoding/json"
+	"encoding/xml"
 	"fmt"
 )
 
@@ -149,6 +151,18 @@ func (receiver String) Format(f fmt.State, c rune) {
 	}
 }
 
+// GobDecode makes mdl.String fit the encoding/gob.GobDecoder interface.
+func (receiver *String) GobDecode(data []byte) error {
+	return receiver.UnmarshalBinary(data)
+}
+
+// GobEncode makes mdl.String fit the encoding/gob.GobEncoder interface.
+//
+// Unlike what encoding/gob does by itself, this keeps mdl.NoString() and mdl.SomeString("") different.
+func (receiver String) GobEncode() ([]byte, error) {
+	return receiver.MarshalBinary()
+}
+
 // GoString makes mdl.String fit the fmt.GoStringer interface.
 //
 // It gets used with the %#v verb with the printing family of functions
@@ -173,6 +187,57 @@ func (receiver String) GoString() string {
 	return fmt.Sprintf("mdl.SomeString(%q)", receiver.datum)
 }
 
+// MarshalBinary makes mdl.String fit the encoding.BinaryMarshaler interface.
+func (receiver String) MarshalBinary() ([]byte, error) {
+	return optionMarshalBinary(receiver.loaded, []byte(receiver.datum)), nil
+}
+
+// MarshalJSON makes mdl.String fit the encoding/json.Marshaler interface.
+//
+// mdl.NoString() is marshaled as JSON null, and mdl.SomeString() is marshaled as a JSON string.
+// (So mdl.SomeString("") is marshaled as "".)
+func (receiver String) MarshalJSON() ([]byte, error) {
+	if NoString() == receiver {
+		return []byte("null"), nil
+	}
+
+	return json.Marshal(receiver.datum)
+}
+
+// MarshalText makes mdl.String fit the encoding.TextMarshaler interface.
+//
+// Since text has no way of saying ‘nothing’, mdl.NoString() cannot be marshaled as text, and returns an error.
+func (receiver String) MarshalText() ([]byte, error) {
+	if NoString() == receiver {
+		return nil, errNotLoaded
+	}
+
+	return []byte(receiver.datum), nil
+}
+
+// MarshalXML makes mdl.String fit the encoding/xml.Marshaler interface.
+//
+// mdl.NoString() is marshaled as nothing at all (i.e., the XML element is omitted),
+// and mdl.SomeString() is marshaled as an XML element with the string as its character data.
+func (receiver String) MarshalXML(encoder *xml.Encoder, start xml.StartElement) error {
+	if NoString() == receiver {
+		return nil
+	}
+
+	return encoder.EncodeElement(receiver.datum, start)
+}
+
+// MarshalXMLAttr makes mdl.String fit the encoding/xml.MarshalerAttr interface.
+//
+// mdl.NoString() is marshaled as nothing at all (i.e., the XML attribute is omitted).
+func (receiver String) MarshalXMLAttr(name xml.Name) (xml.Attr, error) {
+	if NoString() == receiver {
+		return xml.Attr{}, nil
+	}
+
+	return xml.Attr{Name: name, Value: receiver.datum}, nil
+}
+
 func (receiver String) Map(fn func(string)string) String {
 	if NoString() == receiver {
 		return receiver
@@ -236,7 +301,52 @@ func (receiver String) Then(fn func(string)String) String {
 	return fn(receiver.datum)
 }
 
-// Scan makes mdl.String fit the encoding.TextUnmarshaler interface.
+// UnmarshalBinary makes mdl.String fit the encoding.BinaryUnmarshaler interface.
+//
+// It is the opposite of .MarshalBinary().
+func (receiver *String) UnmarshalBinary(data []byte) error {
+	if nil == receiver {
+		return errNilReceiver
+	}
+
+	datum, loaded, err := optionUnmarshalBinary(data)
+	if nil != err {
+		return err
+	}
+
+	if !loaded {
+		*receiver = NoString()
+		return nil
+	}
+
+	*receiver = SomeString(string(datum))
+	return nil
+}
+
+// UnmarshalJSON makes mdl.String fit the encoding/json.Unmarshaler interface.
+//
+// JSON null is unmarshaled as mdl.NoString(), and a JSON string is unmarshaled as mdl.SomeString().
+// Any other JSON value returns an error.
+func (receiver *String) UnmarshalJSON(data []byte) error {
+	if nil == receiver {
+		return errNilReceiver
+	}
+
+	if "null" == string(data) {
+		*receiver = NoString()
+		return nil
+	}
+
+	var datum string
+	if err := json.Unmarshal(data, &datum); nil != err {
+		return err
+	}
+
+	*receiver = SomeString(datum)
+	return nil
+}
+
+// UnmarshalText makes mdl.String fit the encoding.TextUnmarshaler interface.
 func (receiver *String) UnmarshalText(text []byte) error {
 	if nil == receiver {
 		return errNilReceiver
@@ -245,6 +355,34 @@ func (receiver *String) UnmarshalText(text []byte) error {
 	return receiver.Scan(text)
 }
 
+// UnmarshalXML makes mdl.String fit the encoding/xml.Unmarshaler interface.
+//
+// An XML element is unmarshaled as mdl.SomeString() of its character data; even if it is empty.
+// (An XML element that is omitted leaves the mdl.String as it was; which, for the zero value, is mdl.NoString().)
+func (receiver *String) UnmarshalXML(decoder *xml.Decoder, start xml.StartElement) error {
+	if nil == receiver {
+		return errNilReceiver
+	}
+
+	var datum string
+	if err := decoder.DecodeElement(&datum, &start); nil != err {
+		return err
+	}
+
+	*receiver = SomeString(datum)
+	return nil
+}
+
+// UnmarshalXMLAttr makes mdl.String fit the encoding/xml.UnmarshalerAttr interface.
+func (receiver *String) UnmarshalXMLAttr(attr xml.Attr) error {
+	if nil == receiver {
+		return errNilReceiver
+	}
+
+	*receiver = SomeString(attr.Value)
+	return nil
+}
+
 func (receiver String) Unwrap() (string, bool) {
 	return receiver.datum, receiver.loaded
 }
@@ -257,3 +395,7 @@ func (receiver String) Value() (driver.Value, error) {
 
 	return receiver.datum, nil
 }
+
+func (receiver String) isNothing() bool {
+	return NoString() == receiver
+}
@@ -0,0 +1,52 @@
+package mdl_test
+
+import (
+	"github.com/reiver/go-mdl"
+
+	"bytes"
+	"encoding/gob"
+	"testing"
+)
+
+func TestStringGob(t *testing.T) {
+
+	type User struct {
+		GivenName  mdl.String
+		FamilyName mdl.String
+		Nickname   mdl.String
+	}
+
+	tests := []User{
+		{
+			GivenName:  mdl.SomeString("Joe"),
+			FamilyName: mdl.SomeString(""),
+			Nickname:   mdl.NoString(),
+		},
+		{
+			GivenName:  mdl.NoString(),
+			FamilyName: mdl.SomeString("Blow"),
+			Nickname:   mdl.SomeString("Apple banana CHERRY 🙂 “👾”."),
+		},
+	}
+
+	for testNumber, expected := range tests {
+
+		var buffer bytes.Buffer
+		if err := gob.NewEncoder(&buffer).Encode(expected); nil != err {
+			t.Errorf("For test #%d, did not expect an error, but actually got one: (%T) %q", testNumber, err, err)
+			continue
+		}
+
+		// Like with all zero values, encoding/gob does not send mdl.NoString() struct fields; so decode into a zero value.
+		var actual User
+		if err := gob.NewDecoder(&buffer).Decode(&actual); nil != err {
+			t.Errorf("For test #%d, did not expect an error, but actually got one: (%T) %q", testNumber, err, err)
+			continue
+		}
+
+		if expected != actual {
+			t.Errorf("For test #%d, expected %#v, but actually got %#v.", testNumber, expected, actual)
+			continue
+		}
+	}
+}
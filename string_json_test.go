@@ -0,0 +1,100 @@
+package mdl_test
+
+import (
+	"github.com/reiver/go-mdl"
+
+	"encoding/json"
+	"testing"
+)
+
+func TestStringJSON(t *testing.T) {
+
+	tests := []struct{
+		Datum    mdl.String
+		Expected string
+	}{
+		{
+			Datum:    mdl.NoString(),
+			Expected: `null`,
+		},
+		{
+			Datum:    mdl.SomeString(""),
+			Expected: `""`,
+		},
+		{
+			Datum:    mdl.SomeString("Hello world!"),
+			Expected: `"Hello world!"`,
+		},
+		{
+			Datum:    mdl.SomeString("Apple banana CHERRY 🙂 “👾”."),
+			Expected: `"Apple banana CHERRY 🙂 “👾”."`,
+		},
+	}
+
+	for testNumber, test := range tests {
+
+		data, err := json.Marshal(test.Datum)
+		if nil != err {
+			t.Errorf("For test #%d, did not expect an error, but actually got one: (%T) %q", testNumber, err, err)
+			continue
+		}
+
+		if expected, actual := test.Expected, string(data); expected != actual {
+			t.Errorf("For test #%d, expected %s, but actually got %s.", testNumber, expected, actual)
+			continue
+		}
+
+		var actual mdl.String = mdl.SomeString("something else")
+		if err := json.Unmarshal(data, &actual); nil != err {
+			t.Errorf("For test #%d, did not expect an error, but actually got one: (%T) %q", testNumber, err, err)
+			continue
+		}
+
+		if expected := test.Datum; expected != actual {
+			t.Errorf("For test #%d, expected %#v, but actually got %#v.", testNumber, expected, actual)
+			continue
+		}
+	}
+}
+
+func TestStringJSONStruct(t *testing.T) {
+
+	type User struct {
+		GivenName  mdl.String `json:"given_name"`
+		FamilyName mdl.String `json:"family_name"`
+		Nickname   mdl.String `json:"nickname"`
+	}
+
+	expected := User{
+		GivenName:  mdl.SomeString("Joe"),
+		FamilyName: mdl.SomeString(""),
+		Nickname:   mdl.NoString(),
+	}
+
+	data, err := json.Marshal(expected)
+	if nil != err {
+		t.Errorf("Did not expect an error, but actually got one: (%T) %q", err, err)
+		return
+	}
+
+	if expected, actual := `{"given_name":"Joe","family_name":"","nickname":null}`, string(data); expected != actual {
+		t.Errorf("Expected %s, but actually got %s.", expected, actual)
+		return
+	}
+
+	var actual User
+	if err := json.Unmarshal(data, &actual); nil != err {
+		t.Errorf("Did not expect an error, but actually got one: (%T) %q", err, err)
+		return
+	}
+
+	if expected != actual {
+		t.Errorf("Expected %#v, but actually got %#v.", expected, actual)
+		return
+	}
+
+	if err := json.Unmarshal([]byte(`{"given_name":5}`), &actual); nil == err {
+		t.Errorf("Expected an error for a JSON number, but did not actually get one.")
+		return
+	}
+}
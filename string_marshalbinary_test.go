@@ -0,0 +1,57 @@
+package mdl_test
+
+import (
+	"github.com/reiver/go-mdl"
+
+	"testing"
+)
+
+func TestStringMarshalBinary(t *testing.T) {
+
+	tests := []mdl.String{
+		mdl.NoString(),
+		mdl.SomeString(""),
+		mdl.SomeString("Hello world!"),
+		mdl.SomeString("Apple banana CHERRY 🙂 “👾”."),
+	}
+
+	for testNumber, expected := range tests {
+
+		data, err := expected.MarshalBinary()
+		if nil != err {
+			t.Errorf("For test #%d, did not expect an error, but actually got one: (%T) %q", testNumber, err, err)
+			continue
+		}
+
+		var actual mdl.String = mdl.SomeString("something else")
+		if err := actual.UnmarshalBinary(data); nil != err {
+			t.Errorf("For test #%d, did not expect an error, but actually got one: (%T) %q", testNumber, err, err)
+			continue
+		}
+
+		if expected != actual {
+			t.Errorf("For test #%d, expected %#v, but actually got %#v.", testNumber, expected, actual)
+			continue
+		}
+	}
+}
+
+func TestStringUnmarshalBinaryError(t *testing.T) {
+
+	tests := [][]byte{
+		nil,
+		[]byte{},
+		[]byte{0, 'x'},
+		[]byte{2, 'x'},
+	}
+
+	for testNumber, data := range tests {
+
+		var datum mdl.String
+		if err := datum.UnmarshalBinary(data); nil == err {
+			t.Errorf("For test #%d, expected an error, but did not actually get one.", testNumber)
+			t.Logf("DATA: %#v", data)
+			continue
+		}
+	}
+}
@@ -0,0 +1,40 @@
+package mdl_test
+
+import (
+	"github.com/reiver/go-mdl"
+
+	"testing"
+)
+
+func TestStringMarshalText(t *testing.T) {
+
+	tests := []mdl.String{
+		mdl.SomeString(""),
+		mdl.SomeString("Hello world!"),
+		mdl.SomeString("Apple banana CHERRY 🙂 “👾”."),
+	}
+
+	for testNumber, expected := range tests {
+
+		text, err := expected.MarshalText()
+		if nil != err {
+			t.Errorf("For test #%d, did not expect an error, but actually got one: (%T) %q", testNumber, err, err)
+			continue
+		}
+
+		var actual mdl.String
+		if err := actual.UnmarshalText(text); nil != err {
+			t.Errorf("For test #%d, did not expect an error, but actually got one: (%T) %q", testNumber, err, err)
+			continue
+		}
+
+		if expected != actual {
+			t.Errorf("For test #%d, expected %#v, but actually got %#v.", testNumber, expected, actual)
+			continue
+		}
+	}
+
+	if _, err := mdl.NoString().MarshalText(); nil == err {
+		t.Errorf("Expected an error for mdl.NoString(), but did not actually get one.")
+	}
+}
@@ -0,0 +1,69 @@
+package mdl_test
+
+import (
+	"github.com/reiver/go-mdl"
+
+	"encoding/xml"
+	"testing"
+)
+
+func TestStringXML(t *testing.T) {
+
+	type User struct {
+		XMLName    xml.Name   `xml:"user"`
+		ID         mdl.String `xml:"id,attr"`
+		Role       mdl.String `xml:"role,attr"`
+		GivenName  mdl.String `xml:"given_name"`
+		FamilyName mdl.String `xml:"family_name"`
+		Nickname   mdl.String `xml:"nickname"`
+	}
+
+	tests := []struct{
+		User     User
+		Expected string
+	}{
+		{
+			User: User{
+				ID:         mdl.SomeString("42"),
+				Role:       mdl.NoString(),
+				GivenName:  mdl.SomeString("Joe"),
+				FamilyName: mdl.SomeString(""),
+				Nickname:   mdl.NoString(),
+			},
+			Expected: `<user id="42"><given_name>Joe</given_name><family_name></family_name></user>`,
+		},
+		{
+			User: User{
+				Role:       mdl.SomeString(""),
+				Nickname:   mdl.SomeString("<joe & co>"),
+			},
+			Expected: `<user role=""><nickname>&lt;joe &amp; co&gt;</nickname></user>`,
+		},
+	}
+
+	for testNumber, test := range tests {
+
+		data, err := xml.Marshal(test.User)
+		if nil != err {
+			t.Errorf("For test #%d, did not expect an error, but actually got one: (%T) %q", testNumber, err, err)
+			continue
+		}
+
+		if expected, actual := test.Expected, string(data); expected != actual {
+			t.Errorf("For test #%d, expected %s, but actually got %s.", testNumber, expected, actual)
+			continue
+		}
+
+		var actual User
+		if err := xml.Unmarshal(data, &actual); nil != err {
+			t.Errorf("For test #%d, did not expect an error, but actually got one: (%T) %q", testNumber, err, err)
+			continue
+		}
+		actual.XMLName = xml.Name{}
+
+		if expected := test.User; expected != actual {
+			t.Errorf("For test #%d, expected %#v, but actually got %#v.", testNumber, expected, actual)
+			continue
+		}
+	}
+}
@@ -0,0 +1,315 @@
+package mdl
+
+import (
+	"context"
+	"strings"
+	"time"
+)
+
+// SlowConsumerPolicy is what a subscription does when whatever is receiving its events is not keeping up with them;
+// i.e., when its buffer is full.
+type SlowConsumerPolicy int
+
+const (
+	// SlowConsumerWait means to stop reading from the ‘mdl.EventStore’ until there is room in the buffer again.
+	// This is the default.
+	//
+	// Nothing is lost, since the events are still in the ‘mdl.EventStore’; the subscription just falls behind, and then catches up again.
+	SlowConsumerWait SlowConsumerPolicy = iota
+
+	// SlowConsumerEnd means to end the subscription with an ‘mdl.SlowConsumer’ error, if an event cannot be put in the buffer
+	// within SubscriptionOptions.SlowConsumerTimeout (or right away, if that is zero).
+	//
+	// This is for when falling behind is worse than starting over; for example, to let something else take over.
+	SlowConsumerEnd
+)
+
+// The defaults for the SubscriptionOptions that are not set.
+const (
+	DefaultSubscriptionBatchSize    = 256
+	DefaultSubscriptionBufferSize   = 256
+	DefaultSubscriptionPollInterval = time.Second
+)
+
+// SubscriptionOptions are the options for mdl.Subscribe().
+//
+// The zero value subscribes to all events, from the very first one.
+type SubscriptionOptions struct {
+
+	// FromPosition is the Position of the first event to (maybe) deliver.
+	// To carry on after a checkpoint, which is the Position of the last event that was handled, use the checkpoint plus one.
+	FromPosition uint64
+
+	// StreamID, if it is something, only delivers events from that stream.
+	StreamID String
+
+	// StreamPrefix, if it is something, only delivers events from streams whose stream ID starts with it.
+	// For example, mdl.SomeString("user/").
+	StreamPrefix String
+
+	// Types, if it is not empty, only delivers events whose Type is one of them.
+	Types []string
+
+	// BufferSize is how many events can be waiting to be received.
+	// If it is zero (or less), then DefaultSubscriptionBufferSize is used.
+	BufferSize int
+
+	// BatchSize is how many events are read from the ‘mdl.EventStore’ at a time.
+	// If it is zero (or less), then DefaultSubscriptionBatchSize is used.
+	BatchSize int
+
+	// PollInterval is how long to wait before checking for new events again, once caught up.
+	// If it is zero (or less), then DefaultSubscriptionPollInterval is used.
+	//
+	// If the ‘mdl.EventStore’ has the ‘mdl.EventStoreNotifier’ interface, then new events are usually noticed right away,
+	// rather than after PollInterval.
+	PollInterval time.Duration
+
+	// SlowConsumer is what to do when the buffer is full.
+	SlowConsumer SlowConsumerPolicy
+
+	// SlowConsumerTimeout is how long to wait for room in the buffer before giving up, for SlowConsumerEnd.
+	//
+	// While catching up, events are read as fast as the ‘mdl.EventStore’ gives them; so with SlowConsumerEnd, this should be
+	// set to about how long handling an event is allowed to take.
+	SlowConsumerTimeout time.Duration
+}
+
+// Subscription delivers the events from an ‘mdl.EventStore’, in order of their Position. See mdl.Subscribe().
+type Subscription struct {
+	events chan *Event
+	done   chan struct{}
+	cancel context.CancelFunc
+	err    error
+}
+
+// Subscribe starts a subscription to the events in ‘store’.
+//
+// First it delivers the events already in ‘store’ (from options.FromPosition on); and then it keeps delivering new events
+// as they are appended. Since it always reads by Position, no event is skipped, and no event is delivered more than once.
+//
+// The subscription ends when ‘ctx’ is done, when Close is called, when reading from ‘store’ returns an error, or
+// (with SlowConsumerEnd) when its events are not being received fast enough. The channel returned from Events is then
+// closed; and Err says why.
+//
+// Example
+//
+//	subscription := mdl.Subscribe(ctx, store, mdl.SubscriptionOptions{
+//		FromPosition: checkpoint + 1,
+//		StreamPrefix: mdl.SomeString("user/"),
+//	})
+//	defer subscription.Close()
+//
+//	for event := range subscription.Events() {
+//		// ...
+//
+//		checkpoint = event.Position.ElseUnwrap(checkpoint)
+//	}
+//
+//	if err := subscription.Err(); nil != err && context.Canceled != err {
+//		return err
+//	}
+func Subscribe(ctx context.Context, store EventStore, options SubscriptionOptions) *Subscription {
+	if nil == ctx {
+		ctx = context.Background()
+	}
+
+	bufferSize := options.BufferSize
+	if bufferSize <= 0 {
+		bufferSize = DefaultSubscriptionBufferSize
+	}
+
+	ctx, cancel := context.WithCancel(ctx)
+
+	subscription := &Subscription{
+		events:make(chan *Event, bufferSize),
+		done:make(chan struct{}),
+		cancel:cancel,
+	}
+
+	go subscription.run(ctx, store, options)
+
+	return subscription
+}
+
+// Close ends the subscription, and waits for it to stop reading from the ‘mdl.EventStore’.
+func (receiver *Subscription) Close() {
+	if nil == receiver {
+		return
+	}
+
+	receiver.cancel()
+	<-receiver.done
+}
+
+// Err returns why the subscription ended; or nil if it has not ended yet.
+//
+// If it ended because its context was done, or because Close was called, then Err returns the error from the context.
+func (receiver *Subscription) Err() error {
+	if nil == receiver {
+		return errNilReceiver
+	}
+
+	select {
+	case <-receiver.done:
+		return receiver.err
+	default:
+		return nil
+	}
+}
+
+// Events returns the channel the events are delivered on. It is closed when the subscription ends.
+func (receiver *Subscription) Events() <-chan *Event {
+	if nil == receiver {
+		return nil
+	}
+
+	return receiver.events
+}
+
+// deliver puts ‘event’ in the buffer, following the slow-consumer policy.
+func (receiver *Subscription) deliver(ctx context.Context, event *Event, position uint64, options SubscriptionOptions) error {
+	if SlowConsumerEnd != options.SlowConsumer {
+		select {
+		case receiver.events <- event:
+			return nil
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+	}
+
+	if options.SlowConsumerTimeout <= 0 {
+		select {
+		case receiver.events <- event:
+			return nil
+		case <-ctx.Done():
+			return ctx.Err()
+		default:
+			return internalSlowConsumer{position:position}
+		}
+	}
+
+	timer := time.NewTimer(options.SlowConsumerTimeout)
+	defer timer.Stop()
+
+	select {
+	case receiver.events <- event:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-timer.C:
+		return internalSlowConsumer{position:position}
+	}
+}
+
+// follow reads from ‘store’ (catching up, and then polling or waiting to be notified), and delivers the events until something goes wrong.
+func (receiver *Subscription) follow(ctx context.Context, store EventStore, options SubscriptionOptions) error {
+	if nil == store {
+		return errNilEventStore
+	}
+
+	batchSize := options.BatchSize
+	if batchSize <= 0 {
+		batchSize = DefaultSubscriptionBatchSize
+	}
+
+	pollInterval := options.PollInterval
+	if pollInterval <= 0 {
+		pollInterval = DefaultSubscriptionPollInterval
+	}
+
+	notifier, _ := store.(EventStoreNotifier)
+
+	position := options.FromPosition
+	if 0 == position {
+		position = 1
+	}
+
+	for {
+		if err := ctx.Err(); nil != err {
+			return err
+		}
+
+		// This is done before reading; so that anything appended after the read is sure to close it.
+		var appended <-chan struct{}
+		if nil != notifier {
+			appended = notifier.Notify()
+		}
+
+		events, err := store.ReadAll(position, batchSize)
+		if nil != err {
+			return err
+		}
+
+		for _, event := range events {
+			eventPosition, something := event.Position.Unwrap()
+			if !something || eventPosition < position {
+				// Already delivered (or skipped).
+				continue
+			}
+
+			if subscriptionMatches(event, options) {
+				if err := receiver.deliver(ctx, event, eventPosition, options); nil != err {
+					return err
+				}
+			}
+
+			position = eventPosition + 1
+		}
+
+		if batchSize <= len(events) {
+			// Still catching up.
+			continue
+		}
+
+		timer := time.NewTimer(pollInterval)
+		select {
+		case <-ctx.Done():
+		case <-appended:
+		case <-timer.C:
+		}
+		timer.Stop()
+	}
+}
+
+func (receiver *Subscription) run(ctx context.Context, store EventStore, options SubscriptionOptions) {
+	// (Deferred functions are called in reverse order; so ‘done’ is closed before ‘events’, and Err has the error by the time a range over Events ends.)
+	defer close(receiver.events)
+	defer close(receiver.done)
+	defer receiver.cancel()
+
+	receiver.err = receiver.follow(ctx, store, options)
+}
+
+// subscriptionMatches returns whether ‘event’ passes the filters in ‘options’.
+func subscriptionMatches(event *Event, options SubscriptionOptions) bool {
+	streamID := event.StreamID.ElseUnwrap("")
+
+	if expected, something := options.StreamID.Unwrap(); something && expected != streamID {
+		return false
+	}
+
+	if prefix, something := options.StreamPrefix.Unwrap(); something && !strings.HasPrefix(streamID, prefix) {
+		return false
+	}
+
+	if 0 < len(options.Types) {
+		eventType, something := event.Type.Unwrap()
+		if !something {
+			return false
+		}
+
+		var found bool
+		for _, t := range options.Types {
+			if t == eventType {
+				found = true
+				break
+			}
+		}
+		if !found {
+			return false
+		}
+	}
+
+	return true
+}
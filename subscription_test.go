@@ -0,0 +1,351 @@
+package mdl_test
+
+import (
+	"github.com/reiver/go-mdl"
+	"github.com/reiver/go-mdl/mdltest"
+
+	"context"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"sync"
+	"time"
+
+	"testing"
+)
+
+// subscriptionReceive receives ‘count’ events from ‘subscription’; or fails the test if that takes too long.
+func subscriptionReceive(t *testing.T, subscription *mdl.Subscription, count int) []*mdl.Event {
+	var events []*mdl.Event
+
+	timeout := time.After(10 * time.Second)
+
+	for len(events) < count {
+		select {
+		case event, ok := <-subscription.Events():
+			if !ok {
+				t.Fatalf("Expected %d events, but the subscription ended after %d: %v", count, len(events), subscription.Err())
+			}
+			events = append(events, event)
+		case <-timeout:
+			t.Fatalf("Expected %d events, but only got %d before timing out.", count, len(events))
+		}
+	}
+
+	return events
+}
+
+// subscriptionPollingStore hides the mdl.EventStoreNotifier interface of an mdl.EventStore; so subscriptions have to poll it.
+type subscriptionPollingStore struct {
+	mdl.EventStore
+}
+
+func TestSubscribeCatchUpThenLive(t *testing.T) {
+
+	dir, err := ioutil.TempDir("", "mdl-events-")
+	if nil != err {
+		t.Fatalf("Did not expect an error, but actually got one: (%T) %q", err, err)
+	}
+	defer os.RemoveAll(dir)
+
+	fileStore := &mdl.FileEventStore{Dir:dir, Sync:mdl.FileEventStoreSyncNever}
+	defer fileStore.Close()
+
+	tests := []struct{
+		Name  string
+		Store mdl.EventStore
+	}{
+		{"Memory", &mdl.MemoryEventStore{}},
+		{"File", fileStore},
+		{"SQL", sqlEventStoreFixture(t, mdl.SQLDialectSQLite, "")},
+		{"Polling", subscriptionPollingStore{&mdl.MemoryEventStore{}}},
+	}
+
+	for _, test := range tests {
+		test := test
+
+		t.Run(test.Name, func(t *testing.T) {
+			store := test.Store
+
+			for i := 0; i < 5; i++ {
+				if _, err := store.Append("a", mdl.NoUint64(), mdltest.NewEvent(fmt.Sprintf("E%d", i+1))); nil != err {
+					t.Fatalf("Did not expect an error from .Append(), but actually got one: (%T) %q", err, err)
+				}
+			}
+
+			// A small batch size, so that catching up takes more than one read.
+			subscription := mdl.Subscribe(context.Background(), store, mdl.SubscriptionOptions{
+				BatchSize: 2,
+				PollInterval: 10 * time.Millisecond,
+			})
+			defer subscription.Close()
+
+			events := subscriptionReceive(t, subscription, 5)
+
+			for i := 5; i < 8; i++ {
+				if _, err := store.Append("b", mdl.NoUint64(), mdltest.NewEvent(fmt.Sprintf("E%d", i+1))); nil != err {
+					t.Fatalf("Did not expect an error from .Append(), but actually got one: (%T) %q", err, err)
+				}
+			}
+
+			events = append(events, subscriptionReceive(t, subscription, 3)...)
+
+			for i, event := range events {
+				if expected, actual := mdl.SomeUint64(uint64(i+1)), event.Position; expected != actual {
+					t.Errorf("For event #%d, expected Position %#v, but actually got %#v.", i, expected, actual)
+				}
+				if expected, actual := mdl.SomeString(fmt.Sprintf("E%d", i+1)), event.Type; expected != actual {
+					t.Errorf("For event #%d, expected Type %#v, but actually got %#v.", i, expected, actual)
+				}
+			}
+
+			subscription.Close()
+
+			if _, ok := <-subscription.Events(); ok {
+				t.Errorf("Expected no more events after .Close().")
+			}
+			if expected, actual := context.Canceled, subscription.Err(); expected != actual {
+				t.Errorf("Expected the error to be %q, but actually got %v.", expected, actual)
+			}
+		})
+	}
+}
+
+func TestSubscribeNoGapsOrDuplicates(t *testing.T) {
+
+	var store mdl.MemoryEventStore
+
+	const writers = 4
+	const count = 100
+
+	// Some events are already there, and some are appended while the subscription is catching up.
+	for i := 0; i < count; i++ {
+		if _, err := store.Append("before", mdl.NoUint64(), mdltest.NewEvent("E")); nil != err {
+			t.Fatalf("Did not expect an error from .Append(), but actually got one: (%T) %q", err, err)
+		}
+	}
+
+	subscription := mdl.Subscribe(context.Background(), &store, mdl.SubscriptionOptions{
+		BatchSize: 7,
+		BufferSize: 3,
+	})
+	defer subscription.Close()
+
+	var waitgroup sync.WaitGroup
+	for w := 0; w < writers; w++ {
+		waitgroup.Add(1)
+
+		go func(w int) {
+			defer waitgroup.Done()
+
+			for i := 0; i < count; i++ {
+				if _, err := store.Append(fmt.Sprintf("writer/%d", w), mdl.NoUint64(), mdltest.NewEvent("E"), mdltest.NewEvent("E")); nil != err {
+					t.Errorf("Did not expect an error from .Append(), but actually got one: (%T) %q", err, err)
+					return
+				}
+			}
+		}(w)
+	}
+
+	events := subscriptionReceive(t, subscription, count+writers*count*2)
+	waitgroup.Wait()
+
+	for i, event := range events {
+		if expected, actual := mdl.SomeUint64(uint64(i+1)), event.Position; expected != actual {
+			t.Fatalf("For event #%d, expected Position %#v, but actually got %#v.", i, expected, actual)
+		}
+	}
+
+	select {
+	case event := <-subscription.Events():
+		t.Fatalf("Did not expect any more events, but actually got %#v.", event)
+	case <-time.After(20 * time.Millisecond):
+	}
+}
+
+func TestSubscribeFilter(t *testing.T) {
+
+	appends := []struct{
+		StreamID  string
+		EventType string
+	}{
+		{"user/1",    "REGISTERED"},
+		{"user/2",    "REGISTERED"},
+		{"order/1",   "PLACED"},
+		{"user/1",    "EMAIL_RECORDED"},
+		{"user/10",   "REGISTERED"},
+		{"order/1",   "SHIPPED"},
+		{"user/1",    "DEACTIVATED"},
+	}
+
+	tests := []struct{
+		Options  mdl.SubscriptionOptions
+		Expected []uint64
+	}{
+		{
+			Options:  mdl.SubscriptionOptions{},
+			Expected: []uint64{1, 2, 3, 4, 5, 6, 7},
+		},
+		{
+			Options:  mdl.SubscriptionOptions{FromPosition:4},
+			Expected: []uint64{4, 5, 6, 7},
+		},
+		{
+			Options:  mdl.SubscriptionOptions{StreamID:mdl.SomeString("user/1")},
+			Expected: []uint64{1, 4, 7},
+		},
+		{
+			Options:  mdl.SubscriptionOptions{StreamPrefix:mdl.SomeString("user/")},
+			Expected: []uint64{1, 2, 4, 5, 7},
+		},
+		{
+			Options:  mdl.SubscriptionOptions{StreamPrefix:mdl.SomeString("user/1")},
+			Expected: []uint64{1, 4, 5, 7},
+		},
+		{
+			Options:  mdl.SubscriptionOptions{Types:[]string{"REGISTERED", "SHIPPED"}},
+			Expected: []uint64{1, 2, 5, 6},
+		},
+		{
+			Options:  mdl.SubscriptionOptions{StreamPrefix:mdl.SomeString("user/"), Types:[]string{"REGISTERED"}, FromPosition:2},
+			Expected: []uint64{2, 5},
+		},
+	}
+
+	for testNumber, test := range tests {
+
+		func() {
+			var store mdl.MemoryEventStore
+			for _, a := range appends {
+				if _, err := store.Append(a.StreamID, mdl.NoUint64(), mdltest.NewEvent(a.EventType)); nil != err {
+					t.Fatalf("Did not expect an error from .Append(), but actually got one: (%T) %q", err, err)
+				}
+			}
+
+			subscription := mdl.Subscribe(context.Background(), &store, test.Options)
+			defer subscription.Close()
+
+			events := subscriptionReceive(t, subscription, len(test.Expected))
+
+			var actual []uint64
+			for _, event := range events {
+				actual = append(actual, event.Position.ElseUnwrap(0))
+			}
+
+			if expected := test.Expected; fmt.Sprint(expected) != fmt.Sprint(actual) {
+				t.Errorf("For test #%d, expected positions %v, but actually got %v.", testNumber, expected, actual)
+				return
+			}
+
+			// The next event to match is delivered next; so nothing was left out in between.
+			if _, err := store.Append("user/1", mdl.NoUint64(), mdltest.NewEvent("REGISTERED")); nil != err {
+				t.Fatalf("Did not expect an error from .Append(), but actually got one: (%T) %q", err, err)
+			}
+			event := subscriptionReceive(t, subscription, 1)[0]
+			if expected, actual := uint64(len(appends)+1), event.Position.ElseUnwrap(0); expected != actual {
+				t.Errorf("For test #%d, expected the live event to be at position %d, but actually got %d.", testNumber, expected, actual)
+			}
+		}()
+	}
+}
+
+func TestSubscribeContextCancel(t *testing.T) {
+
+	var store mdl.MemoryEventStore
+
+	ctx, cancel := context.WithCancel(context.Background())
+
+	subscription := mdl.Subscribe(ctx, &store, mdl.SubscriptionOptions{})
+	defer subscription.Close()
+
+	if nil != subscription.Err() {
+		t.Fatalf("Did not expect an error before the subscription ended, but actually got one: %q", subscription.Err())
+	}
+
+	cancel()
+
+	select {
+	case _, ok := <-subscription.Events():
+		if ok {
+			t.Fatalf("Did not expect an event.")
+		}
+	case <-time.After(10 * time.Second):
+		t.Fatalf("Expected the subscription to end when its context was canceled, but it did not.")
+	}
+
+	if expected, actual := context.Canceled, subscription.Err(); expected != actual {
+		t.Errorf("Expected the error to be %q, but actually got %v.", expected, actual)
+	}
+}
+
+func TestSubscribeSlowConsumer(t *testing.T) {
+
+	var store mdl.MemoryEventStore
+
+	for i := 0; i < 10; i++ {
+		if _, err := store.Append("a", mdl.NoUint64(), mdltest.NewEvent(fmt.Sprintf("E%d", i+1))); nil != err {
+			t.Fatalf("Did not expect an error from .Append(), but actually got one: (%T) %q", err, err)
+		}
+	}
+
+	options := mdl.SubscriptionOptions{
+		BufferSize: 3,
+		SlowConsumer: mdl.SlowConsumerEnd,
+		SlowConsumerTimeout: 10 * time.Millisecond,
+	}
+
+	subscription := mdl.Subscribe(context.Background(), &store, options)
+	defer subscription.Close()
+
+	// Nothing is received until the subscription gives up; by which time the buffer is full.
+	deadline := time.Now().Add(10 * time.Second)
+	for nil == subscription.Err() {
+		if time.Now().After(deadline) {
+			t.Fatalf("Expected the subscription to end, but it did not.")
+		}
+		time.Sleep(time.Millisecond)
+	}
+
+	slowConsumer, casted := subscription.Err().(mdl.SlowConsumer)
+	if !casted {
+		t.Fatalf("Expected an mdl.SlowConsumer error, but actually got: (%T) %v", subscription.Err(), subscription.Err())
+	}
+	if expected, actual := uint64(4), slowConsumer.Position(); expected != actual {
+		t.Fatalf("Expected the slow-consumer position to be %d, but actually got %d.", expected, actual)
+	}
+
+	// What was in the buffer can still be received.
+	var events []*mdl.Event
+	for event := range subscription.Events() {
+		events = append(events, event)
+	}
+	if expected, actual := 3, len(events); expected != actual {
+		t.Fatalf("Expected %d events in the buffer, but actually got %d.", expected, actual)
+	}
+
+	// Starting again from where it gave up misses nothing.
+	options.FromPosition = slowConsumer.Position()
+	options.SlowConsumer = mdl.SlowConsumerWait
+
+	resumed := mdl.Subscribe(context.Background(), &store, options)
+	defer resumed.Close()
+
+	events = append(events, subscriptionReceive(t, resumed, 7)...)
+	for i, event := range events {
+		if expected, actual := mdl.SomeUint64(uint64(i+1)), event.Position; expected != actual {
+			t.Errorf("For event #%d, expected Position %#v, but actually got %#v.", i, expected, actual)
+		}
+	}
+}
+
+func TestSubscribeNilStore(t *testing.T) {
+
+	subscription := mdl.Subscribe(context.Background(), nil, mdl.SubscriptionOptions{})
+
+	if _, ok := <-subscription.Events(); ok {
+		t.Fatalf("Did not expect an event.")
+	}
+	if nil == subscription.Err() {
+		t.Fatalf("Expected an error, but did not actually get one.")
+	}
+}
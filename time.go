@@ -0,0 +1,251 @@
+package mdl
+
+import (
+	"database/sql/driver"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+)
+
+// Time is a time.Time option type.
+//
+// It can have 2 kinds of value:
+//
+// • no time.Time (i.e., ‘nothing’),
+//
+// • some time.Time (i.e., ‘something’).
+//
+// Like with ‘mdl.String’, the value in this type is that it can differentiate between being assigned the zero time (time.Time{}),
+// and a variable that hasn't been loaded.
+type Time struct {
+	datum  time.Time
+	loaded bool
+}
+
+// NoTime returns a mdl.Time which has no value (i.e., ‘nothing’).
+//
+// Note that this is not the same thing as the zero time (time.Time{})!
+// That is mdl.SomeTime(time.Time{}).
+//
+// Example
+//
+//	var datum mdl.Time
+//	
+//	// ...
+//	
+//	if mdl.NoTime() == datum {
+//		//@TODO
+//	}
+func NoTime() Time {
+	return Time{}
+}
+
+// SomeTime returns a mdl.Time which has some value (i.e., ‘something’).
+//
+// Example
+//
+//	var datum mdl.Time
+//	
+//	// ...
+//	
+//	datum = mdl.SomeTime(time.Date(2015, 5, 7, 10, 25, 9, 0, time.UTC))
+func SomeTime(datum time.Time) Time {
+	return Time{
+		loaded: true,
+		datum:  datum,
+	}
+}
+
+func (receiver Time) Else(datum time.Time) Time {
+	if NoTime() != receiver {
+		return receiver
+	}
+
+	return SomeTime(datum)
+}
+
+func (receiver Time) ElseUnwrap(datum time.Time) time.Time {
+	if NoTime() == receiver {
+		return datum
+	}
+
+	return receiver.datum
+}
+
+// Format makes ‘mdl.Time’ fit the ‘fmt.Formatter’ interface.
+//
+// The %s, and %v verbs format the value the same way they would format a time.Time.
+// The %q verb formats its text form (see .MarshalText()) as a quoted string, and the %#v verb formats it with .GoString().
+func (receiver Time) Format(f fmt.State, c rune) {
+	switch {
+	case 'v' == c && f.Flag('#'):
+		fmt.Fprint(f, receiver.GoString())
+	case NoTime() == receiver && 'q' == c:
+		fmt.Fprint(f, "«no-time»")
+	case NoTime() == receiver:
+		fmt.Fprintf(f, "%%!%s(%s)", string(c), receiver.GoString())
+	case 'q' == c:
+		fmt.Fprintf(f, "%q", receiver.datum.Format(time.RFC3339Nano))
+	case strings.ContainsRune("sv", c):
+		fmt.Fprintf(f, optionFormatDirective(f, c), receiver.datum)
+	default:
+		fmt.Fprintf(f, "%%!%s(%s)", string(c), receiver.GoString())
+	}
+}
+
+// GoString makes mdl.Time fit the fmt.GoStringer interface.
+//
+// It gets used with the %#v verb with the printing family of functions
+// in the Go built-in "fmt" package.
+func (receiver Time) GoString() string {
+	if NoTime() == receiver {
+		return "mdl.NoTime()"
+	}
+
+	return fmt.Sprintf("mdl.SomeTime(%#v)", receiver.datum)
+}
+
+// MarshalJSON makes mdl.Time fit the encoding/json.Marshaler interface.
+//
+// mdl.NoTime() is marshaled as JSON null, and mdl.SomeTime() is marshaled as a JSON string in RFC 3339 format.
+func (receiver Time) MarshalJSON() ([]byte, error) {
+	if NoTime() == receiver {
+		return []byte("null"), nil
+	}
+
+	return json.Marshal(receiver.datum.Format(time.RFC3339Nano))
+}
+
+// MarshalText makes mdl.Time fit the encoding.TextMarshaler interface.
+//
+// mdl.SomeTime() is marshaled as RFC 3339 (ex: 2015-05-07T10:25:09Z).
+// Since text has no way of saying ‘nothing’, mdl.NoTime() cannot be marshaled as text, and returns an error.
+func (receiver Time) MarshalText() ([]byte, error) {
+	if NoTime() == receiver {
+		return nil, errNotLoaded
+	}
+
+	return []byte(receiver.datum.Format(time.RFC3339Nano)), nil
+}
+
+func (receiver Time) Map(fn func(time.Time)time.Time) Time {
+	if NoTime() == receiver {
+		return receiver
+	}
+
+	return SomeTime(fn(receiver.datum))
+}
+
+// Scan makes mdl.Time fit the database/sql.Scanner interface.
+//
+// A nil source (i.e., SQL NULL) scans as mdl.NoTime().
+// The source can be a time.Time, a string (in RFC 3339 format), a []byte (in RFC 3339 format), or an ‘mdl.Time’.
+func (receiver *Time) Scan(src interface{}) error {
+	if nil == receiver {
+		return errNilReceiver
+	}
+
+	switch casted := src.(type) {
+	case nil:
+		*receiver = NoTime()
+		return nil
+	case Time:
+		*receiver = casted
+		return nil
+	case string:
+		return receiver.scanText(casted)
+	case []byte:
+		if nil == casted {
+			*receiver = NoTime()
+			return nil
+		}
+		return receiver.scanText(string(casted))
+	case time.Time:
+		*receiver = SomeTime(casted)
+		return nil
+	default:
+		return unsupportedSource(src)
+	}
+}
+
+// String returns the text form of the value (see .MarshalText()).
+func (receiver Time) String() (string, error) {
+	if NoTime() == receiver {
+		return "", errNotLoaded
+	}
+
+	return receiver.datum.Format(time.RFC3339Nano), nil
+}
+
+func (receiver Time) Then(fn func(time.Time)Time) Time {
+	if NoTime() == receiver {
+		return receiver
+	}
+
+	return fn(receiver.datum)
+}
+
+// UnmarshalJSON makes mdl.Time fit the encoding/json.Unmarshaler interface.
+//
+// It is the opposite of .MarshalJSON(); i.e., JSON null is unmarshaled as mdl.NoTime().
+func (receiver *Time) UnmarshalJSON(data []byte) error {
+	if nil == receiver {
+		return errNilReceiver
+	}
+
+	if "null" == string(data) {
+		*receiver = NoTime()
+		return nil
+	}
+
+	var text string
+	if err := json.Unmarshal(data, &text); nil != err {
+		return err
+	}
+
+	return receiver.scanText(text)
+}
+
+// UnmarshalText makes mdl.Time fit the encoding.TextUnmarshaler interface.
+//
+// It is the opposite of .MarshalText(). (A nil text is unmarshaled as mdl.NoTime().)
+func (receiver *Time) UnmarshalText(text []byte) error {
+	if nil == receiver {
+		return errNilReceiver
+	}
+
+	if nil == text {
+		*receiver = NoTime()
+		return nil
+	}
+
+	return receiver.scanText(string(text))
+}
+
+func (receiver Time) Unwrap() (time.Time, bool) {
+	return receiver.datum, receiver.loaded
+}
+
+// Value makes mdl.Time fit the database/sql/driver.Valuer interface.
+func (receiver Time) Value() (driver.Value, error) {
+	if NoTime() == receiver {
+		return nil, errNotLoaded
+	}
+
+	return receiver.datum, nil
+}
+
+func (receiver Time) isNothing() bool {
+	return NoTime() == receiver
+}
+
+func (receiver *Time) scanText(text string) error {
+	datum, err := time.Parse(time.RFC3339Nano, text)
+	if nil != err {
+		return err
+	}
+
+	*receiver = SomeTime(datum)
+	return nil
+}
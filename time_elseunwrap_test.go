@@ -0,0 +1,55 @@
+package mdl_test
+
+import (
+	"github.com/reiver/go-mdl"
+
+	"testing"
+	"time"
+)
+
+func TestTimeElseUnwrap(t *testing.T) {
+
+	tests := []struct{
+		Value    mdl.Time
+		Else     time.Time
+		Expected time.Time
+	}{
+		{
+			Value:    mdl.NoTime(),
+			Else:                  time.Date(1985, 10, 26, 1, 21, 0, 500, time.UTC),
+			Expected:              time.Date(1985, 10, 26, 1, 21, 0, 500, time.UTC),
+		},
+		{
+			Value:    mdl.NoTime(),
+			Else:                  time.Time{},
+			Expected:              time.Time{},
+		},
+
+
+
+		{
+			Value:    mdl.SomeTime(time.Time{}),
+			Else:                  time.Date(1985, 10, 26, 1, 21, 0, 500, time.UTC),
+			Expected:              time.Time{},
+		},
+		{
+			Value:    mdl.SomeTime(time.Date(2015, 5, 7, 10, 25, 9, 0, time.UTC)),
+			Else:                  time.Date(1985, 10, 26, 1, 21, 0, 500, time.UTC),
+			Expected:              time.Date(2015, 5, 7, 10, 25, 9, 0, time.UTC),
+		},
+	}
+
+	for testNumber, test := range tests {
+
+		actual := test.Value.ElseUnwrap(test.Else)
+
+		if expected := test.Expected; !expected.Equal(actual) {
+			t.Errorf("For test #%d ...", testNumber)
+			t.Errorf("\tVALUE: %#v", test.Value)
+			t.Errorf("\tELSE:               %v", test.Else)
+			t.Errorf("\tEXPECTED:           %v", expected)
+			t.Errorf("\tACTUAL:             %v", actual)
+			continue
+		}
+	}
+}
@@ -0,0 +1,75 @@
+package mdl_test
+
+import (
+	"github.com/reiver/go-mdl"
+
+	"fmt"
+	"time"
+
+	"testing"
+)
+
+func TestTimeFormat(t *testing.T) {
+
+	tests := []struct{
+		Format   string
+		Time     mdl.Time
+		Expected string
+	}{
+		{
+			Format: "%q",
+			Time:   mdl.NoTime(),
+			Expected:      `«no-time»`,
+		},
+		{
+			Format: "%q",
+			Time:   mdl.SomeTime(time.Date(2015, 5, 7, 10, 25, 9, 0, time.UTC)),
+			Expected:      `"2015-05-07T10:25:09Z"`,
+		},
+		{
+			Format: "%q",
+			Time:   mdl.SomeTime(time.Date(1985, 10, 26, 1, 21, 0, 500, time.UTC)),
+			Expected:      `"1985-10-26T01:21:00.0000005Z"`,
+		},
+
+
+
+		{
+			Format: "%s",
+			Time:   mdl.SomeTime(time.Date(2015, 5, 7, 10, 25, 9, 0, time.UTC)),
+			Expected:      `2015-05-07 10:25:09 +0000 UTC`,
+		},
+		{
+			Format: "%v",
+			Time:   mdl.SomeTime(time.Date(2015, 5, 7, 10, 25, 9, 0, time.UTC)),
+			Expected:      `2015-05-07 10:25:09 +0000 UTC`,
+		},
+
+
+
+		{
+			Format: "%s",
+			Time:   mdl.NoTime(),
+			Expected:      `%!s(mdl.NoTime())`,
+		},
+		{
+			Format: "%d",
+			Time:   mdl.SomeTime(time.Time{}),
+			Expected:      fmt.Sprintf(`%%!d(mdl.SomeTime(%#v))`, time.Time{}),
+		},
+	}
+
+	for testNumber, test := range tests {
+
+		actual := fmt.Sprintf(test.Format, test.Time)
+		if expected := test.Expected; expected != actual {
+			t.Errorf("For test #%d, for print verb, did not actually get what was expected.", testNumber)
+			t.Logf("\tEXPECTED: %q", expected)
+			t.Logf("\tACTUAL:   %q", actual)
+			t.Logf("\tFormat: %q", test.Format)
+			t.Logf("\tTime: %#v", test.Time)
+			continue
+		}
+
+	}
+}
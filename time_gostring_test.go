@@ -0,0 +1,42 @@
+package mdl_test
+
+import (
+	"github.com/reiver/go-mdl"
+
+	"fmt"
+	"testing"
+	"time"
+)
+
+func TestTimeGoString(t *testing.T) {
+
+	tests := []struct{
+		Value    mdl.Time
+		Expected string
+	}{
+		{
+			Value:    mdl.NoTime(),
+			Expected: "mdl.NoTime()",
+		},
+		{
+			Value:    mdl.SomeTime(time.Date(2015, 5, 7, 10, 25, 9, 0, time.UTC)),
+			Expected: fmt.Sprintf("mdl.SomeTime(%#v)", time.Date(2015, 5, 7, 10, 25, 9, 0, time.UTC)),
+		},
+		{
+			Value:    mdl.SomeTime(time.Time{}),
+			Expected: fmt.Sprintf("mdl.SomeTime(%#v)", time.Time{}),
+		},
+	}
+
+	for testNumber, test := range tests {
+
+		actual := test.Value.GoString()
+
+		if expected := test.Expected; expected != actual {
+			t.Errorf("For test #%d ...", testNumber)
+			t.Errorf("\tEXPECTED: %#v", expected)
+			t.Errorf("\tACTUAL:   %#v", actual)
+			continue
+		}
+	}
+}
@@ -0,0 +1,72 @@
+package mdl_test
+
+import (
+	"github.com/reiver/go-mdl"
+
+	"encoding/json"
+	"testing"
+	"time"
+)
+
+func TestTimeJSON(t *testing.T) {
+
+	tests := []struct{
+		Datum    mdl.Time
+		Expected string
+	}{
+		{
+			Datum:    mdl.NoTime(),
+			Expected: `null`,
+		},
+		{
+			Datum:    mdl.SomeTime(time.Time{}),
+			Expected: `"0001-01-01T00:00:00Z"`,
+		},
+		{
+			Datum:    mdl.SomeTime(time.Date(2015, 5, 7, 10, 25, 9, 0, time.UTC)),
+			Expected: `"2015-05-07T10:25:09Z"`,
+		},
+		{
+			Datum:    mdl.SomeTime(time.Date(1985, 10, 26, 1, 21, 0, 500, time.UTC)),
+			Expected: `"1985-10-26T01:21:00.0000005Z"`,
+		},
+	}
+
+	for testNumber, test := range tests {
+
+		data, err := json.Marshal(test.Datum)
+		if nil != err {
+			t.Errorf("For test #%d, did not expect an error, but actually got one: (%T) %q", testNumber, err, err)
+			continue
+		}
+
+		if expected, actual := test.Expected, string(data); expected != actual {
+			t.Errorf("For test #%d, expected %s, but actually got %s.", testNumber, expected, actual)
+			continue
+		}
+
+		var actual mdl.Time = mdl.SomeTime(time.Date(2000, 1, 1, 0, 0, 0, 0, time.UTC))
+		if err := json.Unmarshal(data, &actual); nil != err {
+			t.Errorf("For test #%d, did not expect an error, but actually got one: (%T) %q", testNumber, err, err)
+			continue
+		}
+
+		if expected := test.Datum; expected != actual {
+			t.Errorf("For test #%d, expected %#v, but actually got %#v.", testNumber, expected, actual)
+			continue
+		}
+	}
+}
+
+func TestTimeJSONError(t *testing.T) {
+
+	for testNumber, data := range []string{ `5`, `"yesterday"`, `""`, `true` } {
+
+		var actual mdl.Time
+		if err := json.Unmarshal([]byte(data), &actual); nil == err {
+			t.Errorf("For test #%d, expected an error for %s, but did not actually get one.", testNumber, data)
+			t.Errorf("\tACTUAL: %#v", actual)
+			continue
+		}
+	}
+}
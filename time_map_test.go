@@ -0,0 +1,50 @@
+package mdl_test
+
+import (
+	"github.com/reiver/go-mdl"
+
+	"testing"
+	"time"
+)
+
+func TestTimeMap(t *testing.T) {
+
+	nextDay := func(datum time.Time) time.Time {
+		return datum.AddDate(0, 0, 1)
+	}
+
+	tests := []struct{
+		Value    mdl.Time
+		Func     func(time.Time)time.Time
+		Expected mdl.Time
+	}{
+		{
+			Value:    mdl.NoTime(),
+			Func:     nextDay,
+			Expected: mdl.NoTime(),
+		},
+		{
+			Value:    mdl.SomeTime(time.Date(2015, 5, 7, 10, 25, 9, 0, time.UTC)),
+			Func:     nextDay,
+			Expected: mdl.SomeTime(time.Date(2015, 5, 8, 10, 25, 9, 0, time.UTC)),
+		},
+		{
+			Value:    mdl.SomeTime(time.Date(1985, 10, 26, 1, 21, 0, 500, time.UTC)),
+			Func:     nextDay,
+			Expected: mdl.SomeTime(time.Date(1985, 10, 27, 1, 21, 0, 500, time.UTC)),
+		},
+	}
+
+	for testNumber, test := range tests {
+
+		actual := test.Value.Map(test.Func)
+
+		if expected := test.Expected; expected != actual {
+			t.Errorf("For test #%d ...", testNumber)
+			t.Errorf("\tVALUE:    %#v", test.Value)
+			t.Errorf("\tEXPECTED: %#v", expected)
+			t.Errorf("\tACTUAL:   %#v", actual)
+			continue
+		}
+	}
+}
@@ -0,0 +1,47 @@
+package mdl_test
+
+import (
+	"github.com/reiver/go-mdl"
+
+	"testing"
+	"time"
+)
+
+func TestTimeMarshalText(t *testing.T) {
+
+	tests := []struct{
+		Value    mdl.Time
+		Expected string
+	}{
+		{
+			Value:    mdl.SomeTime(time.Time{}),
+			Expected: "0001-01-01T00:00:00Z",
+		},
+		{
+			Value:    mdl.SomeTime(time.Date(2015, 5, 7, 10, 25, 9, 0, time.UTC)),
+			Expected: "2015-05-07T10:25:09Z",
+		},
+		{
+			Value:    mdl.SomeTime(time.Date(1985, 10, 26, 1, 21, 0, 500, time.UTC)),
+			Expected: "1985-10-26T01:21:00.0000005Z",
+		},
+	}
+
+	for testNumber, test := range tests {
+
+		text, err := test.Value.MarshalText()
+		if nil != err {
+			t.Errorf("For test #%d, did not expect an error, but actually got one: (%T) %q", testNumber, err, err)
+			continue
+		}
+
+		if expected, actual := test.Expected, string(text); expected != actual {
+			t.Errorf("For test #%d, expected %q, but actually got %q.", testNumber, expected, actual)
+			continue
+		}
+	}
+
+	if _, err := mdl.NoTime().MarshalText(); nil == err {
+		t.Errorf("Expected an error for mdl.NoTime(), but did not actually get one.")
+	}
+}
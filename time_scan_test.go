@@ -0,0 +1,104 @@
+package mdl_test
+
+import (
+	"github.com/reiver/go-mdl"
+
+	"testing"
+	"time"
+)
+
+func TestTimeScan(t *testing.T) {
+
+	tests := []struct{
+		Datum    interface{}
+		Expected mdl.Time
+	}{
+		{
+			Datum:    nil,
+			Expected: mdl.NoTime(),
+		},
+		{
+			Datum:    []byte(nil),
+			Expected: mdl.NoTime(),
+		},
+
+
+
+		{
+			Datum:    mdl.NoTime(),
+			Expected: mdl.NoTime(),
+		},
+		{
+			Datum:    mdl.SomeTime(time.Date(2015, 5, 7, 10, 25, 9, 0, time.UTC)),
+			Expected: mdl.SomeTime(time.Date(2015, 5, 7, 10, 25, 9, 0, time.UTC)),
+		},
+
+
+
+		{
+			Datum:             "2015-05-07T10:25:09Z",
+			Expected: mdl.SomeTime(time.Date(2015, 5, 7, 10, 25, 9, 0, time.UTC)),
+		},
+		{
+			Datum:      []byte("2015-05-07T10:25:09Z"),
+			Expected: mdl.SomeTime(time.Date(2015, 5, 7, 10, 25, 9, 0, time.UTC)),
+		},
+		{
+			Datum:             "1985-10-26T01:21:00.0000005Z",
+			Expected: mdl.SomeTime(time.Date(1985, 10, 26, 1, 21, 0, 500, time.UTC)),
+		},
+
+
+
+		{
+			Datum:             time.Date(2015, 5, 7, 10, 25, 9, 0, time.UTC),
+			Expected: mdl.SomeTime(time.Date(2015, 5, 7, 10, 25, 9, 0, time.UTC)),
+		},
+	}
+
+	for testNumber, test := range tests {
+
+		var actual mdl.Time = mdl.SomeTime(time.Date(2000, 1, 1, 0, 0, 0, 0, time.UTC))
+
+		err := actual.Scan(test.Datum)
+
+		if expected := test.Expected; nil != err {
+			t.Errorf("For test #%d, did not expect an error, but actually got one: (%T) %q", testNumber, err, err)
+			t.Errorf("\tDATUM: (%T) %#v", test.Datum, test.Datum)
+			t.Errorf("\tEXPECTED: %#v", expected)
+			t.Errorf("\tACTUAL:   %#v", actual)
+			continue
+		}
+
+		if expected := test.Expected; expected != actual {
+			t.Errorf("For test #%d ...", testNumber)
+			t.Errorf("\tDATUM: (%T) %#v", test.Datum, test.Datum)
+			t.Errorf("\tEXPECTED: %#v", expected)
+			t.Errorf("\tACTUAL:   %#v", actual)
+			continue
+		}
+	}
+}
+
+func TestTimeScanError(t *testing.T) {
+
+	tests := []interface{}{
+		"yesterday",
+		"",
+		[]byte("2015-05-07"),
+		int64(5),
+		1.5,
+	}
+
+	for testNumber, datum := range tests {
+
+		var actual mdl.Time
+
+		if err := actual.Scan(datum); nil == err {
+			t.Errorf("For test #%d, expected an error, but did not actually get one.", testNumber)
+			t.Errorf("\tDATUM: (%T) %#v", datum, datum)
+			t.Errorf("\tACTUAL: %#v", actual)
+			continue
+		}
+	}
+}
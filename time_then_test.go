@@ -0,0 +1,54 @@
+package mdl_test
+
+import (
+	"github.com/reiver/go-mdl"
+
+	"testing"
+	"time"
+)
+
+func TestTimeThen(t *testing.T) {
+
+	// The zero time.Time is treated as if it was never set.
+	nonZero := func(datum time.Time) mdl.Time {
+		if datum.IsZero() {
+			return mdl.NoTime()
+		}
+		return mdl.SomeTime(datum)
+	}
+
+	tests := []struct{
+		Value    mdl.Time
+		Func     func(time.Time)mdl.Time
+		Expected mdl.Time
+	}{
+		{
+			Value:    mdl.NoTime(),
+			Func:     nonZero,
+			Expected: mdl.NoTime(),
+		},
+		{
+			Value:    mdl.SomeTime(time.Time{}),
+			Func:     nonZero,
+			Expected: mdl.NoTime(),
+		},
+		{
+			Value:    mdl.SomeTime(time.Date(2015, 5, 7, 10, 25, 9, 0, time.UTC)),
+			Func:     nonZero,
+			Expected: mdl.SomeTime(time.Date(2015, 5, 7, 10, 25, 9, 0, time.UTC)),
+		},
+	}
+
+	for testNumber, test := range tests {
+
+		actual := test.Value.Then(test.Func)
+
+		if expected := test.Expected; expected != actual {
+			t.Errorf("For test #%d ...", testNumber)
+			t.Errorf("\tVALUE:    %#v", test.Value)
+			t.Errorf("\tEXPECTED: %#v", expected)
+			t.Errorf("\tACTUAL:   %#v", actual)
+			continue
+		}
+	}
+}
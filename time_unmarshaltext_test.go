@@ -0,0 +1,68 @@
+package mdl_test
+
+import (
+	"github.com/reiver/go-mdl"
+
+	"testing"
+	"time"
+)
+
+func TestTimeUnmarshalText(t *testing.T) {
+
+	tests := []struct{
+		Datum    []byte
+		Expected mdl.Time
+	}{
+		{
+			Datum:      []byte(nil),
+			Expected: mdl.NoTime(),
+		},
+
+
+
+		{
+			Datum:      []byte("0001-01-01T00:00:00Z"),
+			Expected: mdl.SomeTime(time.Time{}),
+		},
+		{
+			Datum:      []byte("2015-05-07T10:25:09Z"),
+			Expected: mdl.SomeTime(time.Date(2015, 5, 7, 10, 25, 9, 0, time.UTC)),
+		},
+		{
+			Datum:      []byte("1985-10-26T01:21:00.0000005Z"),
+			Expected: mdl.SomeTime(time.Date(1985, 10, 26, 1, 21, 0, 500, time.UTC)),
+		},
+	}
+
+	for testNumber, test := range tests {
+
+		var actual mdl.Time = mdl.SomeTime(time.Date(2000, 1, 1, 0, 0, 0, 0, time.UTC))
+
+		err := actual.UnmarshalText(test.Datum)
+
+		if expected := test.Expected; nil != err {
+			t.Errorf("For test #%d, did not expect an error, but actually got one: (%T) %q", testNumber, err, err)
+			t.Errorf("\tDATUM: %q", test.Datum)
+			t.Errorf("\tEXPECTED: %#v", expected)
+			t.Errorf("\tACTUAL:   %#v", actual)
+			continue
+		}
+
+		if expected := test.Expected; expected != actual {
+			t.Errorf("For test #%d ...", testNumber)
+			t.Errorf("\tDATUM: %q", test.Datum)
+			t.Errorf("\tEXPECTED: %#v", expected)
+			t.Errorf("\tACTUAL:   %#v", actual)
+			continue
+		}
+	}
+
+	for testNumber, datum := range []string{"", "yesterday", "2015-05-07"} {
+
+		var actual mdl.Time
+		if err := actual.UnmarshalText([]byte(datum)); nil == err {
+			t.Errorf("For bad text #%d (%q), expected an error, but did not actually get one.", testNumber, datum)
+			continue
+		}
+	}
+}
@@ -0,0 +1,69 @@
+package mdl_test
+
+import (
+	"github.com/reiver/go-mdl"
+
+	"testing"
+	"time"
+)
+
+func TestTimeUnwrap(t *testing.T) {
+
+	tests := []struct{
+		Value          mdl.Time
+		ExpectedLoaded bool
+		Expected       time.Time
+	}{
+		{
+			Value: mdl.NoTime(),
+			ExpectedLoaded: false,
+			Expected:                 time.Time{},
+		},
+		{
+			Value: mdl.Time{},
+			ExpectedLoaded: false,
+			Expected:                 time.Time{},
+		},
+
+
+
+		{
+			Value: mdl.SomeTime(time.Time{}),
+			ExpectedLoaded: true,
+			Expected:                 time.Time{},
+		},
+		{
+			Value: mdl.SomeTime(time.Date(2015, 5, 7, 10, 25, 9, 0, time.UTC)),
+			ExpectedLoaded: true,
+			Expected:                 time.Date(2015, 5, 7, 10, 25, 9, 0, time.UTC),
+		},
+		{
+			Value: mdl.SomeTime(time.Date(1985, 10, 26, 1, 21, 0, 500, time.UTC)),
+			ExpectedLoaded: true,
+			Expected:                 time.Date(1985, 10, 26, 1, 21, 0, 500, time.UTC),
+		},
+	}
+
+	for testNumber, test := range tests {
+
+		actual, actualLoaded := test.Value.Unwrap()
+
+		if expected, expectedLoaded := test.Expected, test.ExpectedLoaded; expectedLoaded != actualLoaded {
+			t.Errorf("For test #%d ...", testNumber)
+			t.Errorf("\tEXPECTED loaded: %t", expectedLoaded)
+			t.Errorf("\tACTUAL   loaded: %t", actualLoaded)
+			t.Errorf("\tEXPECTED: %v", expected)
+			t.Errorf("\tACTUAL:   %v", actual)
+			continue
+		}
+
+		if expected, expectedLoaded := test.Expected, test.ExpectedLoaded; !expected.Equal(actual) {
+			t.Errorf("For test #%d ...", testNumber)
+			t.Errorf("\tEXPECTED loaded: %t", expectedLoaded)
+			t.Errorf("\tACTUAL   loaded: %t", actualLoaded)
+			t.Errorf("\tEXPECTED: %v", expected)
+			t.Errorf("\tACTUAL:   %v", actual)
+			continue
+		}
+	}
+}
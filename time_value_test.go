@@ -0,0 +1,74 @@
+package mdl_test
+
+import (
+	"github.com/reiver/go-mdl"
+
+	"testing"
+	"time"
+)
+
+func TestTimeValue(t *testing.T) {
+
+	tests := []struct{
+		Value          mdl.Time
+		ExpectedLoaded bool
+		Expected       time.Time
+	}{
+		{
+			Value: mdl.NoTime(),
+			ExpectedLoaded: false,
+			Expected:                 time.Time{},
+		},
+		{
+			Value: mdl.SomeTime(time.Time{}),
+			ExpectedLoaded: true,
+			Expected:                 time.Time{},
+		},
+		{
+			Value: mdl.SomeTime(time.Date(2015, 5, 7, 10, 25, 9, 0, time.UTC)),
+			ExpectedLoaded: true,
+			Expected:                 time.Date(2015, 5, 7, 10, 25, 9, 0, time.UTC),
+		},
+	}
+
+	for testNumber, test := range tests {
+
+		actualInterface, err := test.Value.Value()
+
+		if !test.ExpectedLoaded && nil == err {
+			t.Errorf("For test #%d, expected an error, but did not actually get one: %#v", testNumber, err)
+			t.Errorf("\tEXPECTED loaded: %t", test.ExpectedLoaded)
+			t.Errorf("\tEXPECTED: %v", test.Expected)
+			t.Errorf("\tACTUAL: %T %#v", actualInterface, actualInterface)
+			continue
+		}
+
+		if test.ExpectedLoaded && nil != err {
+			t.Errorf("For test #%d, did not expect an error, but actually got one: (%T) %q", testNumber, err, err)
+			t.Errorf("\tEXPECTED loaded: %t", test.ExpectedLoaded)
+			t.Errorf("\tEXPECTED: %v", test.Expected)
+			t.Errorf("\tACTUAL: %T %#v", actualInterface, actualInterface)
+			continue
+		}
+
+		if !test.ExpectedLoaded {
+			continue
+		}
+		actual, casted := actualInterface.(time.Time)
+		if !casted {
+			t.Errorf("For test #%d, expected type ‘time.Time’, but actually got type ‘%T’.", testNumber, actualInterface)
+			t.Errorf("\tEXPECTED loaded: %t", test.ExpectedLoaded)
+			t.Errorf("\tEXPECTED: %v", test.Expected)
+			t.Errorf("\tACTUAL: %T %#v", actualInterface, actualInterface)
+			continue
+		}
+
+		if expected := test.Expected; !expected.Equal(actual) {
+			t.Errorf("For test #%d ...", testNumber)
+			t.Errorf("\tEXPECTED loaded: %t", test.ExpectedLoaded)
+			t.Errorf("\tEXPECTED: %v", expected)
+			t.Errorf("\tACTUAL:   %v", actual)
+			continue
+		}
+	}
+}
@@ -0,0 +1,289 @@
+package mdl
+
+import (
+	"database/sql/driver"
+	"encoding/json"
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+)
+
+// Uint64 is a uint64 option type.
+//
+// It can have 2 kinds of value:
+//
+// • no uint64 (i.e., ‘nothing’),
+//
+// • some uint64 (i.e., ‘something’).
+//
+// Like with ‘mdl.String’, the value in this type is that it can differentiate between being assigned zero (0),
+// and a variable that hasn't been loaded.
+type Uint64 struct {
+	datum  uint64
+	loaded bool
+}
+
+// NoUint64 returns a mdl.Uint64 which has no value (i.e., ‘nothing’).
+//
+// Note that this is not the same thing as zero (0)!
+// That is mdl.SomeUint64(0).
+//
+// Example
+//
+//	var datum mdl.Uint64
+//	
+//	// ...
+//	
+//	if mdl.NoUint64() == datum {
+//		//@TODO
+//	}
+func NoUint64() Uint64 {
+	return Uint64{}
+}
+
+// SomeUint64 returns a mdl.Uint64 which has some value (i.e., ‘something’).
+//
+// Example
+//
+//	var datum mdl.Uint64
+//	
+//	// ...
+//	
+//	datum = mdl.SomeUint64(5)
+func SomeUint64(datum uint64) Uint64 {
+	return Uint64{
+		loaded: true,
+		datum:  datum,
+	}
+}
+
+func (receiver Uint64) Else(datum uint64) Uint64 {
+	if NoUint64() != receiver {
+		return receiver
+	}
+
+	return SomeUint64(datum)
+}
+
+func (receiver Uint64) ElseUnwrap(datum uint64) uint64 {
+	if NoUint64() == receiver {
+		return datum
+	}
+
+	return receiver.datum
+}
+
+// Format makes ‘mdl.Uint64’ fit the ‘fmt.Formatter’ interface.
+//
+// The %b, %d, %o, %x, %X, and %v verbs format the value the same way they would format a uint64.
+// The %q verb formats its text form (see .MarshalText()) as a quoted string, and the %#v verb formats it with .GoString().
+func (receiver Uint64) Format(f fmt.State, c rune) {
+	switch {
+	case 'v' == c && f.Flag('#'):
+		fmt.Fprint(f, receiver.GoString())
+	case NoUint64() == receiver && 'q' == c:
+		fmt.Fprint(f, "«no-uint64»")
+	case NoUint64() == receiver:
+		fmt.Fprintf(f, "%%!%s(%s)", string(c), receiver.GoString())
+	case 'q' == c:
+		fmt.Fprintf(f, "%q", strconv.FormatUint(receiver.datum, 10))
+	case strings.ContainsRune("bdoxXv", c):
+		fmt.Fprintf(f, optionFormatDirective(f, c), receiver.datum)
+	default:
+		fmt.Fprintf(f, "%%!%s(%s)", string(c), receiver.GoString())
+	}
+}
+
+// GoString makes mdl.Uint64 fit the fmt.GoStringer interface.
+//
+// It gets used with the %#v verb with the printing family of functions
+// in the Go built-in "fmt" package.
+func (receiver Uint64) GoString() string {
+	if NoUint64() == receiver {
+		return "mdl.NoUint64()"
+	}
+
+	return fmt.Sprintf("mdl.SomeUint64(%d)", receiver.datum)
+}
+
+// MarshalJSON makes mdl.Uint64 fit the encoding/json.Marshaler interface.
+//
+// mdl.NoUint64() is marshaled as JSON null, and mdl.SomeUint64() is marshaled as a JSON number.
+func (receiver Uint64) MarshalJSON() ([]byte, error) {
+	if NoUint64() == receiver {
+		return []byte("null"), nil
+	}
+
+	return json.Marshal(receiver.datum)
+}
+
+// MarshalText makes mdl.Uint64 fit the encoding.TextMarshaler interface.
+//
+// mdl.SomeUint64() is marshaled as a base 10 integer (ex: 5).
+// Since text has no way of saying ‘nothing’, mdl.NoUint64() cannot be marshaled as text, and returns an error.
+func (receiver Uint64) MarshalText() ([]byte, error) {
+	if NoUint64() == receiver {
+		return nil, errNotLoaded
+	}
+
+	return []byte(strconv.FormatUint(receiver.datum, 10)), nil
+}
+
+func (receiver Uint64) Map(fn func(uint64)uint64) Uint64 {
+	if NoUint64() == receiver {
+		return receiver
+	}
+
+	return SomeUint64(fn(receiver.datum))
+}
+
+// Scan makes mdl.Uint64 fit the database/sql.Scanner interface.
+//
+// A nil source (i.e., SQL NULL) scans as mdl.NoUint64().
+// The source can be any of the Go integer types (that are not negative), a string, a []byte, or an ‘mdl.Uint64’.
+func (receiver *Uint64) Scan(src interface{}) error {
+	if nil == receiver {
+		return errNilReceiver
+	}
+
+	switch casted := src.(type) {
+	case nil:
+		*receiver = NoUint64()
+		return nil
+	case Uint64:
+		*receiver = casted
+		return nil
+	case string:
+		return receiver.scanText(casted)
+	case []byte:
+		if nil == casted {
+			*receiver = NoUint64()
+			return nil
+		}
+		return receiver.scanText(string(casted))
+	case uint64:
+		*receiver = SomeUint64(casted)
+		return nil
+	case uint:
+		*receiver = SomeUint64(uint64(casted))
+		return nil
+	case uint32:
+		*receiver = SomeUint64(uint64(casted))
+		return nil
+	case uint16:
+		*receiver = SomeUint64(uint64(casted))
+		return nil
+	case uint8:
+		*receiver = SomeUint64(uint64(casted))
+		return nil
+	case int64:
+		return receiver.scanSigned(casted)
+	case int:
+		return receiver.scanSigned(int64(casted))
+	case int32:
+		return receiver.scanSigned(int64(casted))
+	case int16:
+		return receiver.scanSigned(int64(casted))
+	case int8:
+		return receiver.scanSigned(int64(casted))
+	default:
+		return unsupportedSource(src)
+	}
+}
+
+// String returns the text form of the value (see .MarshalText()).
+func (receiver Uint64) String() (string, error) {
+	if NoUint64() == receiver {
+		return "", errNotLoaded
+	}
+
+	return strconv.FormatUint(receiver.datum, 10), nil
+}
+
+func (receiver Uint64) Then(fn func(uint64)Uint64) Uint64 {
+	if NoUint64() == receiver {
+		return receiver
+	}
+
+	return fn(receiver.datum)
+}
+
+// UnmarshalJSON makes mdl.Uint64 fit the encoding/json.Unmarshaler interface.
+//
+// It is the opposite of .MarshalJSON(); i.e., JSON null is unmarshaled as mdl.NoUint64().
+func (receiver *Uint64) UnmarshalJSON(data []byte) error {
+	if nil == receiver {
+		return errNilReceiver
+	}
+
+	if "null" == string(data) {
+		*receiver = NoUint64()
+		return nil
+	}
+
+	var datum uint64
+	if err := json.Unmarshal(data, &datum); nil != err {
+		return err
+	}
+
+	*receiver = SomeUint64(datum)
+	return nil
+}
+
+// UnmarshalText makes mdl.Uint64 fit the encoding.TextUnmarshaler interface.
+//
+// It is the opposite of .MarshalText(). (A nil text is unmarshaled as mdl.NoUint64().)
+func (receiver *Uint64) UnmarshalText(text []byte) error {
+	if nil == receiver {
+		return errNilReceiver
+	}
+
+	if nil == text {
+		*receiver = NoUint64()
+		return nil
+	}
+
+	return receiver.scanText(string(text))
+}
+
+func (receiver Uint64) Unwrap() (uint64, bool) {
+	return receiver.datum, receiver.loaded
+}
+
+// Value makes mdl.Uint64 fit the database/sql/driver.Valuer interface.
+func (receiver Uint64) Value() (driver.Value, error) {
+	if NoUint64() == receiver {
+		return nil, errNotLoaded
+	}
+
+	// A driver.Value cannot be a uint64; so a value too big for an int64 is given as a (base 10) string.
+	if math.MaxInt64 < receiver.datum {
+		return strconv.FormatUint(receiver.datum, 10), nil
+	}
+
+	return int64(receiver.datum), nil
+}
+
+func (receiver Uint64) isNothing() bool {
+	return NoUint64() == receiver
+}
+
+func (receiver *Uint64) scanText(text string) error {
+	datum, err := strconv.ParseUint(text, 10, 64)
+	if nil != err {
+		return err
+	}
+
+	*receiver = SomeUint64(datum)
+	return nil
+}
+
+func (receiver *Uint64) scanSigned(datum int64) error {
+	if datum < 0 {
+		return fmt.Errorf("mdl: %d is negative, so cannot be a uint64", datum)
+	}
+
+	*receiver = SomeUint64(uint64(datum))
+	return nil
+}
@@ -0,0 +1,54 @@
+package mdl_test
+
+import (
+	"github.com/reiver/go-mdl"
+
+	"testing"
+)
+
+func TestUint64Else(t *testing.T) {
+
+	tests := []struct{
+		Value    mdl.Uint64
+		Else     uint64
+		Expected mdl.Uint64
+	}{
+		{
+			Value:    mdl.NoUint64(),
+			Else:                    1,
+			Expected: mdl.SomeUint64(1),
+		},
+		{
+			Value:    mdl.SomeUint64(5),
+			Else:                    1,
+			Expected: mdl.SomeUint64(5),
+		},
+
+
+
+		{
+			Value:    mdl.NoUint64(),
+			Else:                    0,
+			Expected: mdl.SomeUint64(0),
+		},
+		{
+			Value:    mdl.SomeUint64(0),
+			Else:                    1,
+			Expected: mdl.SomeUint64(0),
+		},
+	}
+
+	for testNumber, test := range tests {
+
+		actual := test.Value.Else(test.Else)
+
+		if expected := test.Expected; expected != actual {
+			t.Errorf("For test #%d ...", testNumber)
+			t.Errorf("\tVALUE:    %#v", test.Value)
+			t.Errorf("\tELSE:                    %d", test.Else)
+			t.Errorf("\tEXPECTED: %#v", expected)
+			t.Errorf("\tACTUAL:   %#v", actual)
+			continue
+		}
+	}
+}
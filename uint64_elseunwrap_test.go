@@ -0,0 +1,49 @@
+package mdl_test
+
+import (
+	"github.com/reiver/go-mdl"
+
+	"testing"
+)
+
+func TestUint64ElseUnwrap(t *testing.T) {
+
+	tests := []struct{
+		Value    mdl.Uint64
+		Else     uint64
+		Expected uint64
+	}{
+		{
+			Value:    mdl.NoUint64(),
+			Else:                    1,
+			Expected:                1,
+		},
+		{
+			Value:    mdl.SomeUint64(5),
+			Else:                    1,
+			Expected:                5,
+		},
+
+
+
+		{
+			Value:    mdl.SomeUint64(0),
+			Else:                    1,
+			Expected:                0,
+		},
+	}
+
+	for testNumber, test := range tests {
+
+		actual := test.Value.ElseUnwrap(test.Else)
+
+		if expected := test.Expected; expected != actual {
+			t.Errorf("For test #%d ...", testNumber)
+			t.Errorf("\tVALUE: %#v", test.Value)
+			t.Errorf("\tELSE:                 %d", test.Else)
+			t.Errorf("\tEXPECTED:             %d", expected)
+			t.Errorf("\tACTUAL:               %d", actual)
+			continue
+		}
+	}
+}
@@ -0,0 +1,79 @@
+package mdl_test
+
+import (
+	"github.com/reiver/go-mdl"
+
+	"fmt"
+
+	"testing"
+)
+
+func TestUint64Format(t *testing.T) {
+
+	tests := []struct{
+		Format   string
+		Uint64   mdl.Uint64
+		Expected string
+	}{
+		{
+			Format: "%q",
+			Uint64: mdl.NoUint64(),
+			Expected:      `«no-uint64»`,
+		},
+		{
+			Format: "%q",
+			Uint64: mdl.SomeUint64(5),
+			Expected:      `"5"`,
+		},
+
+
+
+		{
+			Format: "%v",
+			Uint64: mdl.SomeUint64(5),
+			Expected:      `5`,
+		},
+		{
+			Format: "%x",
+			Uint64: mdl.SomeUint64(3735928559),
+			Expected:      `deadbeef`,
+		},
+		{
+			Format: "%#X",
+			Uint64: mdl.SomeUint64(3735928559),
+			Expected:      `0XDEADBEEF`,
+		},
+		{
+			Format: "%o",
+			Uint64: mdl.SomeUint64(8),
+			Expected:      `10`,
+		},
+		{
+			Format: "%-4d|",
+			Uint64: mdl.SomeUint64(5),
+			Expected:      `5   |`,
+		},
+
+
+
+		{
+			Format: "%x",
+			Uint64: mdl.NoUint64(),
+			Expected:      `%!x(mdl.NoUint64())`,
+		},
+	}
+
+	for testNumber, test := range tests {
+
+		actual := fmt.Sprintf(test.Format, test.Uint64)
+		if expected := test.Expected; expected != actual {
+			t.Errorf("For test #%d, for print verb, did not actually get what was expected.", testNumber)
+			t.Logf("\tEXPECTED: %q", expected)
+			t.Logf("\tACTUAL:   %q", actual)
+			t.Logf("\tFormat: %q", test.Format)
+			t.Logf("\tUint64: %#v", test.Uint64)
+			continue
+		}
+
+	}
+}
@@ -0,0 +1,49 @@
+package mdl_test
+
+import (
+	"github.com/reiver/go-mdl"
+
+	"math"
+
+	"testing"
+)
+
+func TestUint64GoString(t *testing.T) {
+
+	tests := []struct{
+		Value    mdl.Uint64
+		Expected string
+	}{
+		{
+			Value:    mdl.NoUint64(),
+			Expected: "mdl.NoUint64()",
+		},
+
+
+
+		{
+			Value:    mdl.SomeUint64(0),
+			Expected: "mdl.SomeUint64(0)",
+		},
+		{
+			Value:    mdl.SomeUint64(5),
+			Expected: "mdl.SomeUint64(5)",
+		},
+		{
+			Value:    mdl.SomeUint64(math.MaxUint64),
+			Expected: "mdl.SomeUint64(18446744073709551615)",
+		},
+	}
+
+	for testNumber, test := range tests {
+
+		actual := test.Value.GoString()
+
+		if expected := test.Expected; expected != actual {
+			t.Errorf("For test #%d ...", testNumber)
+			t.Errorf("\tEXPECTED: %#v", expected)
+			t.Errorf("\tACTUAL:   %#v", actual)
+			continue
+		}
+	}
+}
@@ -0,0 +1,77 @@
+package mdl_test
+
+import (
+	"github.com/reiver/go-mdl"
+
+	"encoding/json"
+	"math"
+	"testing"
+)
+
+func TestUint64JSON(t *testing.T) {
+
+	tests := []struct{
+		Datum    mdl.Uint64
+		Expected string
+	}{
+		{
+			Datum:    mdl.NoUint64(),
+			Expected: `null`,
+		},
+		{
+			Datum:    mdl.SomeUint64(0),
+			Expected: `0`,
+		},
+		{
+			Datum:    mdl.SomeUint64(5),
+			Expected: `5`,
+		},
+		{
+			Datum:    mdl.SomeUint64(math.MaxUint64),
+			Expected: `18446744073709551615`,
+		},
+	}
+
+	for testNumber, test := range tests {
+
+		data, err := json.Marshal(test.Datum)
+		if nil != err {
+			t.Errorf("For test #%d, did not expect an error, but actually got one: (%T) %q", testNumber, err, err)
+			continue
+		}
+
+		if expected, actual := test.Expected, string(data); expected != actual {
+			t.Errorf("For test #%d, expected %s, but actually got %s.", testNumber, expected, actual)
+			continue
+		}
+
+		var actual mdl.Uint64 = mdl.SomeUint64(1)
+		if err := json.Unmarshal(data, &actual); nil != err {
+			t.Errorf("For test #%d, did not expect an error, but actually got one: (%T) %q", testNumber, err, err)
+			continue
+		}
+
+		if expected := test.Datum; expected != actual {
+			t.Errorf("For test #%d, expected %#v, but actually got %#v.", testNumber, expected, actual)
+			continue
+		}
+	}
+}
+
+func TestUint64JSONError(t *testing.T) {
+
+	tests := []string{
+		`-1`,
+		`"5"`,
+		`18446744073709551616`,
+	}
+
+	for testNumber, data := range tests {
+
+		var actual mdl.Uint64
+		if err := json.Unmarshal([]byte(data), &actual); nil == err {
+			t.Errorf("For test #%d, expected an error for %s, but did not actually get one.", testNumber, data)
+			continue
+		}
+	}
+}
@@ -0,0 +1,53 @@
+package mdl_test
+
+import (
+	"github.com/reiver/go-mdl"
+
+	"testing"
+)
+
+func TestUint64Map(t *testing.T) {
+
+	tests := []struct{
+		Value    mdl.Uint64
+		Func     func(uint64)uint64
+		Expected mdl.Uint64
+	}{
+		{
+			Value: mdl.NoUint64(),
+			Func:  func(n uint64)uint64{
+				return n + 1
+			},
+			Expected: mdl.NoUint64(),
+		},
+		{
+			Value: mdl.SomeUint64(5),
+			Func:  func(n uint64)uint64{
+				return n + 1
+			},
+			Expected: mdl.SomeUint64(6),
+		},
+
+
+
+		{
+			Value: mdl.SomeUint64(0),
+			Func:  func(n uint64)uint64{
+				return n - 1
+			},
+			Expected: mdl.SomeUint64(18446744073709551615),
+		},
+	}
+
+	for testNumber, test := range tests {
+
+		actual := test.Value.Map(test.Func)
+
+		if expected := test.Expected; expected != actual {
+			t.Errorf("For test #%d ...", testNumber)
+			t.Errorf("\tEXPECTED: %#v", expected)
+			t.Errorf("\tACTUAL:   %#v", actual)
+			continue
+		}
+	}
+}
@@ -0,0 +1,48 @@
+package mdl_test
+
+import (
+	"github.com/reiver/go-mdl"
+
+	"math"
+
+	"testing"
+)
+
+func TestUint64MarshalText(t *testing.T) {
+
+	tests := []struct{
+		Value    mdl.Uint64
+		Expected string
+	}{
+		{
+			Value:    mdl.SomeUint64(0),
+			Expected: "0",
+		},
+		{
+			Value:    mdl.SomeUint64(5),
+			Expected: "5",
+		},
+		{
+			Value:    mdl.SomeUint64(math.MaxUint64),
+			Expected: "18446744073709551615",
+		},
+	}
+
+	for testNumber, test := range tests {
+
+		text, err := test.Value.MarshalText()
+		if nil != err {
+			t.Errorf("For test #%d, did not expect an error, but actually got one: (%T) %q", testNumber, err, err)
+			continue
+		}
+
+		if expected, actual := test.Expected, string(text); expected != actual {
+			t.Errorf("For test #%d, expected %q, but actually got %q.", testNumber, expected, actual)
+			continue
+		}
+	}
+
+	if _, err := mdl.NoUint64().MarshalText(); nil == err {
+		t.Errorf("Expected an error for mdl.NoUint64(), but did not actually get one.")
+	}
+}
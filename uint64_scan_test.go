@@ -0,0 +1,118 @@
+package mdl_test
+
+import (
+	"github.com/reiver/go-mdl"
+
+	"math"
+
+	"testing"
+)
+
+func TestUint64Scan(t *testing.T) {
+
+	tests := []struct{
+		Datum    interface{}
+		Expected mdl.Uint64
+	}{
+		{
+			Datum:    nil,
+			Expected: mdl.NoUint64(),
+		},
+		{
+			Datum:    []byte(nil),
+			Expected: mdl.NoUint64(),
+		},
+
+
+
+		{
+			Datum:    mdl.NoUint64(),
+			Expected: mdl.NoUint64(),
+		},
+		{
+			Datum:    mdl.SomeUint64(5),
+			Expected: mdl.SomeUint64(5),
+		},
+
+
+
+		{
+			Datum:                   "5",
+			Expected: mdl.SomeUint64(5),
+		},
+		{
+			Datum:            []byte("5"),
+			Expected: mdl.SomeUint64(5),
+		},
+		{
+			// This is how .Value() gives a value that is too big for an int64.
+			Datum:                   "18446744073709551615",
+			Expected: mdl.SomeUint64(math.MaxUint64),
+		},
+
+
+
+		{
+			Datum:             uint64(math.MaxUint64),
+			Expected: mdl.SomeUint64(math.MaxUint64),
+		},
+		{
+			Datum:              uint8(5),
+			Expected: mdl.SomeUint64(5),
+		},
+		{
+			Datum:              int64(5),
+			Expected: mdl.SomeUint64(5),
+		},
+		{
+			Datum:                int(0),
+			Expected: mdl.SomeUint64(0),
+		},
+	}
+
+	for testNumber, test := range tests {
+
+		var actual mdl.Uint64 = mdl.SomeUint64(1)
+
+		err := actual.Scan(test.Datum)
+
+		if expected := test.Expected; nil != err {
+			t.Errorf("For test #%d, did not expect an error, but actually got one: (%T) %q", testNumber, err, err)
+			t.Errorf("\tDATUM: (%T) %#v", test.Datum, test.Datum)
+			t.Errorf("\tEXPECTED: %#v", expected)
+			t.Errorf("\tACTUAL:   %#v", actual)
+			continue
+		}
+
+		if expected := test.Expected; expected != actual {
+			t.Errorf("For test #%d ...", testNumber)
+			t.Errorf("\tDATUM: (%T) %#v", test.Datum, test.Datum)
+			t.Errorf("\tEXPECTED: %#v", expected)
+			t.Errorf("\tACTUAL:   %#v", actual)
+			continue
+		}
+	}
+}
+
+func TestUint64ScanError(t *testing.T) {
+
+	tests := []interface{}{
+		int64(-1),
+		int8(-5),
+		"-1",
+		"18446744073709551616",
+		2.5,
+	}
+
+	for testNumber, datum := range tests {
+
+		var actual mdl.Uint64
+
+		if err := actual.Scan(datum); nil == err {
+			t.Errorf("For test #%d, expected an error, but did not actually get one.", testNumber)
+			t.Errorf("\tDATUM: (%T) %#v", datum, datum)
+			t.Errorf("\tACTUAL: %#v", actual)
+			continue
+		}
+	}
+}
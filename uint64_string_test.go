@@ -0,0 +1,72 @@
+package mdl_test
+
+import (
+	"github.com/reiver/go-mdl"
+
+	"math"
+
+	"testing"
+)
+
+func TestUint64String(t *testing.T) {
+
+	tests := []struct{
+		Value          mdl.Uint64
+		ExpectedLoaded bool
+		Expected       string
+	}{
+		{
+			Value: mdl.NoUint64(),
+			ExpectedLoaded: false,
+			Expected:                   "",
+		},
+
+
+
+		{
+			Value: mdl.SomeUint64(0),
+			ExpectedLoaded: true,
+			Expected:                   "0",
+		},
+		{
+			Value: mdl.SomeUint64(5),
+			ExpectedLoaded: true,
+			Expected:                   "5",
+		},
+		{
+			Value: mdl.SomeUint64(math.MaxUint64),
+			ExpectedLoaded: true,
+			Expected:                   "18446744073709551615",
+		},
+	}
+
+	for testNumber, test := range tests {
+
+		actual, err := test.Value.String()
+
+		if !test.ExpectedLoaded && nil == err {
+			t.Errorf("For test #%d, expected an error, but did not actually get one: %#v", testNumber, err)
+			t.Errorf("\tEXPECTED loaded: %t", test.ExpectedLoaded)
+			t.Errorf("\tEXPECTED: %#v", test.Expected)
+			t.Errorf("\tACTUAL:   %#v", actual)
+			continue
+		}
+
+		if test.ExpectedLoaded && nil != err {
+			t.Errorf("For test #%d, did not expect an error, but actually got one: (%T) %q", testNumber, err, err)
+			t.Errorf("\tEXPECTED loaded: %t", test.ExpectedLoaded)
+			t.Errorf("\tEXPECTED: %#v", test.Expected)
+			t.Errorf("\tACTUAL:   %#v", actual)
+			continue
+		}
+
+		if expected := test.Expected; expected != actual {
+			t.Errorf("For test #%d ...", testNumber)
+			t.Errorf("\tEXPECTED loaded: %t", test.ExpectedLoaded)
+			t.Errorf("\tACTUAL   err:   (%T) %q", err, err)
+			t.Errorf("\tEXPECTED: %#v", expected)
+			t.Errorf("\tACTUAL:   %#v", actual)
+			continue
+		}
+	}
+}
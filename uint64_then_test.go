@@ -0,0 +1,53 @@
+package mdl_test
+
+import (
+	"github.com/reiver/go-mdl"
+
+	"testing"
+)
+
+func TestUint64Then(t *testing.T) {
+
+	// Like an ‘expected version’ that has to be followed by the next one.
+	next := func(n uint64) mdl.Uint64 {
+		if 0 == n {
+			return mdl.NoUint64()
+		}
+		return mdl.SomeUint64(n + 1)
+	}
+
+	tests := []struct{
+		Value    mdl.Uint64
+		Func     func(uint64)mdl.Uint64
+		Expected mdl.Uint64
+	}{
+		{
+			Value:    mdl.NoUint64(),
+			Func:     next,
+			Expected: mdl.NoUint64(),
+		},
+		{
+			Value:    mdl.SomeUint64(0),
+			Func:     next,
+			Expected: mdl.NoUint64(),
+		},
+		{
+			Value:    mdl.SomeUint64(5),
+			Func:     next,
+			Expected: mdl.SomeUint64(6),
+		},
+	}
+
+	for testNumber, test := range tests {
+
+		actual := test.Value.Then(test.Func)
+
+		if expected := test.Expected; expected != actual {
+			t.Errorf("For test #%d ...", testNumber)
+			t.Errorf("\tVALUE:    %#v", test.Value)
+			t.Errorf("\tEXPECTED: %#v", expected)
+			t.Errorf("\tACTUAL:   %#v", actual)
+			continue
+		}
+	}
+}
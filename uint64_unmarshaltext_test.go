@@ -0,0 +1,67 @@
+package mdl_test
+
+import (
+	"github.com/reiver/go-mdl"
+
+	"testing"
+)
+
+func TestUint64UnmarshalText(t *testing.T) {
+
+	tests := []struct{
+		Datum    []byte
+		Expected mdl.Uint64
+	}{
+		{
+			Datum:        []byte(nil),
+			Expected: mdl.NoUint64(),
+		},
+
+
+
+		{
+			Datum:        []byte("0"),
+			Expected: mdl.SomeUint64(0),
+		},
+		{
+			Datum:        []byte("5"),
+			Expected: mdl.SomeUint64(5),
+		},
+		{
+			Datum:        []byte("18446744073709551615"),
+			Expected: mdl.SomeUint64(18446744073709551615),
+		},
+	}
+
+	for testNumber, test := range tests {
+
+		var actual mdl.Uint64 = mdl.SomeUint64(1)
+
+		err := actual.UnmarshalText(test.Datum)
+
+		if expected := test.Expected; nil != err {
+			t.Errorf("For test #%d, did not expect an error, but actually got one: (%T) %q", testNumber, err, err)
+			t.Errorf("\tDATUM: %q", test.Datum)
+			t.Errorf("\tEXPECTED: %#v", expected)
+			t.Errorf("\tACTUAL:   %#v", actual)
+			continue
+		}
+
+		if expected := test.Expected; expected != actual {
+			t.Errorf("For test #%d ...", testNumber)
+			t.Errorf("\tDATUM: %q", test.Datum)
+			t.Errorf("\tEXPECTED: %#v", expected)
+			t.Errorf("\tACTUAL:   %#v", actual)
+			continue
+		}
+	}
+
+	for testNumber, datum := range []string{"", "-1", "+5", "five"} {
+
+		var actual mdl.Uint64
+		if err := actual.UnmarshalText([]byte(datum)); nil == err {
+			t.Errorf("For bad text #%d (%q), expected an error, but did not actually get one.", testNumber, datum)
+			continue
+		}
+	}
+}
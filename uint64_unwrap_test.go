@@ -0,0 +1,70 @@
+package mdl_test
+
+import (
+	"github.com/reiver/go-mdl"
+
+	"math"
+
+	"testing"
+)
+
+func TestUint64Unwrap(t *testing.T) {
+
+	tests := []struct{
+		Value          mdl.Uint64
+		ExpectedLoaded bool
+		Expected       uint64
+	}{
+		{
+			Value: mdl.NoUint64(),
+			ExpectedLoaded: false,
+			Expected:                   0,
+		},
+		{
+			Value: mdl.Uint64{},
+			ExpectedLoaded: false,
+			Expected:                   0,
+		},
+
+
+
+		{
+			Value: mdl.SomeUint64(0),
+			ExpectedLoaded: true,
+			Expected:                   0,
+		},
+		{
+			Value: mdl.SomeUint64(5),
+			ExpectedLoaded: true,
+			Expected:                   5,
+		},
+		{
+			Value: mdl.SomeUint64(math.MaxUint64),
+			ExpectedLoaded: true,
+			Expected:                   math.MaxUint64,
+		},
+	}
+
+	for testNumber, test := range tests {
+
+		actual, actualLoaded := test.Value.Unwrap()
+
+		if expected, expectedLoaded := test.Expected, test.ExpectedLoaded; expectedLoaded != actualLoaded {
+			t.Errorf("For test #%d ...", testNumber)
+			t.Errorf("\tEXPECTED loaded: %t", expectedLoaded)
+			t.Errorf("\tACTUAL   loaded: %t", actualLoaded)
+			t.Errorf("\tEXPECTED: %d", expected)
+			t.Errorf("\tACTUAL:   %d", actual)
+			continue
+		}
+
+		if expected, expectedLoaded := test.Expected, test.ExpectedLoaded; expected != actual {
+			t.Errorf("For test #%d ...", testNumber)
+			t.Errorf("\tEXPECTED loaded: %t", expectedLoaded)
+			t.Errorf("\tACTUAL   loaded: %t", actualLoaded)
+			t.Errorf("\tEXPECTED: %d", expected)
+			t.Errorf("\tACTUAL:   %d", actual)
+			continue
+		}
+	}
+}
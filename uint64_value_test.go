@@ -0,0 +1,74 @@
+package mdl_test
+
+import (
+	"github.com/reiver/go-mdl"
+
+	"database/sql/driver"
+	"math"
+
+	"testing"
+)
+
+func TestUint64Value(t *testing.T) {
+
+	tests := []struct{
+		Value    mdl.Uint64
+		Expected driver.Value
+	}{
+		{
+			Value:    mdl.SomeUint64(0),
+			Expected: int64(0),
+		},
+		{
+			Value:    mdl.SomeUint64(5),
+			Expected: int64(5),
+		},
+		{
+			Value:    mdl.SomeUint64(math.MaxInt64),
+			Expected: int64(math.MaxInt64),
+		},
+
+
+
+		// A driver.Value cannot be a uint64; so these are given as strings.
+		{
+			Value:    mdl.SomeUint64(math.MaxInt64 + 1),
+			Expected: "9223372036854775808",
+		},
+		{
+			Value:    mdl.SomeUint64(math.MaxUint64),
+			Expected: "18446744073709551615",
+		},
+	}
+
+	for testNumber, test := range tests {
+
+		actual, err := test.Value.Value()
+		if nil != err {
+			t.Errorf("For test #%d, did not expect an error, but actually got one: (%T) %q", testNumber, err, err)
+			continue
+		}
+
+		if expected := test.Expected; expected != actual {
+			t.Errorf("For test #%d ...", testNumber)
+			t.Errorf("\tEXPECTED: (%T) %#v", expected, expected)
+			t.Errorf("\tACTUAL:   (%T) %#v", actual, actual)
+			continue
+		}
+
+		// And it scans back to the same value.
+		var scanned mdl.Uint64
+		if err := scanned.Scan(actual); nil != err {
+			t.Errorf("For test #%d, did not expect an error, but actually got one: (%T) %q", testNumber, err, err)
+			continue
+		}
+		if expected := test.Value; expected != scanned {
+			t.Errorf("For test #%d, expected %#v, but actually got %#v.", testNumber, expected, scanned)
+			continue
+		}
+	}
+
+	if _, err := mdl.NoUint64().Value(); nil == err {
+		t.Errorf("Expected an error for mdl.NoUint64(), but did not actually get one.")
+	}
+}
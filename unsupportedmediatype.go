@@ -0,0 +1,66 @@
+package mdl
+
+import (
+	"fmt"
+	"net/http"
+)
+
+// UnsupportedMediaType is the error returned from mdl.Instruction.Scan() when the “Content-Type” of the HTTP request body is not one that is supported.
+//
+// For example:
+//
+//	var instruction mdl.Instruction
+//	
+//	// ...
+//	
+//	err := instruction.Scan(request)
+//	
+//	if nil != err {
+//		switch casted := err.(type) {
+//		case mdl.UnsupportedMediaType:
+//			http.Error(responseWriter, casted.Error(), casted.StatusCode())
+//			return
+//		default:
+//			//@TODO
+//		}
+//	}
+type UnsupportedMediaType interface {
+	error
+	UnsupportedMediaType()
+
+	// StatusCode returns the HTTP status code that goes with this error (i.e., 415 “Unsupported Media Type”).
+	StatusCode() int
+
+	// Unwrap returns the error that caused this error, if there is one.
+	Unwrap() error
+}
+
+type internalUnsupportedMediaType struct {
+	err error
+}
+
+func unsupportedMediaType(err error) UnsupportedMediaType {
+	return internalUnsupportedMediaType{
+		err: err,
+	}
+}
+
+func (receiver internalUnsupportedMediaType) Error() string {
+	if nil == receiver.err {
+		return "mdl: unsupported media type"
+	}
+
+	return fmt.Sprintf("mdl: unsupported media type: %s", receiver.err)
+}
+
+func (receiver internalUnsupportedMediaType) StatusCode() int {
+	return http.StatusUnsupportedMediaType
+}
+
+func (receiver internalUnsupportedMediaType) Unwrap() error {
+	return receiver.err
+}
+
+func (internalUnsupportedMediaType) UnsupportedMediaType() {
+	// Nothing here.
+}
@@ -4,14 +4,14 @@ import (
 	"net/http"
 )
 
-func inferVerb(request *http.Request) (string, bool) {
+func inferVerb(request *http.Request, overrideHeader string) (string, bool) {
 	if nil == request {
 		return "", false
 	}
 
 	switch request.Method {
 	case http.MethodPost, http.MethodPatch:
-		if value := request.Header.Get("X-HTTP-Method-Override"); "" != value {
+		if value := request.Header.Get(overrideHeader); "" != value {
 			return value, true
 		}
 	}
@@ -158,7 +158,7 @@ apple=one&banana=two&cherry=three`))) ; if nil != e { panic(e) } ; return r}(),
 
 	for testNumber, test := range tests {
 
-		actualVerb, actualOK := inferVerb(test.Request)
+		actualVerb, actualOK := inferVerb(test.Request, defaultMethodOverrideHeader)
 
 		if expected, actual := test.ExpectedOK, actualOK; expected != actual {
 			t.Errorf("For test #%d, did not get expected value for ‘ok’.", testNumber)
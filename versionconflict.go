@@ -0,0 +1,64 @@
+package mdl
+
+import (
+	"fmt"
+)
+
+// VersionConflict is the error returned from mdl.EventStore.Append() when the stream being appended to
+// is not at the version it was expected to be at. (Usually because something else appended to the stream first.)
+//
+// For example:
+//
+//	var store mdl.EventStore
+//
+//	// ...
+//
+//	_, err := store.Append(streamID, mdl.SomeUint64(version), events...)
+//
+//	if nil != err {
+//		switch casted := err.(type) {
+//		case mdl.VersionConflict:
+//			fmt.Printf("stream %q is at version %d\n", casted.StreamID(), casted.ActualVersion())
+//		default:
+//			//@TODO
+//		}
+//	}
+type VersionConflict interface {
+	error
+	VersionConflict()
+
+	// StreamID returns the ID of the stream that was being appended to.
+	StreamID() string
+
+	// ExpectedVersion returns the version the stream was expected to be at.
+	ExpectedVersion() uint64
+
+	// ActualVersion returns the version the stream was actually at.
+	ActualVersion() uint64
+}
+
+type internalVersionConflict struct {
+	streamID string
+	expectedVersion uint64
+	actualVersion uint64
+}
+
+func (receiver internalVersionConflict) Error() string {
+	return fmt.Sprintf("mdl: stream %q is at version %d, not the expected version %d", receiver.streamID, receiver.actualVersion, receiver.expectedVersion)
+}
+
+func (receiver internalVersionConflict) StreamID() string {
+	return receiver.streamID
+}
+
+func (receiver internalVersionConflict) ExpectedVersion() uint64 {
+	return receiver.expectedVersion
+}
+
+func (receiver internalVersionConflict) ActualVersion() uint64 {
+	return receiver.actualVersion
+}
+
+func (internalVersionConflict) VersionConflict() {
+	// Nothing here.
+}